@@ -1,9 +1,159 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE IF NOT EXISTS services (
+    service_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    aliases TEXT[] NOT NULL DEFAULT '{}',
+    category TEXT,
+    default_price INTEGER,
+    website TEXT
+);
+
+CREATE UNIQUE INDEX idx_services_name ON services(lower(name));
+
 CREATE TABLE IF NOT EXISTS subscriptions (
+    subscription_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kind TEXT NOT NULL DEFAULT 'recurring' CHECK (kind IN ('recurring', 'one_time')),
     service_name TEXT NOT NULL,
     month_cost INTEGER NOT NULL,
     user_id UUID NOT NULL,
     subs_start_date DATE NOT NULL DEFAULT CURRENT_DATE,
-    subs_end_date DATE
+    subs_end_date DATE,
+    cancellation_code TEXT CHECK (cancellation_code IN ('too_expensive', 'not_using', 'switched_service', 'missing_features', 'technical_issues', 'temporary', 'other')),
+    cancellation_reason TEXT,
+    deleted_at TIMESTAMPTZ,
+    auto_renew BOOLEAN NOT NULL DEFAULT false,
+    term_months INTEGER CHECK (term_months > 0),
+    expired_at TIMESTAMPTZ,
+    service_id UUID REFERENCES services(service_id) ON DELETE SET NULL,
+    category TEXT,
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    split_rule TEXT CHECK (split_rule IN ('equal', 'percentage', 'fixed')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
 );
 
 CREATE INDEX idx_subscriptions ON subscriptions(user_id, service_name, subs_start_date, subs_end_date) include (month_cost);
+
+CREATE INDEX idx_subscriptions_tags ON subscriptions USING gin (tags);
+
+CREATE INDEX idx_subscriptions_deleted_at ON subscriptions(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Every version of every subscription row, including changes made directly in
+-- SQL. A version is current from valid_from until valid_to; the current one
+-- has no valid_to. Purging a subscription closes its last version.
+CREATE TABLE IF NOT EXISTS subscriptions_history (
+    LIKE subscriptions,
+    valid_from TIMESTAMPTZ NOT NULL,
+    valid_to TIMESTAMPTZ,
+    CHECK (valid_to IS NULL OR valid_to >= valid_from)
+);
+
+CREATE INDEX idx_subscriptions_history ON subscriptions_history(subscription_id, valid_from);
+
+-- clock_timestamp() rather than now(), so that versions written by several
+-- updates in one transaction follow each other instead of sharing a moment.
+-- Columns are listed by name, as the history table need not keep their order.
+CREATE OR REPLACE FUNCTION subscriptions_versioning() RETURNS TRIGGER AS $$
+DECLARE
+    changed_at TIMESTAMPTZ := clock_timestamp();
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE subscriptions_history SET valid_to = changed_at
+        WHERE subscription_id = OLD.subscription_id AND valid_to IS NULL;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        INSERT INTO subscriptions_history (
+            subscription_id, kind, service_name, month_cost, user_id, subs_start_date, subs_end_date,
+            cancellation_code, cancellation_reason, deleted_at, auto_renew, term_months, expired_at,
+            service_id, category, tags, split_rule, created_at,
+            valid_from, valid_to
+        ) VALUES (
+            NEW.subscription_id, NEW.kind, NEW.service_name, NEW.month_cost, NEW.user_id, NEW.subs_start_date, NEW.subs_end_date,
+            NEW.cancellation_code, NEW.cancellation_reason, NEW.deleted_at, NEW.auto_renew, NEW.term_months, NEW.expired_at,
+            NEW.service_id, NEW.category, NEW.tags, NEW.split_rule, NEW.created_at,
+            changed_at, NULL
+        );
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER subscriptions_versioning
+AFTER INSERT OR UPDATE OR DELETE ON subscriptions
+FOR EACH ROW EXECUTE FUNCTION subscriptions_versioning();
+
+CREATE TABLE IF NOT EXISTS subscription_members (
+    subscription_id UUID NOT NULL REFERENCES subscriptions(subscription_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    share INTEGER CHECK (share >= 0),
+    PRIMARY KEY (subscription_id, user_id)
+);
+
+CREATE INDEX idx_subscription_members_user_id ON subscription_members(user_id);
+
+CREATE TABLE IF NOT EXISTS subscription_discounts (
+    discount_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    subscription_id UUID NOT NULL REFERENCES subscriptions(subscription_id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('percent', 'fixed_price')),
+    value INTEGER NOT NULL CHECK (value >= 0),
+    start_date DATE NOT NULL,
+    end_date DATE,
+    CHECK (end_date IS NULL OR end_date >= start_date)
+);
+
+CREATE INDEX idx_subscription_discounts_subscription_id ON subscription_discounts(subscription_id);
+
+CREATE TABLE IF NOT EXISTS subscription_actuals (
+    subscription_id UUID NOT NULL REFERENCES subscriptions(subscription_id) ON DELETE CASCADE,
+    month DATE NOT NULL CHECK (month = date_trunc('month', month)),
+    amount INTEGER NOT NULL CHECK (amount >= 0),
+    PRIMARY KEY (subscription_id, month)
+);
+
+CREATE TABLE IF NOT EXISTS charges (
+    subscription_id UUID NOT NULL REFERENCES subscriptions(subscription_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    payer_id UUID NOT NULL,
+    month DATE NOT NULL CHECK (month = date_trunc('month', month)),
+    kind TEXT NOT NULL CHECK (kind IN ('subscription', 'discount')),
+    discount_id UUID REFERENCES subscription_discounts(discount_id) ON DELETE CASCADE,
+    actual BOOLEAN NOT NULL DEFAULT false,
+    amount INTEGER NOT NULL,
+    currency TEXT NOT NULL DEFAULT 'RUB'
+);
+
+CREATE INDEX idx_charges_subscription_id ON charges(subscription_id);
+
+CREATE INDEX idx_charges_user_id_month ON charges(user_id, month) include (amount);
+
+CREATE INDEX idx_charges_payer_id_month ON charges(payer_id, month);
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    audit_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    actor TEXT NOT NULL,
+    request_id TEXT NOT NULL,
+    operation TEXT NOT NULL,
+    subscription_id UUID,
+    user_id UUID,
+    before JSONB,
+    after JSONB,
+    details JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_audit_log_created_at ON audit_log(created_at);
+CREATE INDEX idx_audit_log_subscription_id ON audit_log(subscription_id, created_at);
+CREATE INDEX idx_audit_log_user_id ON audit_log(user_id, created_at);
+
+CREATE TABLE IF NOT EXISTS budgets (
+    budget_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    scope TEXT NOT NULL CHECK (scope IN ('total', 'category', 'service')),
+    target TEXT,
+    monthly_limit INTEGER NOT NULL CHECK (monthly_limit > 0),
+    thresholds INTEGER[] NOT NULL DEFAULT '{80,100}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK ((scope = 'total') = (target IS NULL))
+);
+
+CREATE UNIQUE INDEX idx_budgets_scope ON budgets(user_id, scope, lower(coalesce(target, '')));
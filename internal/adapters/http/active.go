@@ -0,0 +1,59 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+)
+
+func (s *Server) GetUsersIdActive(
+	ctx context.Context,
+	request oapi.GetUsersIdActiveRequestObject,
+) (oapi.GetUsersIdActiveResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get active set.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	date := time.Now()
+	if request.Params.Date != nil {
+		var err error
+		date, err = time.Parse("01-2006", *request.Params.Date)
+		if err != nil {
+			slog.ErrorContext(ctx, "Invalid date format.", log.ErrorAttr(err), log.RequestID(ctx))
+			return oapi.GetUsersIdActive400JSONResponse{
+				Message: "Неверный формат даты",
+			}, nil
+		}
+	}
+
+	set, err := s.subscriptions.ActiveSet(ctx, request.Id, date)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Active set did not get. Failed to read active subscriptions.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetUsersIdActive400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	response := oapi.GetUsersIdActive200JSONResponse{
+		Id:            set.UserID,
+		Month:         set.Month.Format("01-2006"),
+		Subscriptions: make([]oapi.Subscription, 0, len(set.Subscriptions)),
+		Total:         set.Total,
+	}
+	for _, subscription := range set.Subscriptions {
+		response.Subscriptions = append(response.Subscriptions, toAPISubscription(subscription))
+	}
+
+	slog.InfoContext(ctx, "Active set successfully read.", log.RequestID(ctx))
+	return response, nil
+}
@@ -0,0 +1,131 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+)
+
+func (s *Server) GetSubscriptionsSubscriptionIdActuals(
+	ctx context.Context,
+	request oapi.GetSubscriptionsSubscriptionIdActualsRequestObject,
+) (oapi.GetSubscriptionsSubscriptionIdActualsResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to list actual charges.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	actuals, err := s.subscriptions.ListActuals(ctx, request.SubscriptionId)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Actual charges did not get. Failed to list actual charges.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetSubscriptionsSubscriptionIdActuals400JSONResponse{
+			Message: "Ошибка получения списаний",
+		}, nil
+	}
+
+	response := oapi.GetSubscriptionsSubscriptionIdActuals200JSONResponse{}
+	for _, actual := range actuals {
+		response = append(response, oapi.ActualCharge{
+			Month:  actual.Month.Format("01-2006"),
+			Amount: actual.Amount,
+		})
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Actual charges successfully got.",
+		log.RequestID(ctx),
+		slog.Any("response", response),
+	)
+
+	return response, nil
+}
+
+func (s *Server) PutSubscriptionsSubscriptionIdActualsMonth(
+	ctx context.Context,
+	request oapi.PutSubscriptionsSubscriptionIdActualsMonthRequestObject,
+) (oapi.PutSubscriptionsSubscriptionIdActualsMonthResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to set actual charge.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	month, err := time.Parse("01-2006", request.Month)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid month format.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.PutSubscriptionsSubscriptionIdActualsMonth400JSONResponse{
+			Message: "Неверный формат месяца",
+		}, nil
+	}
+
+	err = s.subscriptions.SetActual(ctx, domain.ActualCharge{
+		SubscriptionID: request.SubscriptionId,
+		Month:          month,
+		Amount:         request.Body.Amount,
+	})
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Actual charge did not set. Failed to set actual charge.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PutSubscriptionsSubscriptionIdActualsMonth400JSONResponse{
+			Message: "Ошибка записи списания",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Actual charge successfully set.", log.RequestID(ctx))
+
+	return oapi.PutSubscriptionsSubscriptionIdActualsMonth200JSONResponse{
+		Message: "Списание записано",
+	}, nil
+}
+
+func (s *Server) DeleteSubscriptionsSubscriptionIdActualsMonth(
+	ctx context.Context,
+	request oapi.DeleteSubscriptionsSubscriptionIdActualsMonthRequestObject,
+) (oapi.DeleteSubscriptionsSubscriptionIdActualsMonthResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to delete actual charge.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	month, err := time.Parse("01-2006", request.Month)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid month format.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.DeleteSubscriptionsSubscriptionIdActualsMonth400JSONResponse{
+			Message: "Неверный формат месяца",
+		}, nil
+	}
+
+	if err := s.subscriptions.DeleteActual(ctx, request.SubscriptionId, month); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Actual charge did not delete. Failed to delete actual charge.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.DeleteSubscriptionsSubscriptionIdActualsMonth400JSONResponse{
+			Message: "Ошибка удаления списания",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Actual charge successfully deleted.", log.RequestID(ctx))
+
+	return oapi.DeleteSubscriptionsSubscriptionIdActualsMonth200JSONResponse{
+		Message: "Списание удалено",
+	}, nil
+}
@@ -0,0 +1,162 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+
+	"github.com/google/uuid"
+)
+
+//nolint:dupl  // Same same but different.
+func (s *Server) PostAdminServicesRename(
+	ctx context.Context,
+	request oapi.PostAdminServicesRenameRequestObject,
+) (oapi.PostAdminServicesRenameResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to rename service.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	rewrite, err := s.admin.RenameService(ctx, request.Body.From, request.Body.To)
+	if errors.Is(err, domain.ErrServiceRewriteConflicts) {
+		slog.WarnContext(ctx, "Service did not rename. Overlaps found.", log.RequestID(ctx))
+		return oapi.PostAdminServicesRename409JSONResponse{
+			Message:   "Переименование создаст пересекающиеся подписки",
+			Conflicts: toAPIServiceConflicts(rewrite.Conflicts),
+		}, nil
+	}
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Service did not rename. Failed to rename service.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PostAdminServicesRename400JSONResponse{
+			Message: "Ошибка переименования сервиса",
+		}, nil
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Service successfully renamed.",
+		log.RequestID(ctx), slog.Int64("updated", rewrite.Updated),
+	)
+
+	return oapi.PostAdminServicesRename200JSONResponse{
+		Message: "Сервис переименован",
+		Updated: rewrite.Updated,
+	}, nil
+}
+
+//nolint:dupl  // Same same but different.
+func (s *Server) PostAdminServicesMerge(
+	ctx context.Context,
+	request oapi.PostAdminServicesMergeRequestObject,
+) (oapi.PostAdminServicesMergeResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to merge services.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	rewrite, err := s.admin.MergeServices(ctx, request.Body.Source, request.Body.Target)
+	if errors.Is(err, domain.ErrServiceRewriteConflicts) {
+		slog.WarnContext(ctx, "Services did not merge. Overlaps found.", log.RequestID(ctx))
+		return oapi.PostAdminServicesMerge409JSONResponse{
+			Message:   "Слияние создаст пересекающиеся подписки",
+			Conflicts: toAPIServiceConflicts(rewrite.Conflicts),
+		}, nil
+	}
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Services did not merge. Failed to merge services.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PostAdminServicesMerge400JSONResponse{
+			Message: "Ошибка слияния сервисов",
+		}, nil
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Services successfully merged.",
+		log.RequestID(ctx), slog.Int64("updated", rewrite.Updated),
+	)
+
+	return oapi.PostAdminServicesMerge200JSONResponse{
+		Message: "Сервисы объединены",
+		Updated: rewrite.Updated,
+	}, nil
+}
+
+func (s *Server) PostAdminUsersTransfer(
+	ctx context.Context,
+	request oapi.PostAdminUsersTransferRequestObject,
+) (oapi.PostAdminUsersTransferResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to transfer user.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	transfer := domain.UserTransfer{Source: request.Body.Source, Target: request.Body.Target}
+	if request.Body.Strategy != nil {
+		transfer.Strategy = domain.TransferStrategy(*request.Body.Strategy)
+	}
+
+	result, err := s.admin.TransferUser(ctx, transfer)
+	if errors.Is(err, domain.ErrServiceRewriteConflicts) {
+		slog.WarnContext(ctx, "User did not transfer. Overlaps found.", log.RequestID(ctx))
+		return oapi.PostAdminUsersTransfer409JSONResponse{
+			Message:   "Перенос создаст пересекающиеся подписки",
+			Conflicts: toAPIServiceConflicts(result.Conflicts),
+		}, nil
+	}
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"User did not transfer. Failed to transfer subscriptions.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PostAdminUsersTransfer400JSONResponse{
+			Message: "Ошибка переноса подписок",
+		}, nil
+	}
+
+	slog.InfoContext(
+		ctx,
+		"User successfully transferred.",
+		log.RequestID(ctx), slog.Int64("moved", result.Moved), slog.Int("trashed", len(result.Trashed)),
+	)
+
+	trashed := make([]uuid.UUID, 0, len(result.Trashed))
+	trashed = append(trashed, result.Trashed...)
+	return oapi.PostAdminUsersTransfer200JSONResponse{
+		Message: "Подписки перенесены",
+		Moved:   result.Moved,
+		Trashed: trashed,
+	}, nil
+}
+
+func toAPIServiceConflicts(conflicts []domain.ServiceConflict) []oapi.ServiceConflict {
+	apiConflicts := make([]oapi.ServiceConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		apiConflicts = append(apiConflicts, oapi.ServiceConflict{
+			UserId:               conflict.UserID,
+			SourceSubscriptionId: conflict.SourceID,
+			TargetSubscriptionId: conflict.TargetID,
+		})
+	}
+
+	return apiConflicts
+}
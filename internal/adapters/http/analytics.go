@@ -0,0 +1,277 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"log/slog"
+	"strconv"
+	"time"
+
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+	"ef_project/internal/infra/pointer"
+)
+
+func (s *Server) GetAdminAnalyticsTopServices(
+	ctx context.Context,
+	request oapi.GetAdminAnalyticsTopServicesRequestObject,
+) (oapi.GetAdminAnalyticsTopServicesResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get top services.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	month := time.Now()
+	if request.Params.Month != nil {
+		var err error
+		month, err = time.Parse("01-2006", *request.Params.Month)
+		if err != nil {
+			slog.ErrorContext(ctx, "Invalid month format.", log.ErrorAttr(err), log.RequestID(ctx))
+			return oapi.GetAdminAnalyticsTopServices400JSONResponse{
+				Message: "Неверный формат месяца",
+			}, nil
+		}
+	}
+
+	services, err := s.analytics.TopServices(ctx, month, pointer.Deref(request.Params.Limit))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get top services.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAnalyticsTopServices400JSONResponse{
+			Message: "Ошибка получения аналитики",
+		}, nil
+	}
+
+	response := make(oapi.GetAdminAnalyticsTopServices200JSONResponse, 0, len(services))
+	for _, service := range services {
+		response = append(response, oapi.ServiceSubscribers{Name: service.Name, Subscribers: service.Subscribers})
+	}
+	return response, nil
+}
+
+func (s *Server) GetAdminAnalyticsRevenue(
+	ctx context.Context,
+	request oapi.GetAdminAnalyticsRevenueRequestObject,
+) (oapi.GetAdminAnalyticsRevenueResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get revenue.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	period, message, err := toPeriod(request.Params.StartDate, request.Params.EndDate)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid period.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAnalyticsRevenue400JSONResponse{Message: message}, nil
+	}
+
+	revenue, err := s.analytics.Revenue(ctx, period)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get revenue.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAnalyticsRevenue400JSONResponse{
+			Message: "Ошибка получения аналитики",
+		}, nil
+	}
+
+	response := make(oapi.GetAdminAnalyticsRevenue200JSONResponse, 0, len(revenue))
+	for _, service := range revenue {
+		response = append(response, oapi.ServiceRevenue{
+			Name:    service.Name,
+			Month:   service.Month.Format("01-2006"),
+			Revenue: service.Revenue,
+		})
+	}
+	return response, nil
+}
+
+func (s *Server) GetAdminAnalyticsPrices(
+	ctx context.Context,
+	request oapi.GetAdminAnalyticsPricesRequestObject,
+) (oapi.GetAdminAnalyticsPricesResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get prices.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	prices, err := s.analytics.Prices(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get prices.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAnalyticsPrices400JSONResponse{
+			Message: "Ошибка получения аналитики",
+		}, nil
+	}
+
+	response := make(oapi.GetAdminAnalyticsPrices200JSONResponse, 0, len(prices))
+	for _, price := range prices {
+		response = append(response, oapi.ServicePrice{
+			Name:          price.Name,
+			Subscriptions: price.Subscriptions,
+			Average:       price.Average,
+			Median:        price.Median,
+		})
+	}
+	return response, nil
+}
+
+func (s *Server) GetAdminAnalyticsFlow(
+	ctx context.Context,
+	request oapi.GetAdminAnalyticsFlowRequestObject,
+) (oapi.GetAdminAnalyticsFlowResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get subscription flow.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	period, message, err := toPeriod(request.Params.StartDate, request.Params.EndDate)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid period.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAnalyticsFlow400JSONResponse{Message: message}, nil
+	}
+
+	flow, err := s.analytics.Flow(ctx, period)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get subscription flow.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAnalyticsFlow400JSONResponse{
+			Message: "Ошибка получения аналитики",
+		}, nil
+	}
+
+	response := make(oapi.GetAdminAnalyticsFlow200JSONResponse, 0, len(flow))
+	for _, month := range flow {
+		response = append(response, oapi.SubscriptionFlow{
+			Month:   month.Month.Format("01-2006"),
+			New:     month.New,
+			Churned: month.Churned,
+		})
+	}
+	return response, nil
+}
+
+func (s *Server) GetAdminAnalyticsChurn(
+	ctx context.Context,
+	request oapi.GetAdminAnalyticsChurnRequestObject,
+) (oapi.GetAdminAnalyticsChurnResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get churn.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	period, message, err := toPeriod(request.Params.StartDate, request.Params.EndDate)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid period.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAnalyticsChurn400JSONResponse{Message: message}, nil
+	}
+
+	churn, err := s.analytics.Churn(ctx, period, request.Params.Name)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get churn.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAnalyticsChurn400JSONResponse{
+			Message: "Ошибка получения аналитики",
+		}, nil
+	}
+
+	response := make(oapi.GetAdminAnalyticsChurn200JSONResponse, 0, len(churn))
+	for _, service := range churn {
+		reasons := make(map[string]int, len(service.Reasons))
+		for code, count := range service.Reasons {
+			reasons[string(code)] = count
+		}
+		response = append(response, oapi.ServiceChurn{
+			Name:            service.Name,
+			Month:           service.Month.Format("01-2006"),
+			Active:          service.Active,
+			Churned:         service.Churned,
+			Rate:            service.Rate,
+			AverageLifetime: service.AverageLifetime,
+			Reasons:         reasons,
+		})
+	}
+	return response, nil
+}
+
+func (s *Server) GetAdminAnalyticsRetention(
+	ctx context.Context,
+	request oapi.GetAdminAnalyticsRetentionRequestObject,
+) (oapi.GetAdminAnalyticsRetentionResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get retention.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	period, message, err := toPeriod(request.Params.StartDate, request.Params.EndDate)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid period.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAnalyticsRetention400JSONResponse{Message: message}, nil
+	}
+
+	cohorts, err := s.analytics.Retention(ctx, period, request.Params.Name)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get retention.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAnalyticsRetention400JSONResponse{
+			Message: "Ошибка получения аналитики",
+		}, nil
+	}
+
+	response := make(oapi.GetAdminAnalyticsRetention200JSONResponse, 0, len(cohorts))
+	for _, cohort := range cohorts {
+		response = append(response, oapi.Cohort{
+			Name:      cohort.Name,
+			Month:     cohort.Month.Format("01-2006"),
+			Size:      cohort.Size,
+			Retained:  cohort.Retained,
+			Retention: cohort.Retention,
+		})
+	}
+
+	switch pointer.Deref(request.Params.Format) {
+	case oapi.GetAdminAnalyticsRetentionParamsFormatJson, "":
+		return response, nil
+	case oapi.GetAdminAnalyticsRetentionParamsFormatCsv:
+		var body bytes.Buffer
+		if err := writeRetentionCSV(&body, response); err != nil {
+			slog.ErrorContext(ctx, "Rendering retention failed.", log.ErrorAttr(err), log.RequestID(ctx))
+			return oapi.GetAdminAnalyticsRetention400JSONResponse{Message: "Ошибка получения аналитики"}, nil
+		}
+		return oapi.GetAdminAnalyticsRetention200TextcsvResponse{
+			Body:          &body,
+			ContentLength: int64(body.Len()),
+		}, nil
+	}
+
+	return oapi.GetAdminAnalyticsRetention400JSONResponse{
+		Message: "Неизвестный формат отчёта",
+	}, nil
+}
+
+// writeRetentionCSV writes a row per cohort with its retention after 0, 1, ...
+// months, as wide as the longest row.
+func writeRetentionCSV(buffer *bytes.Buffer, cohorts []oapi.Cohort) error {
+	var width int
+	for _, cohort := range cohorts {
+		width = max(width, len(cohort.Retention))
+	}
+
+	writer := csv.NewWriter(buffer)
+	header := []string{"name", "month", "size"}
+	for n := range width {
+		header = append(header, "month_"+strconv.Itoa(n))
+	}
+	rows := [][]string{header}
+	for _, cohort := range cohorts {
+		row := []string{cohort.Name, cohort.Month, strconv.Itoa(cohort.Size)}
+		for _, retention := range cohort.Retention {
+			row = append(row, strconv.FormatFloat(retention, 'f', 4, 64))
+		}
+		rows = append(rows, row)
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
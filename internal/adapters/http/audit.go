@@ -0,0 +1,132 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+	"ef_project/internal/infra/pointer"
+)
+
+func (s *Server) GetSubscriptionsSubscriptionIdHistory(
+	ctx context.Context,
+	request oapi.GetSubscriptionsSubscriptionIdHistoryRequestObject,
+) (oapi.GetSubscriptionsSubscriptionIdHistoryResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get subscription history.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	entries, err := s.audit.History(ctx, request.SubscriptionId)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get subscription history.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetSubscriptionsSubscriptionIdHistory400JSONResponse{
+			Message: "Ошибка получения истории подписки",
+		}, nil
+	}
+
+	response := make(oapi.GetSubscriptionsSubscriptionIdHistory200JSONResponse, 0, len(entries))
+	for _, entry := range entries {
+		response = append(response, toAPIAuditRecord(entry))
+	}
+	return response, nil
+}
+
+func (s *Server) GetAdminAudit(
+	ctx context.Context,
+	request oapi.GetAdminAuditRequestObject,
+) (oapi.GetAdminAuditResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to search audit log.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	entries, err := s.audit.Search(ctx, domain.AuditFilter{
+		Actor:          request.Params.Actor,
+		Operation:      request.Params.Operation,
+		UserID:         request.Params.UserId,
+		SubscriptionID: request.Params.SubscriptionId,
+		From:           request.Params.From,
+		To:             request.Params.To,
+		Limit:          pointer.Deref(request.Params.Limit),
+		Offset:         pointer.Deref(request.Params.Offset),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to search audit log.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAudit400JSONResponse{
+			Message: "Ошибка поиска по журналу аудита",
+		}, nil
+	}
+
+	response := make(oapi.GetAdminAudit200JSONResponse, 0, len(entries))
+	for _, entry := range entries {
+		response = append(response, toAPIAuditRecord(entry))
+	}
+	return response, nil
+}
+
+func (s *Server) PostUsersIdUndo(
+	ctx context.Context,
+	request oapi.PostUsersIdUndoRequestObject,
+) (oapi.PostUsersIdUndoResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to undo last change.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	undone, err := s.subscriptions.Undo(ctx, request.Id)
+	switch {
+	case errors.Is(err, domain.ErrUndoConflict):
+		slog.WarnContext(ctx, "Change did not undo. Subscription changed since.", log.RequestID(ctx))
+		return oapi.PostUsersIdUndo409JSONResponse{
+			Message: "Подписка изменилась после отменяемого изменения",
+		}, nil
+	case errors.Is(err, domain.ErrNothingToUndo):
+		slog.WarnContext(ctx, "Change did not undo. Nothing to undo.", log.RequestID(ctx))
+		return oapi.PostUsersIdUndo400JSONResponse{
+			Message: "Нет изменений для отмены",
+		}, nil
+	case err != nil:
+		slog.ErrorContext(
+			ctx,
+			"Change did not undo. Failed to undo change.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PostUsersIdUndo400JSONResponse{
+			Message: "Ошибка отмены изменения",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Change successfully undone.", log.RequestID(ctx), slog.String("operation", undone.Operation))
+	return oapi.PostUsersIdUndo200JSONResponse(toAPIAuditRecord(undone)), nil
+}
+
+func toAPIAuditRecord(entry domain.AuditEntry) oapi.AuditRecord {
+	record := oapi.AuditRecord{
+		AuditId:        entry.ID,
+		Actor:          entry.Actor,
+		RequestId:      entry.RequestID,
+		Operation:      entry.Operation,
+		SubscriptionId: entry.SubscriptionID,
+		UserId:         entry.UserID,
+		CreatedAt:      entry.CreatedAt,
+	}
+	// Snapshots are passed through as stored, a missing one is left out.
+	if len(entry.Before) > 0 {
+		record.Before = entry.Before
+	}
+	if len(entry.After) > 0 {
+		record.After = entry.After
+	}
+	if len(entry.Details) > 0 {
+		record.Details = entry.Details
+	}
+	return record
+}
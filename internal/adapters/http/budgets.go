@@ -0,0 +1,170 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+	"ef_project/internal/infra/pointer"
+)
+
+func (s *Server) GetUsersIdBudgets(
+	ctx context.Context,
+	request oapi.GetUsersIdBudgetsRequestObject,
+) (oapi.GetUsersIdBudgetsResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to list budgets.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	budgets, err := s.budgets.List(ctx, request.Id)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Budgets did not get. Failed to list budgets.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetUsersIdBudgets400JSONResponse{
+			Message: "Ошибка получения бюджетов",
+		}, nil
+	}
+
+	response := oapi.GetUsersIdBudgets200JSONResponse{}
+	for _, budget := range budgets {
+		response = append(response, toAPIBudget(budget))
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Budgets successfully got.",
+		log.RequestID(ctx),
+		slog.Any("response", response),
+	)
+
+	return response, nil
+}
+
+func (s *Server) PostUsersIdBudgets(
+	ctx context.Context,
+	request oapi.PostUsersIdBudgetsRequestObject,
+) (oapi.PostUsersIdBudgetsResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to create budget.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	budget := domain.Budget{
+		UserID: request.Id,
+		Scope:  domain.BudgetScope(request.Body.Scope),
+		Target: request.Body.Target,
+		Limit:  request.Body.Limit,
+	}
+	if request.Body.Thresholds != nil {
+		budget.Thresholds = *request.Body.Thresholds
+	}
+	budget, err := s.budgets.Create(ctx, budget)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Budget did not create. Failed to create budget.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PostUsersIdBudgets400JSONResponse{
+			Message: "Ошибка создания бюджета",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Budget successfully created.", log.RequestID(ctx))
+
+	return oapi.PostUsersIdBudgets201JSONResponse(toAPIBudget(budget)), nil
+}
+
+func (s *Server) DeleteUsersIdBudgetsBudgetId(
+	ctx context.Context,
+	request oapi.DeleteUsersIdBudgetsBudgetIdRequestObject,
+) (oapi.DeleteUsersIdBudgetsBudgetIdResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to delete budget.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	if err := s.budgets.Delete(ctx, request.Id, request.BudgetId); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Budget did not delete. Failed to delete budget.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.DeleteUsersIdBudgetsBudgetId400JSONResponse{
+			Message: "Ошибка удаления бюджета",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Budget successfully deleted.", log.RequestID(ctx))
+
+	return oapi.DeleteUsersIdBudgetsBudgetId200JSONResponse{
+		Message: "Бюджет удалён",
+	}, nil
+}
+
+func (s *Server) GetUsersIdBudgetsStatus(
+	ctx context.Context,
+	request oapi.GetUsersIdBudgetsStatusRequestObject,
+) (oapi.GetUsersIdBudgetsStatusResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to evaluate budgets.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	statuses, err := s.budgets.Status(ctx, request.Id, time.Now())
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Budgets did not evaluate. Failed to evaluate budgets.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetUsersIdBudgetsStatus400JSONResponse{
+			Message: "Ошибка расчёта бюджетов",
+		}, nil
+	}
+
+	response := oapi.GetUsersIdBudgetsStatus200JSONResponse{}
+	for _, status := range statuses {
+		response = append(response, oapi.BudgetStatus{
+			Budget:              toAPIBudget(status.Budget),
+			Spent:               status.Spent,
+			Forecast:            status.Forecast,
+			Utilisation:         status.Utilisation,
+			ForecastUtilisation: status.ForecastUtilisation,
+		})
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Budgets successfully evaluated.",
+		log.RequestID(ctx),
+		slog.Any("response", response),
+	)
+
+	return response, nil
+}
+
+func toAPIBudget(budget domain.Budget) oapi.Budget {
+	return oapi.Budget{
+		BudgetId:   pointer.Ref(budget.ID),
+		Scope:      oapi.BudgetScope(budget.Scope),
+		Target:     budget.Target,
+		Limit:      budget.Limit,
+		Thresholds: pointer.Ref(budget.Thresholds),
+	}
+}
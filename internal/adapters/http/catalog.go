@@ -0,0 +1,246 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+	"ef_project/internal/infra/pointer"
+)
+
+func (s *Server) GetServices(
+	ctx context.Context,
+	request oapi.GetServicesRequestObject,
+) (oapi.GetServicesResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to list service catalog.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	entries, err := s.catalog.List(ctx)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Catalog did not get. Failed to list catalog.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetServices400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	response := oapi.GetServices200JSONResponse{}
+	for _, entry := range entries {
+		response = append(response, toAPICatalogService(entry))
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Catalog successfully got.",
+		log.RequestID(ctx),
+		slog.Any("response", response),
+	)
+
+	return response, nil
+}
+
+func (s *Server) PostServices(
+	ctx context.Context,
+	request oapi.PostServicesRequestObject,
+) (oapi.PostServicesResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to create catalog entry.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	entry, err := s.catalog.Create(ctx, fromAPICatalogService(*request.Body))
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Catalog entry did not create. Failed to create catalog entry.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PostServices400JSONResponse{
+			Message: "Ошибка добавления сервиса",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Catalog entry successfully created.", log.RequestID(ctx))
+
+	return oapi.PostServices201JSONResponse(toAPICatalogService(entry)), nil
+}
+
+func (s *Server) GetServicesSearch(
+	ctx context.Context,
+	request oapi.GetServicesSearchRequestObject,
+) (oapi.GetServicesSearchResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to search service catalog.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	limit := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	matches, err := s.catalog.Search(ctx, request.Params.Q, limit)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Catalog search failed.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetServicesSearch400JSONResponse{
+			Message: "Ошибка поиска сервисов",
+		}, nil
+	}
+
+	response := oapi.GetServicesSearch200JSONResponse{}
+	for _, match := range matches {
+		response = append(response, toAPICatalogMatch(match))
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Catalog search successfully done.",
+		log.RequestID(ctx),
+		slog.Any("response", response),
+	)
+
+	return response, nil
+}
+
+func (s *Server) GetServicesServiceId(
+	ctx context.Context,
+	request oapi.GetServicesServiceIdRequestObject,
+) (oapi.GetServicesServiceIdResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get catalog entry.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	entry, err := s.catalog.Get(ctx, request.ServiceId)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Catalog entry did not get. Failed to get catalog entry.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetServicesServiceId400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	response := oapi.GetServicesServiceId200JSONResponse(toAPICatalogService(entry))
+
+	slog.InfoContext(
+		ctx,
+		"Catalog entry successfully got.",
+		log.RequestID(ctx),
+		slog.Any("response", response),
+	)
+
+	return response, nil
+}
+
+func (s *Server) PutServicesServiceId(
+	ctx context.Context,
+	request oapi.PutServicesServiceIdRequestObject,
+) (oapi.PutServicesServiceIdResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to update catalog entry.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	entry := fromAPICatalogService(*request.Body)
+	entry.ID = request.ServiceId
+	if err := s.catalog.Update(ctx, entry); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Catalog entry did not update. Failed to update catalog entry.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PutServicesServiceId400JSONResponse{
+			Message: "Ошибка обновления сервиса",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Catalog entry successfully updated.", log.RequestID(ctx))
+
+	return oapi.PutServicesServiceId200JSONResponse{
+		Message: "Сервис обновлён",
+	}, nil
+}
+
+func (s *Server) DeleteServicesServiceId(
+	ctx context.Context,
+	request oapi.DeleteServicesServiceIdRequestObject,
+) (oapi.DeleteServicesServiceIdResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to delete catalog entry.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	if err := s.catalog.Delete(ctx, request.ServiceId); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Catalog entry did not delete. Failed to delete catalog entry.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.DeleteServicesServiceId400JSONResponse{
+			Message: "Ошибка удаления сервиса",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Catalog entry successfully deleted.", log.RequestID(ctx))
+
+	return oapi.DeleteServicesServiceId200JSONResponse{
+		Message: "Сервис удалён",
+	}, nil
+}
+
+func toAPICatalogService(entry domain.CatalogEntry) oapi.CatalogService {
+	return oapi.CatalogService{
+		ServiceId:    pointer.Ref(entry.ID),
+		Name:         entry.Name,
+		Aliases:      pointer.Ref(entry.Aliases),
+		Category:     entry.Category,
+		DefaultPrice: entry.DefaultPrice,
+		Website:      entry.Website,
+	}
+}
+
+func toAPICatalogMatch(match domain.CatalogMatch) oapi.CatalogMatch {
+	return oapi.CatalogMatch{
+		Service: toAPICatalogService(match.CatalogEntry),
+		Score:   match.Score,
+	}
+}
+
+func fromAPICatalogService(service oapi.CatalogService) domain.CatalogEntry {
+	entry := domain.CatalogEntry{
+		Name:         service.Name,
+		Category:     service.Category,
+		DefaultPrice: service.DefaultPrice,
+		Website:      service.Website,
+	}
+	if service.Aliases != nil {
+		entry.Aliases = *service.Aliases
+	}
+
+	return entry
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+)
+
+func (s *Server) GetSubscriptionsCompare(
+	ctx context.Context,
+	request oapi.GetSubscriptionsCompareRequestObject,
+) (oapi.GetSubscriptionsCompareResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to compare periods.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	params := request.Params
+	base, message, err := toCostQuery(params.Id, params.Name, params.BaseStart, params.BaseEnd, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid base period.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetSubscriptionsCompare400JSONResponse{Message: message}, nil
+	}
+	compare, message, err := toCostQuery(params.Id, params.Name, params.CompareStart, params.CompareEnd, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid compared period.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetSubscriptionsCompare400JSONResponse{Message: message}, nil
+	}
+
+	comparison, err := s.subscriptions.ComparePeriods(ctx, domain.ComparisonQuery{
+		UserID:  base.UserID,
+		Name:    base.Name,
+		Base:    domain.Period{Start: base.Start, End: base.End},
+		Compare: domain.Period{Start: compare.Start, End: compare.End},
+	})
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Periods did not compare. Failed to compare periods.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetSubscriptionsCompare400JSONResponse{
+			Message: "Ошибка сравнения периодов",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Periods successfully compared.", log.RequestID(ctx))
+
+	return oapi.GetSubscriptionsCompare200JSONResponse{
+		BaseTotal:     comparison.BaseTotal,
+		CompareTotal:  comparison.CompareTotal,
+		Delta:         comparison.Delta,
+		PercentChange: comparison.PercentChange,
+		Added:         toAPISubscriptionChanges(comparison.Added),
+		Removed:       toAPISubscriptionChanges(comparison.Removed),
+		Repriced:      toAPISubscriptionChanges(comparison.Repriced),
+	}, nil
+}
+
+func toAPISubscriptionChanges(changes []domain.SubscriptionChange) []oapi.SubscriptionChange {
+	apiChanges := make([]oapi.SubscriptionChange, 0, len(changes))
+	for _, change := range changes {
+		apiChanges = append(apiChanges, oapi.SubscriptionChange{
+			Id:           change.UserID,
+			Name:         change.Name,
+			BaseCost:     change.BaseCost,
+			CompareCost:  change.CompareCost,
+			BaseTotal:    change.BaseTotal,
+			CompareTotal: change.CompareTotal,
+		})
+	}
+	return apiChanges
+}
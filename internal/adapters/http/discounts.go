@@ -0,0 +1,203 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+	"ef_project/internal/infra/pointer"
+)
+
+func (s *Server) GetSubscriptionsSubscriptionIdDiscounts(
+	ctx context.Context,
+	request oapi.GetSubscriptionsSubscriptionIdDiscountsRequestObject,
+) (oapi.GetSubscriptionsSubscriptionIdDiscountsResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to list discounts.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	discounts, err := s.subscriptions.ListDiscounts(ctx, request.SubscriptionId)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Discounts did not get. Failed to list discounts.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetSubscriptionsSubscriptionIdDiscounts400JSONResponse{
+			Message: "Ошибка получения скидок",
+		}, nil
+	}
+
+	response := oapi.GetSubscriptionsSubscriptionIdDiscounts200JSONResponse{}
+	for _, discount := range discounts {
+		response = append(response, toAPIDiscount(discount))
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Discounts successfully got.",
+		log.RequestID(ctx),
+		slog.Any("response", response),
+	)
+
+	return response, nil
+}
+
+func (s *Server) PostSubscriptionsSubscriptionIdDiscounts(
+	ctx context.Context,
+	request oapi.PostSubscriptionsSubscriptionIdDiscountsRequestObject,
+) (oapi.PostSubscriptionsSubscriptionIdDiscountsResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to add discount.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	discount := domain.Discount{
+		SubscriptionID: request.SubscriptionId,
+		Kind:           domain.DiscountKind(request.Body.Kind),
+		Value:          request.Body.Value,
+		Months:         request.Body.Months,
+	}
+	if request.Body.StartDate != nil {
+		startDate, err := time.Parse("01-2006", *request.Body.StartDate)
+		if err != nil {
+			slog.ErrorContext(ctx, "Invalid start date format.", log.ErrorAttr(err), log.RequestID(ctx))
+			return oapi.PostSubscriptionsSubscriptionIdDiscounts400JSONResponse{
+				Message: "Неверный формат даты начала",
+			}, nil
+		}
+		discount.StartDate = startDate
+	}
+	if request.Body.EndDate != nil {
+		endDate, err := time.Parse("01-2006", *request.Body.EndDate)
+		if err != nil {
+			slog.ErrorContext(ctx, "Invalid end date format.", log.ErrorAttr(err), log.RequestID(ctx))
+			return oapi.PostSubscriptionsSubscriptionIdDiscounts400JSONResponse{
+				Message: "Неверный формат даты окончания",
+			}, nil
+		}
+		discount.EndDate = &endDate
+	}
+
+	discount, err := s.subscriptions.AddDiscount(ctx, discount)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Discount did not add. Failed to add discount.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PostSubscriptionsSubscriptionIdDiscounts400JSONResponse{
+			Message: "Ошибка добавления скидки",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Discount successfully added.", log.RequestID(ctx))
+
+	return oapi.PostSubscriptionsSubscriptionIdDiscounts201JSONResponse(toAPIDiscount(discount)), nil
+}
+
+func (s *Server) DeleteSubscriptionsSubscriptionIdDiscountsDiscountId(
+	ctx context.Context,
+	request oapi.DeleteSubscriptionsSubscriptionIdDiscountsDiscountIdRequestObject,
+) (oapi.DeleteSubscriptionsSubscriptionIdDiscountsDiscountIdResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to delete discount.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	if err := s.subscriptions.DeleteDiscount(ctx, request.SubscriptionId, request.DiscountId); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Discount did not delete. Failed to delete discount.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.DeleteSubscriptionsSubscriptionIdDiscountsDiscountId400JSONResponse{
+			Message: "Ошибка удаления скидки",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Discount successfully deleted.", log.RequestID(ctx))
+
+	return oapi.DeleteSubscriptionsSubscriptionIdDiscountsDiscountId200JSONResponse{
+		Message: "Скидка удалена",
+	}, nil
+}
+
+func (s *Server) GetSubscriptionsBreakdown(
+	ctx context.Context,
+	request oapi.GetSubscriptionsBreakdownRequestObject,
+) (oapi.GetSubscriptionsBreakdownResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to calculate cost breakdown.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	query, message, err := toCostQuery(
+		request.Params.Id,
+		request.Params.Name,
+		request.Params.StartDate,
+		request.Params.EndDate,
+		nil,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid cost query.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetSubscriptionsBreakdown400JSONResponse{Message: message}, nil
+	}
+	charges, err := s.subscriptions.Breakdown(ctx, query)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Breakdown did not calculate. Failed to calculate breakdown.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetSubscriptionsBreakdown400JSONResponse{
+			Message: "Ошибка подсчета расходов",
+		}, nil
+	}
+
+	response := oapi.GetSubscriptionsBreakdown200JSONResponse{Items: make([]oapi.BreakdownItem, 0, len(charges))}
+	for _, charge := range charges {
+		response.Items = append(response.Items, oapi.BreakdownItem{
+			Month:          charge.Month.Format("01-2006"),
+			SubscriptionId: charge.SubscriptionID,
+			Id:             charge.UserID,
+			Name:           charge.Name,
+			Kind:           oapi.BreakdownItemKind(charge.Kind),
+			DiscountId:     charge.DiscountID,
+			Actual:         pointer.Ref(charge.Actual),
+			OneTime:        pointer.Ref(charge.OneTime),
+			Amount:         charge.Amount,
+		})
+		response.Total += charge.Amount
+	}
+
+	slog.InfoContext(ctx, "Breakdown successfully calculated.", log.RequestID(ctx))
+
+	return response, nil
+}
+
+func toAPIDiscount(discount domain.Discount) oapi.Discount {
+	apiDiscount := oapi.Discount{
+		DiscountId: pointer.Ref(discount.ID),
+		Kind:       oapi.DiscountKind(discount.Kind),
+		Value:      discount.Value,
+		StartDate:  pointer.Ref(discount.StartDate.Format("01-2006")),
+	}
+	if discount.EndDate != nil {
+		apiDiscount.EndDate = pointer.Ref(discount.EndDate.Format("01-2006"))
+	}
+
+	return apiDiscount
+}
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+)
+
+func (s *Server) GetUsersIdInsights(
+	ctx context.Context,
+	request oapi.GetUsersIdInsightsRequestObject,
+) (oapi.GetUsersIdInsightsResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get price insights.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	month := time.Now()
+	if request.Params.Month != nil {
+		var err error
+		month, err = time.Parse("01-2006", *request.Params.Month)
+		if err != nil {
+			slog.ErrorContext(ctx, "Invalid month format.", log.ErrorAttr(err), log.RequestID(ctx))
+			return oapi.GetUsersIdInsights400JSONResponse{
+				Message: "Неверный формат месяца",
+			}, nil
+		}
+	}
+
+	insights, err := s.analytics.PriceInsights(ctx, request.Id, month)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get price insights.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetUsersIdInsights400JSONResponse{
+			Message: "Ошибка получения аналитики",
+		}, nil
+	}
+
+	return oapi.GetUsersIdInsights200JSONResponse(toAPIPriceAnomalies(insights)), nil
+}
+
+func (s *Server) GetAdminAnalyticsPriceAnomalies(
+	ctx context.Context,
+	request oapi.GetAdminAnalyticsPriceAnomaliesRequestObject,
+) (oapi.GetAdminAnalyticsPriceAnomaliesResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get price anomalies.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	period, message, err := toPeriod(request.Params.StartDate, request.Params.EndDate)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid period.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAnalyticsPriceAnomalies400JSONResponse{Message: message}, nil
+	}
+
+	anomalies, err := s.analytics.PriceAnomalies(ctx, period)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get price anomalies.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminAnalyticsPriceAnomalies400JSONResponse{
+			Message: "Ошибка получения аналитики",
+		}, nil
+	}
+
+	return oapi.GetAdminAnalyticsPriceAnomalies200JSONResponse(toAPIPriceAnomalies(anomalies)), nil
+}
+
+func toAPIPriceAnomalies(anomalies []domain.PriceAnomaly) []oapi.PriceAnomaly {
+	apiAnomalies := make([]oapi.PriceAnomaly, 0, len(anomalies))
+	for _, anomaly := range anomalies {
+		apiAnomalies = append(apiAnomalies, oapi.PriceAnomaly{
+			Kind:           oapi.PriceAnomalyKind(anomaly.Kind),
+			Name:           anomaly.Name,
+			Month:          anomaly.Month.Format("01-2006"),
+			SubscriptionId: anomaly.SubscriptionID,
+			UserId:         anomaly.UserID,
+			Price:          anomaly.Price,
+			Reference:      anomaly.Reference,
+			Ratio:          anomaly.Ratio,
+		})
+	}
+	return apiAnomalies
+}
@@ -0,0 +1,64 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+)
+
+func (s *Server) PostAdminLedgerRebuild(
+	ctx context.Context,
+	request oapi.PostAdminLedgerRebuildRequestObject,
+) (oapi.PostAdminLedgerRebuildResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to rebuild ledger.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	start, err := time.Parse("01-2006", request.Body.DateStart)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid start date format.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.PostAdminLedgerRebuild400JSONResponse{
+			Message: "Неверный формат даты начала",
+		}, nil
+	}
+	end := domain.LedgerHorizon(time.Now())
+	if request.Body.DateEnd != nil {
+		end, err = time.Parse("01-2006", *request.Body.DateEnd)
+		if err != nil {
+			slog.ErrorContext(ctx, "Invalid end date format.", log.ErrorAttr(err), log.RequestID(ctx))
+			return oapi.PostAdminLedgerRebuild400JSONResponse{
+				Message: "Неверный формат даты окончания",
+			}, nil
+		}
+	}
+
+	written, err := s.ledger.Rebuild(ctx, domain.LedgerRebuild{
+		UserID: request.Body.Id,
+		Start:  start,
+		End:    end,
+	})
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Ledger did not rebuild. Failed to rebuild ledger.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PostAdminLedgerRebuild400JSONResponse{
+			Message: "Ошибка пересчёта журнала списаний",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Ledger successfully rebuilt.", log.RequestID(ctx), slog.Int("written", written))
+
+	return oapi.PostAdminLedgerRebuild200JSONResponse{
+		Message: "Журнал списаний пересчитан",
+		Written: written,
+	}, nil
+}
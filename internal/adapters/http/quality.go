@@ -0,0 +1,79 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+)
+
+func (s *Server) GetAdminQuality(
+	ctx context.Context,
+	request oapi.GetAdminQualityRequestObject,
+) (oapi.GetAdminQualityResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to scan subscriptions for anomalies.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	anomalies, err := s.quality.Scan(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to scan subscriptions for anomalies.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetAdminQuality400JSONResponse{
+			Message: "Ошибка проверки подписок",
+		}, nil
+	}
+
+	return oapi.GetAdminQuality200JSONResponse(toAPIAnomalies(anomalies)), nil
+}
+
+func (s *Server) PostAdminQualityFix(
+	ctx context.Context,
+	request oapi.PostAdminQualityFixRequestObject,
+) (oapi.PostAdminQualityFixResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to fix subscription anomalies.",
+		log.RequestID(ctx), log.Actor(ctx), slog.Any("request", request),
+	)
+
+	fix, err := s.quality.Fix(ctx, request.Body.DryRun)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Anomalies did not fix. Failed to fix subscription anomalies.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PostAdminQualityFix400JSONResponse{
+			Message: "Ошибка исправления подписок",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Anomalies successfully fixed.", log.RequestID(ctx), slog.Bool("dry_run", fix.DryRun))
+
+	response := oapi.PostAdminQualityFix200JSONResponse{
+		Fixed:     make(map[string]int, len(fix.Fixed)),
+		Remaining: toAPIAnomalies(fix.Remaining),
+		DryRun:    fix.DryRun,
+	}
+	for anomaly, count := range fix.Fixed {
+		response.Fixed[string(anomaly)] = count
+	}
+	return response, nil
+}
+
+func toAPIAnomalies(anomalies []domain.Anomaly) []oapi.Anomaly {
+	apiAnomalies := make([]oapi.Anomaly, 0, len(anomalies))
+	for _, anomaly := range anomalies {
+		apiAnomalies = append(apiAnomalies, oapi.Anomaly{
+			Type:         oapi.AnomalyType(anomaly.Type),
+			Subscription: toAPISubscription(anomaly.Subscription),
+			ConflictId:   anomaly.ConflictID,
+		})
+	}
+	return apiAnomalies
+}
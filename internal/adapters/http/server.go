@@ -9,19 +9,42 @@ import (
 	oapi "ef_project/internal/generated/oapi"
 	"ef_project/internal/infra/log"
 	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
 )
 
 var _ oapi.StrictServerInterface = (*Server)(nil)
 
 type Server struct {
 	subscriptions domain.SubscriptionInterface
+	catalog       domain.CatalogInterface
+	admin         domain.AdminInterface
+	budgets       domain.BudgetInterface
+	ledger        domain.LedgerInterface
+	analytics     domain.AnalyticsInterface
+	audit         domain.AuditInterface
+	quality       domain.QualityInterface
 }
 
 func NewServer(
 	subscriptions domain.SubscriptionInterface,
+	catalog domain.CatalogInterface,
+	admin domain.AdminInterface,
+	budgets domain.BudgetInterface,
+	ledger domain.LedgerInterface,
+	analytics domain.AnalyticsInterface,
+	audit domain.AuditInterface,
+	quality domain.QualityInterface,
 ) *Server {
 	return &Server{
 		subscriptions: subscriptions,
+		catalog:       catalog,
+		admin:         admin,
+		budgets:       budgets,
+		ledger:        ledger,
+		analytics:     analytics,
+		audit:         audit,
+		quality:       quality,
 	}
 }
 
@@ -35,6 +58,29 @@ func (s *Server) GetSubscriptions(
 		log.RequestID(ctx), slog.Any("request", request),
 	)
 
+	if request.Params.AsOf != nil {
+		asOf, err := time.Parse("01-2006", *request.Params.AsOf)
+		if err != nil {
+			slog.ErrorContext(ctx, "Invalid as of date format.", log.ErrorAttr(err), log.RequestID(ctx))
+			return oapi.GetSubscriptions400JSONResponse{
+				Message: "Неверный формат даты",
+			}, nil
+		}
+		active, err := s.subscriptions.ReadActiveAt(ctx, *request.Params.Id, asOf)
+		if err != nil || len(active) == 0 {
+			slog.ErrorContext(
+				ctx,
+				"Subscriptions did not get. No subscription active at the date.",
+				log.ErrorAttr(err),
+				log.RequestID(ctx),
+			)
+			return oapi.GetSubscriptions400JSONResponse{
+				Message: "Нет активной подписки на дату",
+			}, nil
+		}
+		return oapi.GetSubscriptions200JSONResponse(toAPISubscription(active[len(active)-1])), nil
+	}
+
 	subscription, err := s.subscriptions.GetLatest(ctx, *request.Params.Id)
 	if err != nil {
 		slog.ErrorContext(
@@ -48,13 +94,7 @@ func (s *Server) GetSubscriptions(
 		}, nil
 	}
 
-	response := oapi.GetSubscriptions200JSONResponse{
-		Id:        subscription.UserID,
-		Name:      subscription.Name,
-		Cost:      subscription.Cost,
-		DateEnd:   pointer.Ref(subscription.EndDate.Format("01-2006")),
-		DateStart: subscription.StartDate.Format("01-2006"),
-	}
+	response := oapi.GetSubscriptions200JSONResponse(toAPISubscription(subscription))
 
 	slog.InfoContext(
 		ctx,
@@ -77,7 +117,22 @@ func (s *Server) GetAll(
 	)
 
 	var response oapi.GetAll200JSONResponse
-	subscriptionsByUserID, err := s.subscriptions.ReadAllByUserID(ctx, *request.Params.Id)
+	var (
+		subscriptionsByUserID []domain.Subscription
+		err                   error
+	)
+	if request.Params.AsOf != nil {
+		asOf, parseErr := time.Parse("01-2006", *request.Params.AsOf)
+		if parseErr != nil {
+			slog.ErrorContext(ctx, "Invalid as of date format.", log.ErrorAttr(parseErr), log.RequestID(ctx))
+			return oapi.GetAll400JSONResponse{
+				Message: "Неверный формат даты",
+			}, nil
+		}
+		subscriptionsByUserID, err = s.subscriptions.ReadActiveAt(ctx, *request.Params.Id, asOf)
+	} else {
+		subscriptionsByUserID, err = s.subscriptions.ReadAllByUserID(ctx, *request.Params.Id)
+	}
 	if err != nil {
 		slog.ErrorContext(
 			ctx,
@@ -91,13 +146,7 @@ func (s *Server) GetAll(
 	}
 
 	for _, curSubscription := range subscriptionsByUserID {
-		response = append(response, oapi.Subscription{
-			Cost:      curSubscription.Cost,
-			DateStart: curSubscription.StartDate.Format("01-2006"),
-			DateEnd:   pointer.Ref(curSubscription.EndDate.Format("01-2006")),
-			Id:        curSubscription.UserID,
-			Name:      curSubscription.Name,
-		})
+		response = append(response, toAPISubscription(curSubscription))
 	}
 
 	slog.InfoContext(
@@ -110,6 +159,73 @@ func (s *Server) GetAll(
 	return response, nil
 }
 
+func (s *Server) GetTrash(
+	ctx context.Context,
+	request oapi.GetTrashRequestObject,
+) (oapi.GetTrashResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get deleted subscriptions by user id.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	response := oapi.GetTrash200JSONResponse{}
+	deletedSubscriptions, err := s.subscriptions.ReadTrash(ctx, request.Params.Id)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Deleted subscriptions did not get. Failed to read trash.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetTrash400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	for _, curSubscription := range deletedSubscriptions {
+		response = append(response, toAPISubscription(curSubscription))
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Deleted subscriptions successfully got.",
+		log.RequestID(ctx),
+		slog.Any("response", response),
+	)
+
+	return response, nil
+}
+
+func (s *Server) PostSubscriptionsSubscriptionIdRestore(
+	ctx context.Context,
+	request oapi.PostSubscriptionsSubscriptionIdRestoreRequestObject,
+) (oapi.PostSubscriptionsSubscriptionIdRestoreResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to restore subscription.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	if err := s.subscriptions.Restore(ctx, request.SubscriptionId); err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Subscription did not restore. Failed to restore subscription.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PostSubscriptionsSubscriptionIdRestore400JSONResponse{
+			Message: "Ошибка восстановления подписки",
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Subscription successfully restored.", log.RequestID(ctx))
+
+	return oapi.PostSubscriptionsSubscriptionIdRestore200JSONResponse{
+		Message: "Подписка восстановлена",
+	}, nil
+}
+
 func (s *Server) DeleteSubscriptions(
 	ctx context.Context,
 	request oapi.DeleteSubscriptionsRequestObject,
@@ -140,37 +256,76 @@ func (s *Server) DeleteSubscriptions(
 	}, nil
 }
 
-func (s *Server) GetSubscriptionsTotalCost(
+func (s *Server) PostSubscriptionsCancel(
 	ctx context.Context,
-	request oapi.GetSubscriptionsTotalCostRequestObject,
-) (oapi.GetSubscriptionsTotalCostResponseObject, error) {
+	request oapi.PostSubscriptionsCancelRequestObject,
+) (oapi.PostSubscriptionsCancelResponseObject, error) {
 	slog.InfoContext(
 		ctx,
-		"Request to calculate total cost.",
+		"Request to cancel subscription.",
 		log.RequestID(ctx), slog.Any("request", request),
 	)
 
-	startDate, err := time.Parse("01-2006", request.Params.StartDate)
-	if err != nil {
-		slog.ErrorContext(ctx, "Invalid start date format.", log.ErrorAttr(err), log.RequestID(ctx))
-		return oapi.GetSubscriptionsTotalCost400JSONResponse{
-			Message: "Неверный формат даты начала",
-		}, nil
+	var endDate *time.Time
+	if request.Body.DateEnd != nil {
+		parsedEndDate, err := time.Parse("01-2006", *request.Body.DateEnd)
+		if err != nil {
+			slog.ErrorContext(ctx, "Invalid end date format.", log.ErrorAttr(err), log.RequestID(ctx))
+			return oapi.PostSubscriptionsCancel400JSONResponse{
+				Message: "Неверный формат даты окончания",
+			}, nil
+		}
+		endDate = &parsedEndDate
 	}
-	endDate, err := time.Parse("01-2006", request.Params.EndDate)
+
+	err := s.subscriptions.Cancel(ctx, domain.Cancellation{
+		UserID:  request.Body.Id,
+		Name:    request.Body.Name,
+		EndDate: endDate,
+		Code:    domain.CancellationCode(pointer.Deref(request.Body.ReasonCode)),
+		Reason:  request.Body.Reason,
+	})
 	if err != nil {
-		slog.ErrorContext(ctx, "Invalid end date format.", log.ErrorAttr(err), log.RequestID(ctx))
-		return oapi.GetSubscriptionsTotalCost400JSONResponse{
-			Message: "Неверный формат даты окончания",
+		slog.ErrorContext(
+			ctx,
+			"Subscription did not cancel. Failed to cancel subscription.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.PostSubscriptionsCancel400JSONResponse{
+			Message: "Ошибка отмены подписки",
 		}, nil
 	}
-	totalCost, err := s.subscriptions.TotalSubscriptionsCost(
+
+	slog.InfoContext(ctx, "Subscription successfully cancelled.", log.RequestID(ctx))
+
+	return oapi.PostSubscriptionsCancel200JSONResponse{
+		Message: "Подписка отменена",
+	}, nil
+}
+
+func (s *Server) GetSubscriptionsTotalCost(
+	ctx context.Context,
+	request oapi.GetSubscriptionsTotalCostRequestObject,
+) (oapi.GetSubscriptionsTotalCostResponseObject, error) {
+	slog.InfoContext(
 		ctx,
-		*request.Params.Id,
-		*request.Params.Name,
-		startDate,
-		pointer.Ref(endDate),
+		"Request to calculate total cost.",
+		log.RequestID(ctx), slog.Any("request", request),
 	)
+
+	query, message, err := toCostQuery(
+		request.Params.Id,
+		request.Params.Name,
+		request.Params.StartDate,
+		request.Params.EndDate,
+		request.Params.GroupBy,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid cost query.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetSubscriptionsTotalCost400JSONResponse{Message: message}, nil
+	}
+	report, err := s.subscriptions.TotalSubscriptionsCost(ctx, query)
 	if err != nil {
 		slog.ErrorContext(
 			ctx,
@@ -185,7 +340,14 @@ func (s *Server) GetSubscriptionsTotalCost(
 	}
 
 	response := oapi.GetSubscriptionsTotalCost200JSONResponse{
-		TotalCost: totalCost,
+		TotalCost: report.Total,
+	}
+	if query.GroupBy != domain.GroupByNone {
+		groups := make([]oapi.GroupTotal, 0, len(report.Groups))
+		for _, group := range report.Groups {
+			groups = append(groups, oapi.GroupTotal{Group: group.Group, Total: group.Total})
+		}
+		response.Groups = &groups
 	}
 
 	slog.InfoContext(
@@ -197,6 +359,59 @@ func (s *Server) GetSubscriptionsTotalCost(
 	return response, nil
 }
 
+func (s *Server) GetSubscriptionsSpendSeries(
+	ctx context.Context,
+	request oapi.GetSubscriptionsSpendSeriesRequestObject,
+) (oapi.GetSubscriptionsSpendSeriesResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to calculate spend series.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	query, message, err := toCostQuery(
+		request.Params.Id,
+		request.Params.Name,
+		request.Params.StartDate,
+		request.Params.EndDate,
+		request.Params.GroupBy,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid cost query.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetSubscriptionsSpendSeries400JSONResponse{Message: message}, nil
+	}
+	series, err := s.subscriptions.SpendSeries(ctx, query)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Spend series did not calculate. Failed to calculate series.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+
+		return oapi.GetSubscriptionsSpendSeries400JSONResponse{
+			Message: "Ошибка подсчета расходов по месяцам",
+		}, nil
+	}
+
+	points := make([]oapi.SpendSeriesPoint, 0, len(series))
+	for _, point := range series {
+		apiPoint := oapi.SpendSeriesPoint{
+			Month: point.Month.Format("01-2006"),
+			Total: point.Total,
+		}
+		if query.GroupBy != domain.GroupByNone {
+			apiPoint.Group = pointer.Ref(point.Group)
+		}
+		points = append(points, apiPoint)
+	}
+	response := oapi.GetSubscriptionsSpendSeries200JSONResponse{Points: points}
+
+	slog.InfoContext(ctx, "Spend series successfully calculated.", log.RequestID(ctx))
+
+	return response, nil
+}
+
 //nolint:dupl  // Same same but different.
 func (s *Server) PostSubscriptions(
 	ctx context.Context,
@@ -230,15 +445,23 @@ func (s *Server) PostSubscriptions(
 			}, nil
 		}
 		endDate = &parsedEndDate
-	} else {
-		endDate = pointer.Ref(time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC))
 	}
-	err = s.subscriptions.Create(ctx, domain.Subscription{
+	splitRule, members := fromAPISplit(request.Body.SplitRule, request.Body.Members)
+	suggestions, err := s.subscriptions.Create(ctx, domain.Subscription{
+		Kind:      domain.SubscriptionKind(pointer.Deref(request.Body.Kind)),
 		Name:      request.Body.Name,
 		Cost:      request.Body.Cost,
 		UserID:    request.Body.Id,
 		StartDate: startDate,
 		EndDate:   endDate,
+
+		Category:  request.Body.Category,
+		Tags:      pointer.Deref(request.Body.Tags),
+		SplitRule: splitRule,
+		Members:   members,
+
+		AutoRenew:  request.Body.AutoRenew != nil && *request.Body.AutoRenew,
+		TermMonths: request.Body.TermMonths,
 	})
 	if err != nil {
 		slog.ErrorContext(
@@ -250,10 +473,24 @@ func (s *Server) PostSubscriptions(
 		return oapi.PostSubscriptions400JSONResponse{Message: "Ошибка создания подписки"}, nil
 	}
 
-	slog.InfoContext(ctx, "Subscription successfully created.", log.RequestID(ctx))
-	return oapi.PostSubscriptions201JSONResponse{
+	response := oapi.PostSubscriptions201JSONResponse{
 		Message: "Подписка создана",
-	}, nil
+	}
+	if len(suggestions) > 0 {
+		apiSuggestions := make([]oapi.CatalogMatch, 0, len(suggestions))
+		for _, suggestion := range suggestions {
+			apiSuggestions = append(apiSuggestions, toAPICatalogMatch(suggestion))
+		}
+		response.Suggestions = &apiSuggestions
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Subscription successfully created.",
+		log.RequestID(ctx),
+		slog.Any("response", response),
+	)
+	return response, nil
 }
 
 //nolint:dupl  // Same same but different.
@@ -289,16 +526,23 @@ func (s *Server) PutSubscriptions(
 			}, nil
 		}
 		endDate = &parsedEndDate
-	} else {
-		endDate = pointer.Ref(time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC))
 	}
 
+	splitRule, members := fromAPISplit(request.Body.SplitRule, request.Body.Members)
 	err = s.subscriptions.Update(ctx, domain.Subscription{
 		Name:      request.Body.Name,
 		Cost:      request.Body.Cost,
 		UserID:    request.Body.Id,
 		StartDate: startDate,
 		EndDate:   endDate,
+
+		Category:  request.Body.Category,
+		Tags:      pointer.Deref(request.Body.Tags),
+		SplitRule: splitRule,
+		Members:   members,
+
+		AutoRenew:  request.Body.AutoRenew != nil && *request.Body.AutoRenew,
+		TermMonths: request.Body.TermMonths,
 	})
 	if err != nil {
 		slog.ErrorContext(
@@ -315,3 +559,75 @@ func (s *Server) PutSubscriptions(
 		Message: "Подписка обновлена",
 	}, nil
 }
+
+func toAPISubscription(subscription domain.Subscription) oapi.Subscription {
+	apiSubscription := oapi.Subscription{
+		SubscriptionId: pointer.Ref(subscription.ID),
+		Kind:           pointer.Ref(oapi.SubscriptionKind(subscription.Kind)),
+		Cost:           subscription.Cost,
+		DateStart:      subscription.StartDate.Format("01-2006"),
+		Id:             subscription.UserID,
+		Name:           subscription.Name,
+		ServiceId:      subscription.ServiceID,
+		Category:       subscription.Category,
+
+		AutoRenew:  pointer.Ref(subscription.AutoRenew),
+		TermMonths: subscription.TermMonths,
+		ExpiredAt:  subscription.ExpiredAt,
+
+		CancellationReason: subscription.CancellationReason,
+		DeletedAt:          subscription.DeletedAt,
+	}
+	if subscription.CancellationCode != nil {
+		apiSubscription.CancellationCode = pointer.Ref(oapi.SubscriptionCancellationCode(*subscription.CancellationCode))
+	}
+	if subscription.EndDate != nil {
+		apiSubscription.DateEnd = pointer.Ref(subscription.EndDate.Format("01-2006"))
+	}
+	if len(subscription.Tags) > 0 {
+		apiSubscription.Tags = pointer.Ref(subscription.Tags)
+	}
+	apiSubscription.SplitRule, apiSubscription.Members = toAPISplit(subscription)
+
+	return apiSubscription
+}
+
+// toCostQuery parses the query parameters shared by the cost endpoints. On
+// failure it also returns the message to report to the client.
+func toCostQuery(
+	userID *uuid.UUID,
+	name *string,
+	start string,
+	end string,
+	groupBy *oapi.GroupBy,
+) (domain.CostQuery, string, error) {
+	period, message, err := toPeriod(start, end)
+	if err != nil {
+		return domain.CostQuery{}, message, err
+	}
+
+	query := domain.CostQuery{
+		UserID: userID,
+		Name:   name,
+		Start:  period.Start,
+		End:    period.End,
+	}
+	if groupBy != nil {
+		query.GroupBy = domain.GroupBy(*groupBy)
+	}
+	return query, "", nil
+}
+
+// toPeriod parses the bounds of a period. On failure it also returns the
+// message to report to the client.
+func toPeriod(start string, end string) (domain.Period, string, error) {
+	startDate, err := time.Parse("01-2006", start)
+	if err != nil {
+		return domain.Period{}, "Неверный формат даты начала", err
+	}
+	endDate, err := time.Parse("01-2006", end)
+	if err != nil {
+		return domain.Period{}, "Неверный формат даты окончания", err
+	}
+	return domain.Period{Start: startDate, End: endDate}, "", nil
+}
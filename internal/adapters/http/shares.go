@@ -0,0 +1,104 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+	"ef_project/internal/infra/pointer"
+)
+
+func (s *Server) GetUsersIdOwed(
+	ctx context.Context,
+	request oapi.GetUsersIdOwedRequestObject,
+) (oapi.GetUsersIdOwedResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to calculate shares owed.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	startDate, err := time.Parse("01-2006", request.Params.StartDate)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid start date format.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetUsersIdOwed400JSONResponse{
+			Message: "Неверный формат даты начала",
+		}, nil
+	}
+	endDate, err := time.Parse("01-2006", request.Params.EndDate)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid end date format.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetUsersIdOwed400JSONResponse{
+			Message: "Неверный формат даты окончания",
+		}, nil
+	}
+
+	debts, err := s.subscriptions.Owed(ctx, request.Id, startDate, endDate)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Shares owed did not calculate. Failed to calculate shares.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetUsersIdOwed400JSONResponse{
+			Message: "Ошибка подсчета долгов участников",
+		}, nil
+	}
+
+	response := oapi.GetUsersIdOwed200JSONResponse{Debts: make([]oapi.MemberDebt, 0, len(debts))}
+	for _, debt := range debts {
+		response.Debts = append(response.Debts, oapi.MemberDebt{
+			Id:             debt.UserID,
+			SubscriptionId: debt.SubscriptionID,
+			Name:           debt.Name,
+			Amount:         debt.Amount,
+		})
+		response.Total += debt.Amount
+	}
+
+	slog.InfoContext(
+		ctx,
+		"Shares owed successfully calculated.",
+		log.RequestID(ctx),
+		slog.Any("response", response),
+	)
+
+	return response, nil
+}
+
+func fromAPISplit(
+	rule *oapi.SubscriptionSplitRule,
+	members *[]oapi.SubscriptionMember,
+) (*domain.SplitRule, []domain.SubscriptionMember) {
+	var splitRule *domain.SplitRule
+	if rule != nil {
+		splitRule = pointer.Ref(domain.SplitRule(*rule))
+	}
+
+	var domainMembers []domain.SubscriptionMember
+	if members != nil {
+		for _, member := range *members {
+			domainMembers = append(domainMembers, domain.SubscriptionMember{
+				UserID: member.Id,
+				Share:  member.Share,
+			})
+		}
+	}
+	return splitRule, domainMembers
+}
+
+func toAPISplit(subscription domain.Subscription) (*oapi.SubscriptionSplitRule, *[]oapi.SubscriptionMember) {
+	if subscription.SplitRule == nil {
+		return nil, nil
+	}
+
+	members := make([]oapi.SubscriptionMember, 0, len(subscription.Members))
+	for _, member := range subscription.Members {
+		members = append(members, oapi.SubscriptionMember{Id: member.UserID, Share: member.Share})
+	}
+	return pointer.Ref(oapi.SubscriptionSplitRule(*subscription.SplitRule)), &members
+}
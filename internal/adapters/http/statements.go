@@ -0,0 +1,177 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"html/template"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+	"ef_project/internal/infra/pointer"
+)
+
+var statementTemplate = template.Must(template.New("statement").Parse(`<!DOCTYPE html>
+<html lang="ru">
+<head>
+<meta charset="utf-8">
+<title>Выписка за {{.Month}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #999; padding: 4px 8px; }
+td.num { text-align: right; }
+</style>
+</head>
+<body>
+<h1>Выписка за {{.Month}}</h1>
+<p>Пользователь: {{.Id}}</p>
+<table>
+<tr><th>Сервис</th><th>Категория</th><th>Стоимость</th><th>Доля</th><th>Скидка</th><th>Итого</th></tr>
+{{range .Lines}}<tr><td>{{.Name}}{{if .OneTime}} (разовая покупка){{end}}</td><td>{{with .Category}}{{.}}{{end}}</td><td class="num">{{.Cost}}</td><td class="num">{{.Share}}</td><td class="num">{{.Discount}}</td><td class="num">{{.Amount}}</td></tr>
+{{end}}</table>
+<table>
+<tr><th>Категория</th><th>Итого</th></tr>
+{{range .Categories}}<tr><td>{{.Group}}</td><td class="num">{{.Total}}</td></tr>
+{{end}}</table>
+<p>Итого за месяц: {{.Total}}</p>
+<p>Предыдущий месяц: {{.PreviousTotal}} (разница {{.Change}})</p>
+</body>
+</html>
+`))
+
+func (s *Server) GetUsersIdStatementsMonth(
+	ctx context.Context,
+	request oapi.GetUsersIdStatementsMonthRequestObject,
+) (oapi.GetUsersIdStatementsMonthResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get statement.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	month, err := time.Parse("01-2006", request.Month)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid month format.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetUsersIdStatementsMonth400JSONResponse{
+			Message: "Неверный формат месяца",
+		}, nil
+	}
+
+	statement, err := s.subscriptions.Statement(ctx, request.Id, month)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"Statement did not build. Failed to build statement.",
+			log.ErrorAttr(err),
+			log.RequestID(ctx),
+		)
+		return oapi.GetUsersIdStatementsMonth400JSONResponse{
+			Message: "Ошибка формирования выписки",
+		}, nil
+	}
+	apiStatement := toAPIStatement(statement)
+
+	var body bytes.Buffer
+	switch pointer.Deref(request.Params.Format) {
+	case oapi.GetUsersIdStatementsMonthParamsFormatJson, "":
+		slog.InfoContext(ctx, "Statement successfully built.", log.RequestID(ctx))
+		return oapi.GetUsersIdStatementsMonth200JSONResponse(apiStatement), nil
+	case oapi.GetUsersIdStatementsMonthParamsFormatCsv:
+		if err := writeStatementCSV(&body, apiStatement); err != nil {
+			slog.ErrorContext(ctx, "Rendering statement failed.", log.ErrorAttr(err), log.RequestID(ctx))
+			return oapi.GetUsersIdStatementsMonth400JSONResponse{Message: "Ошибка формирования выписки"}, nil
+		}
+		slog.InfoContext(ctx, "Statement successfully built.", log.RequestID(ctx))
+		return oapi.GetUsersIdStatementsMonth200TextcsvResponse{
+			Body:          &body,
+			ContentLength: int64(body.Len()),
+		}, nil
+	case oapi.GetUsersIdStatementsMonthParamsFormatHtml:
+		if err := statementTemplate.Execute(&body, apiStatement); err != nil {
+			slog.ErrorContext(ctx, "Rendering statement failed.", log.ErrorAttr(err), log.RequestID(ctx))
+			return oapi.GetUsersIdStatementsMonth400JSONResponse{Message: "Ошибка формирования выписки"}, nil
+		}
+		slog.InfoContext(ctx, "Statement successfully built.", log.RequestID(ctx))
+		return oapi.GetUsersIdStatementsMonth200TexthtmlResponse{
+			Body:          &body,
+			ContentLength: int64(body.Len()),
+		}, nil
+	}
+
+	return oapi.GetUsersIdStatementsMonth400JSONResponse{
+		Message: "Неизвестный формат выписки",
+	}, nil
+}
+
+// writeStatementCSV writes a line per subscription followed by the totals of
+// the month and of the previous month.
+func writeStatementCSV(buffer *bytes.Buffer, statement oapi.Statement) error {
+	writer := csv.NewWriter(buffer)
+	rows := [][]string{{"subscription_id", "name", "category", "payer_id", "split_rule", "cost", "share", "discount", "amount"}}
+	for _, line := range statement.Lines {
+		var splitRule string
+		if line.SplitRule != nil {
+			splitRule = string(*line.SplitRule)
+		}
+		rows = append(rows, []string{
+			line.SubscriptionId.String(),
+			line.Name,
+			pointer.Deref(line.Category),
+			line.PayerId.String(),
+			splitRule,
+			strconv.Itoa(line.Cost),
+			strconv.Itoa(line.Share),
+			strconv.Itoa(line.Discount),
+			strconv.Itoa(line.Amount),
+		})
+	}
+	rows = append(rows,
+		[]string{"", "total", "", "", "", "", "", "", strconv.Itoa(statement.Total)},
+		[]string{"", "previous_total", "", "", "", "", "", "", strconv.Itoa(statement.PreviousTotal)},
+	)
+
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+func toAPIStatement(statement domain.Statement) oapi.Statement {
+	apiStatement := oapi.Statement{
+		Id:            statement.UserID,
+		Month:         statement.Month.Format("01-2006"),
+		Lines:         make([]oapi.StatementLine, 0, len(statement.Lines)),
+		Categories:    make([]oapi.GroupTotal, 0, len(statement.Categories)),
+		Total:         statement.Total,
+		PreviousTotal: statement.PreviousTotal,
+		Change:        statement.Total - statement.PreviousTotal,
+	}
+	for _, line := range statement.Lines {
+		apiLine := oapi.StatementLine{
+			SubscriptionId: line.SubscriptionID,
+			Name:           line.Name,
+			Category:       line.Category,
+			PayerId:        line.PayerID,
+			Cost:           line.Cost,
+			Share:          line.Share,
+			Discount:       line.Discount,
+			Amount:         line.Amount,
+			Actual:         line.Actual,
+			OneTime:        line.OneTime,
+		}
+		if line.SplitRule != nil {
+			apiLine.SplitRule = pointer.Ref(oapi.StatementLineSplitRule(*line.SplitRule))
+		}
+		apiStatement.Lines = append(apiStatement.Lines, apiLine)
+	}
+	for _, group := range statement.Categories {
+		apiStatement.Categories = append(apiStatement.Categories, oapi.GroupTotal{Group: group.Group, Total: group.Total})
+	}
+
+	return apiStatement
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"ef_project/internal/domain"
+	oapi "ef_project/internal/generated/oapi"
+	"ef_project/internal/infra/log"
+)
+
+func (s *Server) GetSubscriptionsSubscriptionIdVersions(
+	ctx context.Context,
+	request oapi.GetSubscriptionsSubscriptionIdVersionsRequestObject,
+) (oapi.GetSubscriptionsSubscriptionIdVersionsResponseObject, error) {
+	slog.InfoContext(
+		ctx,
+		"Request to get subscription versions.",
+		log.RequestID(ctx), slog.Any("request", request),
+	)
+
+	var (
+		versions []domain.SubscriptionVersion
+		err      error
+	)
+	if request.Params.At != nil {
+		var version domain.SubscriptionVersion
+		version, err = s.subscriptions.VersionAt(ctx, request.SubscriptionId, *request.Params.At)
+		versions = []domain.SubscriptionVersion{version}
+	} else {
+		versions, err = s.subscriptions.Versions(ctx, request.SubscriptionId)
+	}
+	// The subscription did not exist yet at the moment asked for.
+	if errors.Is(err, domain.ErrNotFound) {
+		versions, err = nil, nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get subscription versions.", log.ErrorAttr(err), log.RequestID(ctx))
+		return oapi.GetSubscriptionsSubscriptionIdVersions400JSONResponse{
+			Message: "Ошибка получения версий подписки",
+		}, nil
+	}
+
+	response := make(oapi.GetSubscriptionsSubscriptionIdVersions200JSONResponse, 0, len(versions))
+	for _, version := range versions {
+		response = append(response, oapi.SubscriptionVersion{
+			Subscription: toAPISubscription(version.Subscription),
+			ValidFrom:    version.ValidFrom,
+			ValidTo:      version.ValidTo,
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"ef_project/internal/infra/log"
+)
+
+var (
+	ErrServiceSetActual = errors.Join(
+		errServiseSubscription,
+		errors.New("set actual charge failed"),
+	)
+	ErrServiceListActuals = errors.Join(
+		errServiseSubscription,
+		errors.New("list actual charges failed"),
+	)
+	ErrServiceDeleteActual = errors.Join(
+		errServiseSubscription,
+		errors.New("delete actual charge failed"),
+	)
+)
+
+// monthAmount returns what a subscription costs in a month: the actual charge
+// recorded for it, or the nominal monthly cost.
+func monthAmount(subscription Subscription, month time.Time) (int, bool) {
+	for _, actual := range subscription.Actuals {
+		if BillingPeriod(actual.Month).Equal(month) {
+			return actual.Amount, true
+		}
+	}
+	return subscription.Cost, false
+}
+
+// attachActuals loads the actual charges of the given subscriptions.
+func attachActuals(
+	ctx context.Context,
+	c Connection,
+	subscriptionRepo SubscriptionsRepository,
+	subscriptions []Subscription,
+) ([]Subscription, error) {
+	if len(subscriptions) == 0 {
+		return subscriptions, nil
+	}
+	ids := make([]SubscriptionID, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		ids = append(ids, subscription.ID)
+	}
+
+	actuals, err := subscriptionRepo.ReadActuals(ctx, c, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range subscriptions {
+		for _, actual := range actuals {
+			if actual.SubscriptionID == subscriptions[i].ID {
+				subscriptions[i].Actuals = append(subscriptions[i].Actuals, actual)
+			}
+		}
+	}
+	return subscriptions, nil
+}
+
+// SetActual records the amount billed for a subscription in a month, replacing
+// an earlier record for the same month. The month must be within the
+// subscription period.
+func (s *SubscriptionService) SetActual(ctx context.Context, actual ActualCharge) error {
+	slog.DebugContext(ctx, "Service: setting actual charge.", log.RequestID(ctx))
+	if actual.Amount < 0 {
+		return errors.Join(ErrServiceSetActual, errors.New("amount must not be negative"))
+	}
+	actual.Month = BillingPeriod(actual.Month)
+
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		subscription, err := s.subscriptionRepo.GetByID(ctx, c, actual.SubscriptionID)
+		if err != nil {
+			return err
+		}
+		if subscription.DeletedAt != nil {
+			return errors.New("subscription is deleted")
+		}
+		if actual.Month.Before(BillingPeriod(subscription.StartDate)) ||
+			(subscription.EndDate != nil && actual.Month.After(BillingPeriod(*subscription.EndDate))) {
+			return errors.New("month is outside the subscription period")
+		}
+
+		if err := s.subscriptionRepo.UpsertActual(ctx, c, actual); err != nil {
+			return err
+		}
+		return s.syncLedger(ctx, c, actual.SubscriptionID)
+	})
+	if err != nil {
+		return errors.Join(ErrServiceSetActual, err)
+	}
+	return nil
+}
+
+func (s *SubscriptionService) ListActuals(
+	ctx context.Context,
+	subscriptionID SubscriptionID,
+) ([]ActualCharge, error) {
+	slog.DebugContext(ctx, "Service: listing actual charges.", log.RequestID(ctx))
+	var actuals []ActualCharge
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		actuals, dbErr = s.subscriptionRepo.ReadActuals(ctx, c, []SubscriptionID{subscriptionID})
+		return dbErr
+	})
+	if err != nil {
+		return actuals, errors.Join(ErrServiceListActuals, err)
+	}
+	return actuals, nil
+}
+
+func (s *SubscriptionService) DeleteActual(
+	ctx context.Context,
+	subscriptionID SubscriptionID,
+	month time.Time,
+) error {
+	slog.DebugContext(ctx, "Service: deleting actual charge.", log.RequestID(ctx))
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		if err := s.subscriptionRepo.DeleteActual(ctx, c, subscriptionID, BillingPeriod(month)); err != nil {
+			return err
+		}
+		return s.syncLedger(ctx, c, subscriptionID)
+	})
+	if err != nil {
+		return errors.Join(ErrServiceDeleteActual, err)
+	}
+	return nil
+}
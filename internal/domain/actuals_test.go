@@ -0,0 +1,121 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_TotalSubscriptionsCostPrefersActuals(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	january := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	subscription := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "Cloud",
+		Cost:      1000,
+		UserID:    userID,
+		StartDate: january,
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoSunbscriptions.EXPECT().
+		ReadActiveInPeriod(mock.Anything, mock.Anything, &userID, (*domain.ServiceName)(nil), january, march).
+		Return([]domain.Subscription{subscription}, nil).Once()
+	repoSunbscriptions.EXPECT().ReadDiscounts(mock.Anything, mock.Anything, mock.Anything).
+		Return([]domain.Discount{{
+			ID:             uuid.New(),
+			SubscriptionID: subscription.ID,
+			Kind:           domain.DiscountPercent,
+			Value:          10,
+			StartDate:      january,
+		}}, nil).Once()
+	repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, mock.Anything, mock.Anything).
+		Return([]domain.ActualCharge{
+			{SubscriptionID: subscription.ID, Month: january, Amount: 1234},
+			{SubscriptionID: subscription.ID, Month: march, Amount: 0},
+		}, nil).Once()
+
+	report, err := domain.NewSubscriptionService(provider, repoSunbscriptions, mocks.NewMockCatalogRepository(t)).
+		TotalSubscriptionsCost(t.Context(), domain.CostQuery{UserID: &userID, Start: january, End: march})
+
+	require.NoError(t, err)
+	// Actuals replace the nominal cost and the discount; February falls back to
+	// the discounted nominal price.
+	require.Equal(t, 1234+900+0, report.Total)
+}
+
+func TestServicePVZ_SetActual(t *testing.T) {
+	t.Parallel()
+
+	subscription := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "Cloud",
+		Cost:      1000,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   pointer.Ref(time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		name    string
+		month   time.Time
+		created bool
+		check   func(*testing.T, error)
+	}{
+		{
+			name:    "Within period",
+			month:   time.Date(2025, time.March, 17, 0, 0, 0, 0, time.UTC),
+			created: true,
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:  "After end",
+			month: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceSetActual)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+			repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+			repoSunbscriptions.EXPECT().GetByID(mock.Anything, mock.Anything, subscription.ID).
+				Return(subscription, nil).Once()
+			if test.created {
+				repoSunbscriptions.EXPECT().
+					UpsertActual(mock.Anything, mock.Anything, domain.ActualCharge{
+						SubscriptionID: subscription.ID,
+						Month:          domain.BillingPeriod(test.month),
+						Amount:         1500,
+					}).
+					Return(nil).Once()
+			}
+
+			err := domain.NewSubscriptionService(provider, repoSunbscriptions, mocks.NewMockCatalogRepository(t)).
+				SetActual(t.Context(), domain.ActualCharge{
+					SubscriptionID: subscription.ID,
+					Month:          test.month,
+					Amount:         1500,
+				})
+
+			test.check(t, err)
+		})
+	}
+}
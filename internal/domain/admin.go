@@ -0,0 +1,348 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"ef_project/internal/infra/log"
+)
+
+var _ AdminInterface = (*AdminService)(nil)
+
+var (
+	errServiceAdmin         = errors.New("admin service error")
+	ErrServiceRenameService = errors.Join(
+		errServiceAdmin,
+		errors.New("rename service failed"),
+	)
+	ErrServiceMergeServices = errors.Join(
+		errServiceAdmin,
+		errors.New("merge services failed"),
+	)
+	ErrServiceRewriteConflicts = errors.Join(
+		errServiceAdmin,
+		errors.New("rewrite would create overlapping subscriptions"),
+	)
+	ErrServiceTransferUser = errors.Join(
+		errServiceAdmin,
+		errors.New("transfer user failed"),
+	)
+)
+
+const (
+	// TransferFail refuses a transfer that would make subscriptions of the
+	// target user overlap.
+	TransferFail TransferStrategy = "fail"
+	// TransferKeepSource moves the overlapping subscriptions of the target
+	// user to the trash.
+	TransferKeepSource TransferStrategy = "keep_source"
+	// TransferKeepTarget moves the overlapping subscriptions of the source
+	// user to the trash before the transfer.
+	TransferKeepTarget TransferStrategy = "keep_target"
+)
+
+type AdminService struct {
+	provider         ConnectionProvider
+	subscriptionRepo SubscriptionsRepository
+	catalogRepo      CatalogRepository
+	auditRepo        AuditRepository
+	ledger           ChargeLedger
+}
+
+func NewAdminService(
+	provider ConnectionProvider,
+	subscriptionRepo SubscriptionsRepository,
+	catalogRepo CatalogRepository,
+	auditRepo AuditRepository,
+	ledger ChargeLedger,
+) *AdminService {
+	return &AdminService{
+		provider:         provider,
+		subscriptionRepo: subscriptionRepo,
+		catalogRepo:      catalogRepo,
+		auditRepo:        auditRepo,
+		ledger:           ledger,
+	}
+}
+
+// RenameService renames a service in every subscription. When the old name is
+// a canonical catalog name, the catalog entry is renamed too and keeps the old
+// name as an alias.
+func (s *AdminService) RenameService(
+	ctx context.Context,
+	from ServiceName,
+	to ServiceName,
+) (ServiceRewrite, error) {
+	slog.DebugContext(ctx, "Service: renaming service.", log.RequestID(ctx))
+	from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+	if from == "" || to == "" || from == to {
+		return ServiceRewrite{}, errors.Join(ErrServiceRenameService, errors.New("invalid service names"))
+	}
+
+	var rewrite ServiceRewrite
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		source, sourceFound, err := resolveCatalogEntry(ctx, c, s.catalogRepo, from)
+		if err != nil {
+			return err
+		}
+		target, targetFound, err := resolveCatalogEntry(ctx, c, s.catalogRepo, to)
+		if err != nil {
+			return err
+		}
+
+		var serviceID *CatalogEntryID
+		switch {
+		case targetFound:
+			to, serviceID = target.Name, &target.ID
+		case sourceFound && source.Name == from:
+			source.Name = to
+			source.Aliases = append(source.Aliases, from)
+			if source, err = normalizeCatalogEntry(source); err != nil {
+				return err
+			}
+			if err = s.catalogRepo.Update(ctx, c, source); err != nil {
+				return err
+			}
+			serviceID = &source.ID
+		}
+
+		rewrite, err = s.rewriteService(ctx, c, AuditOperationRenameService, from, to, serviceID)
+		return err
+	})
+	if err != nil {
+		return rewrite, errors.Join(ErrServiceRenameService, err)
+	}
+	return rewrite, nil
+}
+
+// MergeServices moves every subscription of the source service to the target
+// one. When both are catalog entries, the source entry is removed and its names
+// become aliases of the target.
+func (s *AdminService) MergeServices(
+	ctx context.Context,
+	source ServiceName,
+	target ServiceName,
+) (ServiceRewrite, error) {
+	slog.DebugContext(ctx, "Service: merging services.", log.RequestID(ctx))
+	source, target = strings.TrimSpace(source), strings.TrimSpace(target)
+	if source == "" || target == "" || source == target {
+		return ServiceRewrite{}, errors.Join(ErrServiceMergeServices, errors.New("invalid service names"))
+	}
+
+	var rewrite ServiceRewrite
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		sourceEntry, sourceFound, err := resolveCatalogEntry(ctx, c, s.catalogRepo, source)
+		if err != nil {
+			return err
+		}
+		targetEntry, targetFound, err := resolveCatalogEntry(ctx, c, s.catalogRepo, target)
+		if err != nil {
+			return err
+		}
+
+		var serviceID *CatalogEntryID
+		if targetFound {
+			target, serviceID = targetEntry.Name, &targetEntry.ID
+		}
+
+		rewrite, err = s.rewriteService(ctx, c, AuditOperationMergeServices, source, target, serviceID)
+		if err != nil {
+			return err
+		}
+
+		if !sourceFound || !targetFound || sourceEntry.ID == targetEntry.ID {
+			return nil
+		}
+		if err = s.catalogRepo.Delete(ctx, c, sourceEntry.ID); err != nil {
+			return err
+		}
+		targetEntry.Aliases = append(targetEntry.Aliases, sourceEntry.Name)
+		targetEntry.Aliases = append(targetEntry.Aliases, sourceEntry.Aliases...)
+		if targetEntry, err = normalizeCatalogEntry(targetEntry); err != nil {
+			return err
+		}
+		return s.catalogRepo.Update(ctx, c, targetEntry)
+	})
+	if err != nil {
+		return rewrite, errors.Join(ErrServiceMergeServices, err)
+	}
+	return rewrite, nil
+}
+
+// rewriteService moves subscriptions from one service name to another unless
+// that would make two periods of the same user overlap, and records the
+// change in the audit log.
+func (s *AdminService) rewriteService(
+	ctx context.Context,
+	c Connection,
+	operation string,
+	from ServiceName,
+	to ServiceName,
+	serviceID *CatalogEntryID,
+) (ServiceRewrite, error) {
+	conflicts, err := s.subscriptionRepo.FindServiceConflicts(ctx, c, from, to)
+	if err != nil {
+		return ServiceRewrite{}, err
+	}
+	if len(conflicts) > 0 {
+		return ServiceRewrite{Conflicts: conflicts}, ErrServiceRewriteConflicts
+	}
+
+	updated, err := s.subscriptionRepo.RenameService(ctx, c, from, to, serviceID)
+	if err != nil {
+		return ServiceRewrite{}, err
+	}
+
+	entry := newAuditEntry(ctx, operation)
+	entry.Details, err = json.Marshal(map[string]any{
+		"from":          from,
+		"to":            to,
+		"serviceId":     serviceID,
+		"subscriptions": updated,
+	})
+	if err != nil {
+		return ServiceRewrite{}, err
+	}
+	if err = s.auditRepo.Record(ctx, c, entry); err != nil {
+		return ServiceRewrite{}, err
+	}
+
+	return ServiceRewrite{Updated: updated}, nil
+}
+
+// TransferUser moves the subscriptions and family memberships of one user to
+// another in one transaction, for accounts merged in the identity system.
+// Subscriptions of the same service whose periods overlap are resolved by the
+// strategy, fail by default. Each changed subscription is audited on its own.
+func (s *AdminService) TransferUser(ctx context.Context, transfer UserTransfer) (TransferResult, error) {
+	slog.DebugContext(ctx, "Service: transferring user.", log.RequestID(ctx))
+	if transfer.Strategy == "" {
+		transfer.Strategy = TransferFail
+	}
+	if transfer.Source == transfer.Target {
+		return TransferResult{}, errors.Join(ErrServiceTransferUser, errors.New("source and target are the same user"))
+	}
+	if !slices.Contains([]TransferStrategy{TransferFail, TransferKeepSource, TransferKeepTarget}, transfer.Strategy) {
+		return TransferResult{}, errors.Join(ErrServiceTransferUser, errors.New("unknown transfer strategy"))
+	}
+
+	var result TransferResult
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		conflicts, err := s.subscriptionRepo.FindUserConflicts(ctx, c, transfer.Source, transfer.Target)
+		if err != nil {
+			return err
+		}
+		if len(conflicts) > 0 && transfer.Strategy == TransferFail {
+			result.Conflicts = conflicts
+			return ErrServiceRewriteConflicts
+		}
+		for _, conflict := range conflicts {
+			id := conflict.TargetID
+			if transfer.Strategy == TransferKeepTarget {
+				id = conflict.SourceID
+			}
+			if !slices.Contains(result.Trashed, id) {
+				result.Trashed = append(result.Trashed, id)
+			}
+		}
+		before, err := s.readTransferred(ctx, c, transfer.Source, transfer.Target)
+		if err != nil {
+			return err
+		}
+		if len(result.Trashed) > 0 {
+			if err = s.subscriptionRepo.Trash(ctx, c, result.Trashed); err != nil {
+				return err
+			}
+		}
+
+		moved, err := s.subscriptionRepo.TransferOwner(ctx, c, transfer.Source, transfer.Target)
+		if err != nil {
+			return err
+		}
+		shared, err := s.subscriptionRepo.TransferMembers(ctx, c, transfer.Source, transfer.Target)
+		if err != nil {
+			return err
+		}
+		result.Moved = int64(len(moved))
+
+		after, err := s.readTransferred(ctx, c, transfer.Target)
+		if err != nil {
+			return err
+		}
+		details, err := json.Marshal(map[string]any{
+			"source":   transfer.Source,
+			"target":   transfer.Target,
+			"strategy": transfer.Strategy,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Every changed subscription gets its own entry, so its history shows
+		// the new owner or member. Charges keep the payer and the member they
+		// are billed to.
+		changed := map[SubscriptionID]struct{}{}
+		for _, id := range slices.Concat(result.Trashed, moved, shared) {
+			if _, ok := changed[id]; ok {
+				continue
+			}
+			changed[id] = struct{}{}
+			if err := s.auditTransfer(ctx, c, details, before[id], after[id]); err != nil {
+				return err
+			}
+			if s.ledger == nil {
+				continue
+			}
+			if err := s.ledger.Sync(ctx, c, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return result, errors.Join(ErrServiceTransferUser, err)
+	}
+	return result, nil
+}
+
+// readTransferred snapshots the subscriptions owned or shared by the users,
+// the trashed ones included, by ID.
+func (s *AdminService) readTransferred(
+	ctx context.Context,
+	c Connection,
+	userIDs ...UserID,
+) (map[SubscriptionID]Subscription, error) {
+	subscriptions, err := s.subscriptionRepo.ReadByUsers(ctx, c, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	if subscriptions, err = attachMembers(ctx, c, s.subscriptionRepo, subscriptions); err != nil {
+		return nil, err
+	}
+	snapshots := make(map[SubscriptionID]Subscription, len(subscriptions))
+	for _, subscription := range subscriptions {
+		snapshots[subscription.ID] = subscription
+	}
+	return snapshots, nil
+}
+
+// auditTransfer records the transfer of one subscription with its state
+// before and after it.
+func (s *AdminService) auditTransfer(
+	ctx context.Context,
+	c Connection,
+	details json.RawMessage,
+	before Subscription,
+	after Subscription,
+) error {
+	entry, err := subscriptionAuditEntry(ctx, AuditOperationTransferUser, &before, &after)
+	if err != nil {
+		return err
+	}
+	entry.Details = details
+	return s.auditRepo.Record(ctx, c, entry)
+}
@@ -0,0 +1,270 @@
+package domain_test
+
+import (
+	"testing"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminService_RenameService(t *testing.T) {
+	t.Parallel()
+
+	conflict := domain.ServiceConflict{UserID: uuid.New(), SourceID: uuid.New(), TargetID: uuid.New()}
+	entry := domain.CatalogEntry{ID: uuid.New(), Name: "Okko", Aliases: []string{}}
+
+	tests := []struct {
+		name         string
+		prepareMocks func(*mocks.MockSubscriptionsRepository, *mocks.MockCatalogRepository, *mocks.MockAuditRepository)
+		check        func(*testing.T, domain.ServiceRewrite, error)
+	}{
+		{
+			name: "Renames catalog entry",
+			prepareMocks: func(
+				subscriptions *mocks.MockSubscriptionsRepository,
+				catalog *mocks.MockCatalogRepository,
+				audit *mocks.MockAuditRepository,
+			) {
+				catalog.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, "Okko").
+					Return([]domain.CatalogEntry{entry}, nil).Once()
+				catalog.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, "Okko TV").
+					Return(nil, nil).Once()
+				catalog.EXPECT().
+					Update(mock.Anything, mock.Anything, domain.CatalogEntry{
+						ID:      entry.ID,
+						Name:    "Okko TV",
+						Aliases: []string{"Okko"},
+					}).
+					Return(nil).Once()
+				subscriptions.EXPECT().FindServiceConflicts(mock.Anything, mock.Anything, "Okko", "Okko TV").
+					Return(nil, nil).Once()
+				subscriptions.EXPECT().RenameService(mock.Anything, mock.Anything, "Okko", "Okko TV", &entry.ID).
+					Return(3, nil).Once()
+				audit.EXPECT().
+					Record(mock.Anything, mock.Anything, mock.MatchedBy(func(entry domain.AuditEntry) bool {
+						return entry.Operation == domain.AuditOperationRenameService
+					})).
+					Return(nil).Once()
+			},
+			check: func(t *testing.T, rewrite domain.ServiceRewrite, err error) {
+				require.NoError(t, err)
+				require.Equal(t, domain.ServiceRewrite{Updated: 3}, rewrite)
+			},
+		},
+		{
+			name: "Reports conflicts",
+			prepareMocks: func(
+				subscriptions *mocks.MockSubscriptionsRepository,
+				catalog *mocks.MockCatalogRepository,
+				_ *mocks.MockAuditRepository,
+			) {
+				catalog.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, nil).Twice()
+				subscriptions.EXPECT().FindServiceConflicts(mock.Anything, mock.Anything, "Okko", "Okko TV").
+					Return([]domain.ServiceConflict{conflict}, nil).Once()
+			},
+			check: func(t *testing.T, rewrite domain.ServiceRewrite, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceRewriteConflicts)
+				require.Equal(t, []domain.ServiceConflict{conflict}, rewrite.Conflicts)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+			repoSubscriptions := mocks.NewMockSubscriptionsRepository(t)
+			repoCatalog := mocks.NewMockCatalogRepository(t)
+			repoAudit := mocks.NewMockAuditRepository(t)
+
+			test.prepareMocks(repoSubscriptions, repoCatalog, repoAudit)
+
+			rewrite, err := domain.NewAdminService(provider, repoSubscriptions, repoCatalog, repoAudit, nil).
+				RenameService(t.Context(), "Okko", "Okko TV")
+
+			test.check(t, rewrite, err)
+		})
+	}
+}
+
+func TestAdminService_MergeServices(t *testing.T) {
+	t.Parallel()
+
+	source := domain.CatalogEntry{ID: uuid.New(), Name: "Кинопоиск", Aliases: []string{"kinopoisk"}}
+	target := domain.CatalogEntry{ID: uuid.New(), Name: "Kinopoisk HD", Aliases: []string{}}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSubscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoCatalog := mocks.NewMockCatalogRepository(t)
+	repoAudit := mocks.NewMockAuditRepository(t)
+
+	repoCatalog.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, "Кинопоиск").
+		Return([]domain.CatalogEntry{source}, nil).Once()
+	repoCatalog.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, "kinopoisk hd").
+		Return([]domain.CatalogEntry{target}, nil).Once()
+	repoSubscriptions.EXPECT().
+		FindServiceConflicts(mock.Anything, mock.Anything, "Кинопоиск", "Kinopoisk HD").
+		Return(nil, nil).Once()
+	repoSubscriptions.EXPECT().
+		RenameService(mock.Anything, mock.Anything, "Кинопоиск", "Kinopoisk HD", &target.ID).
+		Return(5, nil).Once()
+	repoAudit.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	repoCatalog.EXPECT().Delete(mock.Anything, mock.Anything, source.ID).Return(nil).Once()
+	repoCatalog.EXPECT().
+		Update(mock.Anything, mock.Anything, domain.CatalogEntry{
+			ID:      target.ID,
+			Name:    target.Name,
+			Aliases: []string{"Кинопоиск", "kinopoisk"},
+		}).
+		Return(nil).Once()
+
+	rewrite, err := domain.NewAdminService(provider, repoSubscriptions, repoCatalog, repoAudit, nil).
+		MergeServices(t.Context(), "Кинопоиск", "kinopoisk hd")
+
+	require.NoError(t, err)
+	require.Equal(t, int64(5), rewrite.Updated)
+}
+
+func TestAdminService_TransferUser(t *testing.T) {
+	t.Parallel()
+
+	source, target := uuid.New(), uuid.New()
+	conflict := domain.ServiceConflict{UserID: target, SourceID: uuid.New(), TargetID: uuid.New()}
+	moved := []domain.SubscriptionID{conflict.SourceID, uuid.New()}
+	shared := []domain.SubscriptionID{uuid.New()}
+	owned := func(userID domain.UserID, ids ...domain.SubscriptionID) []domain.Subscription {
+		subscriptions := make([]domain.Subscription, 0, len(ids))
+		for _, id := range ids {
+			subscriptions = append(subscriptions, domain.Subscription{ID: id, UserID: userID})
+		}
+		return subscriptions
+	}
+
+	tests := []struct {
+		name         string
+		strategy     domain.TransferStrategy
+		prepareMocks func(*mocks.MockSubscriptionsRepository, *mocks.MockAuditRepository, *mocks.MockChargeLedger)
+		check        func(*testing.T, domain.TransferResult, error)
+	}{
+		{
+			name: "Fails on overlaps by default",
+			prepareMocks: func(
+				subscriptions *mocks.MockSubscriptionsRepository,
+				_ *mocks.MockAuditRepository,
+				_ *mocks.MockChargeLedger,
+			) {
+				subscriptions.EXPECT().FindUserConflicts(mock.Anything, mock.Anything, source, target).
+					Return([]domain.ServiceConflict{conflict}, nil).Once()
+			},
+			check: func(t *testing.T, result domain.TransferResult, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceRewriteConflicts)
+				require.Equal(t, []domain.ServiceConflict{conflict}, result.Conflicts)
+			},
+		},
+		{
+			name:     "Keeps source",
+			strategy: domain.TransferKeepSource,
+			prepareMocks: func(
+				subscriptions *mocks.MockSubscriptionsRepository,
+				audit *mocks.MockAuditRepository,
+				ledger *mocks.MockChargeLedger,
+			) {
+				subscriptions.EXPECT().FindUserConflicts(mock.Anything, mock.Anything, source, target).
+					Return([]domain.ServiceConflict{conflict}, nil).Once()
+				subscriptions.EXPECT().ReadByUsers(mock.Anything, mock.Anything, []domain.UserID{source, target}).
+					Return(append(owned(source, moved...), owned(target, conflict.TargetID, shared[0])...), nil).Once()
+				subscriptions.EXPECT().Trash(mock.Anything, mock.Anything, []domain.SubscriptionID{conflict.TargetID}).
+					Return(nil).Once()
+				subscriptions.EXPECT().TransferOwner(mock.Anything, mock.Anything, source, target).
+					Return(moved, nil).Once()
+				subscriptions.EXPECT().TransferMembers(mock.Anything, mock.Anything, source, target).
+					Return(shared, nil).Once()
+				subscriptions.EXPECT().ReadByUsers(mock.Anything, mock.Anything, []domain.UserID{target}).
+					Return(owned(target, conflict.TargetID, moved[0], moved[1], shared[0]), nil).Once()
+				for _, id := range []domain.SubscriptionID{conflict.TargetID, moved[0], moved[1], shared[0]} {
+					audit.EXPECT().Record(mock.Anything, mock.Anything, mock.MatchedBy(func(entry domain.AuditEntry) bool {
+						return entry.Operation == domain.AuditOperationTransferUser &&
+							*entry.SubscriptionID == id && entry.Before != nil && entry.After != nil
+					})).Return(nil).Once()
+					ledger.EXPECT().Sync(mock.Anything, mock.Anything, id).Return(nil).Once()
+				}
+			},
+			check: func(t *testing.T, result domain.TransferResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(2), result.Moved)
+				require.Equal(t, []domain.SubscriptionID{conflict.TargetID}, result.Trashed)
+			},
+		},
+		{
+			name:     "Keeps target",
+			strategy: domain.TransferKeepTarget,
+			prepareMocks: func(
+				subscriptions *mocks.MockSubscriptionsRepository,
+				audit *mocks.MockAuditRepository,
+				ledger *mocks.MockChargeLedger,
+			) {
+				subscriptions.EXPECT().FindUserConflicts(mock.Anything, mock.Anything, source, target).
+					Return([]domain.ServiceConflict{conflict}, nil).Once()
+				subscriptions.EXPECT().ReadByUsers(mock.Anything, mock.Anything, []domain.UserID{source, target}).
+					Return(owned(source, moved...), nil).Once()
+				subscriptions.EXPECT().Trash(mock.Anything, mock.Anything, []domain.SubscriptionID{conflict.SourceID}).
+					Return(nil).Once()
+				subscriptions.EXPECT().TransferOwner(mock.Anything, mock.Anything, source, target).
+					Return(moved, nil).Once()
+				subscriptions.EXPECT().TransferMembers(mock.Anything, mock.Anything, source, target).
+					Return(nil, nil).Once()
+				subscriptions.EXPECT().ReadByUsers(mock.Anything, mock.Anything, []domain.UserID{target}).
+					Return(owned(target, moved...), nil).Once()
+				audit.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(len(moved))
+				for _, id := range moved {
+					ledger.EXPECT().Sync(mock.Anything, mock.Anything, id).Return(nil).Once()
+				}
+			},
+			check: func(t *testing.T, result domain.TransferResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []domain.SubscriptionID{conflict.SourceID}, result.Trashed)
+			},
+		},
+		{
+			name:     "Unknown strategy",
+			strategy: "merge",
+			prepareMocks: func(
+				_ *mocks.MockSubscriptionsRepository,
+				_ *mocks.MockAuditRepository,
+				_ *mocks.MockChargeLedger,
+			) {
+			},
+			check: func(t *testing.T, _ domain.TransferResult, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceTransferUser)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+			repoSubscriptions := mocks.NewMockSubscriptionsRepository(t)
+			repoAudit := mocks.NewMockAuditRepository(t)
+			ledger := mocks.NewMockChargeLedger(t)
+
+			test.prepareMocks(repoSubscriptions, repoAudit, ledger)
+
+			result, err := domain.NewAdminService(
+				provider,
+				repoSubscriptions,
+				mocks.NewMockCatalogRepository(t),
+				repoAudit,
+				ledger,
+			).TransferUser(t.Context(), domain.UserTransfer{Source: source, Target: target, Strategy: test.strategy})
+
+			test.check(t, result, err)
+		})
+	}
+}
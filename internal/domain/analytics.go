@@ -0,0 +1,306 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"ef_project/internal/infra/log"
+)
+
+var _ AnalyticsInterface = (*AnalyticsService)(nil)
+
+var (
+	errServiceAnalytics   = errors.New("analytics service error")
+	ErrServiceTopServices = errors.Join(
+		errServiceAnalytics,
+		errors.New("top services failed"),
+	)
+	ErrServiceRevenue = errors.Join(
+		errServiceAnalytics,
+		errors.New("revenue failed"),
+	)
+	ErrServicePrices = errors.Join(
+		errServiceAnalytics,
+		errors.New("prices failed"),
+	)
+	ErrServiceFlow = errors.Join(
+		errServiceAnalytics,
+		errors.New("flow failed"),
+	)
+	ErrServiceChurn = errors.Join(
+		errServiceAnalytics,
+		errors.New("churn failed"),
+	)
+	ErrServiceRetention = errors.Join(
+		errServiceAnalytics,
+		errors.New("retention failed"),
+	)
+	ErrServicePriceInsights = errors.Join(
+		errServiceAnalytics,
+		errors.New("price insights failed"),
+	)
+	ErrServicePriceAnomalies = errors.Join(
+		errServiceAnalytics,
+		errors.New("price anomalies failed"),
+	)
+)
+
+const (
+	defaultTopServicesLimit = 10
+	maxTopServicesLimit     = 100
+
+	// A subscription costing more than priceOutlierRatio times the catalog
+	// price or the median other users pay is an outlier, and so is a median
+	// rising more than medianJumpRatio times in a month. Medians are only
+	// trusted over at least minPriceSample subscriptions.
+	priceOutlierRatio = 1.5
+	medianJumpRatio   = 1.2
+	minPriceSample    = 3
+)
+
+const (
+	PriceAboveCatalog PriceAnomalyKind = "above_catalog"
+	PriceAboveMarket  PriceAnomalyKind = "above_market"
+	PriceMedianJump   PriceAnomalyKind = "median_jump"
+)
+
+// AnalyticsService reports figures across all users. Everything is
+// aggregated by the database.
+type AnalyticsService struct {
+	provider      ConnectionProvider
+	analyticsRepo AnalyticsRepository
+}
+
+func NewAnalyticsService(provider ConnectionProvider, analyticsRepo AnalyticsRepository) *AnalyticsService {
+	return &AnalyticsService{
+		provider:      provider,
+		analyticsRepo: analyticsRepo,
+	}
+}
+
+// TopServices returns the services with the most users subscribed in the
+// month.
+func (s *AnalyticsService) TopServices(ctx context.Context, month time.Time, limit int) ([]ServiceSubscribers, error) {
+	slog.DebugContext(ctx, "Service: reading top services.", log.RequestID(ctx))
+	if limit <= 0 {
+		limit = defaultTopServicesLimit
+	}
+	limit = min(limit, maxTopServicesLimit)
+
+	var services []ServiceSubscribers
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		services, dbErr = s.analyticsRepo.TopServices(ctx, c, BillingPeriod(month), limit)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServiceTopServices, err)
+	}
+	return services, nil
+}
+
+// Revenue sums the monthly cost of the subscriptions of every service for
+// each month of the period.
+func (s *AnalyticsService) Revenue(ctx context.Context, period Period) ([]ServiceRevenue, error) {
+	slog.DebugContext(ctx, "Service: reading revenue.", log.RequestID(ctx))
+	start, end, err := analyticsPeriod(period)
+	if err != nil {
+		return nil, errors.Join(ErrServiceRevenue, err)
+	}
+
+	var revenue []ServiceRevenue
+	err = s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		revenue, dbErr = s.analyticsRepo.Revenue(ctx, c, start, end)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServiceRevenue, err)
+	}
+	return revenue, nil
+}
+
+// Prices returns the average and median monthly cost of every service over
+// its recurring subscriptions.
+func (s *AnalyticsService) Prices(ctx context.Context) ([]ServicePrice, error) {
+	slog.DebugContext(ctx, "Service: reading prices.", log.RequestID(ctx))
+	var prices []ServicePrice
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		prices, dbErr = s.analyticsRepo.Prices(ctx, c)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServicePrices, err)
+	}
+	return prices, nil
+}
+
+// Flow counts new and churned recurring subscriptions for each month of the
+// period. Auto-renewing subscriptions are not churned when their term ends.
+func (s *AnalyticsService) Flow(ctx context.Context, period Period) ([]SubscriptionFlow, error) {
+	slog.DebugContext(ctx, "Service: reading subscription flow.", log.RequestID(ctx))
+	start, end, err := analyticsPeriod(period)
+	if err != nil {
+		return nil, errors.Join(ErrServiceFlow, err)
+	}
+
+	var flow []SubscriptionFlow
+	err = s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		flow, dbErr = s.analyticsRepo.Flow(ctx, c, start, end)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServiceFlow, err)
+	}
+	return flow, nil
+}
+
+// Churn reports, for every service and month of the period, the share of
+// recurring subscriptions billed in the month that were billed for the last
+// time, their average lifetime in months and the reasons they were cancelled
+// for. Subscriptions ended without a reason code count as "unspecified".
+func (s *AnalyticsService) Churn(ctx context.Context, period Period, name *ServiceName) ([]ServiceChurn, error) {
+	slog.DebugContext(ctx, "Service: reading churn.", log.RequestID(ctx))
+	start, end, err := analyticsPeriod(period)
+	if err != nil {
+		return nil, errors.Join(ErrServiceChurn, err)
+	}
+
+	var (
+		churn   []ServiceChurn
+		reasons []ChurnReason
+	)
+	err = s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		churn, dbErr = s.analyticsRepo.Churn(ctx, c, start, end, name)
+		if dbErr != nil {
+			return dbErr
+		}
+		reasons, dbErr = s.analyticsRepo.ChurnReasons(ctx, c, start, end, name)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServiceChurn, err)
+	}
+
+	type churnKey struct {
+		name  ServiceName
+		month time.Time
+	}
+	byKey := make(map[churnKey]map[CancellationCode]int, len(churn))
+	for _, reason := range reasons {
+		key := churnKey{name: reason.Name, month: reason.Month}
+		if byKey[key] == nil {
+			byKey[key] = map[CancellationCode]int{}
+		}
+		byKey[key][reason.Code] += reason.Count
+	}
+	for i := range churn {
+		if churn[i].Active > 0 {
+			churn[i].Rate = float64(churn[i].Churned) / float64(churn[i].Active)
+		}
+		churn[i].Reasons = byKey[churnKey{name: churn[i].Name, month: churn[i].Month}]
+		if churn[i].Reasons == nil {
+			churn[i].Reasons = map[CancellationCode]int{}
+		}
+	}
+	return churn, nil
+}
+
+// Retention builds the retention matrix of the cohorts started in the period.
+// Every cohort is followed from its start month up to the end of the period,
+// so later cohorts have shorter rows.
+func (s *AnalyticsService) Retention(ctx context.Context, period Period, name *ServiceName) ([]Cohort, error) {
+	slog.DebugContext(ctx, "Service: reading retention.", log.RequestID(ctx))
+	start, end, err := analyticsPeriod(period)
+	if err != nil {
+		return nil, errors.Join(ErrServiceRetention, err)
+	}
+
+	var months []CohortMonth
+	err = s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		months, dbErr = s.analyticsRepo.Retention(ctx, c, start, end, name)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServiceRetention, err)
+	}
+
+	// Rows come ordered by cohort and months later, starting at zero.
+	var cohorts []Cohort
+	for _, month := range months {
+		if month.MonthsLater == 0 {
+			cohorts = append(cohorts, Cohort{Name: month.Name, Month: month.Month, Size: month.Size})
+		}
+		if len(cohorts) == 0 {
+			continue
+		}
+		cohort := &cohorts[len(cohorts)-1]
+		cohort.Retained = append(cohort.Retained, month.Retained)
+		cohort.Retention = append(cohort.Retention, float64(month.Retained)/float64(cohort.Size))
+	}
+	return cohorts, nil
+}
+
+// PriceInsights lists the recurring subscriptions of the user billed in the
+// month that cost far more than the catalog price of their service or than
+// other users pay for it.
+func (s *AnalyticsService) PriceInsights(ctx context.Context, userID UserID, month time.Time) ([]PriceAnomaly, error) {
+	slog.DebugContext(ctx, "Service: reading price insights.", log.RequestID(ctx))
+	var insights []PriceAnomaly
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		insights, dbErr = s.analyticsRepo.PriceOutliers(
+			ctx,
+			c,
+			&userID,
+			BillingPeriod(month),
+			priceOutlierRatio,
+			minPriceSample,
+		)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServicePriceInsights, err)
+	}
+	return insights, nil
+}
+
+// PriceAnomalies is the operator feed: the services whose median price jumped
+// in a month of the period, followed by the subscriptions of all users that
+// are outliers in its last month.
+func (s *AnalyticsService) PriceAnomalies(ctx context.Context, period Period) ([]PriceAnomaly, error) {
+	slog.DebugContext(ctx, "Service: reading price anomalies.", log.RequestID(ctx))
+	start, end, err := analyticsPeriod(period)
+	if err != nil {
+		return nil, errors.Join(ErrServicePriceAnomalies, err)
+	}
+
+	var jumps, outliers []PriceAnomaly
+	err = s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		jumps, dbErr = s.analyticsRepo.MedianJumps(ctx, c, start, end, medianJumpRatio, minPriceSample)
+		if dbErr != nil {
+			return dbErr
+		}
+		outliers, dbErr = s.analyticsRepo.PriceOutliers(ctx, c, nil, end, priceOutlierRatio, minPriceSample)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServicePriceAnomalies, err)
+	}
+	return append(jumps, outliers...), nil
+}
+
+func analyticsPeriod(period Period) (time.Time, time.Time, error) {
+	start, end := BillingPeriod(period.Start), BillingPeriod(period.End)
+	if end.Before(start) {
+		return start, end, errors.New("period end is before its start")
+	}
+	return start, end, nil
+}
@@ -0,0 +1,187 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_TopServices(t *testing.T) {
+	t.Parallel()
+
+	month := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{name: "Default limit", limit: 0, want: 10},
+		{name: "Requested limit", limit: 3, want: 3},
+		{name: "Capped limit", limit: 1000, want: 100},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoAnalytics := mocks.NewMockAnalyticsRepository(t)
+			repoAnalytics.EXPECT().TopServices(mock.Anything, mock.Anything, month, test.want).
+				Return([]domain.ServiceSubscribers{{Name: "Netflix", Subscribers: 2}}, nil).Once()
+
+			services, err := domain.NewAnalyticsService(
+				database.NewDummyProvider(mocks.NewMockConnection(t)),
+				repoAnalytics,
+			).TopServices(t.Context(), month.AddDate(0, 0, 20), test.limit)
+
+			require.NoError(t, err)
+			require.Len(t, services, 1)
+		})
+	}
+}
+
+func TestServicePVZ_Flow(t *testing.T) {
+	t.Parallel()
+
+	january := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("End before start", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := domain.NewAnalyticsService(
+			database.NewDummyProvider(mocks.NewMockConnection(t)),
+			mocks.NewMockAnalyticsRepository(t),
+		).Flow(t.Context(), domain.Period{Start: march, End: january})
+
+		require.ErrorIs(t, err, domain.ErrServiceFlow)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		t.Parallel()
+
+		repoAnalytics := mocks.NewMockAnalyticsRepository(t)
+		repoAnalytics.EXPECT().Flow(mock.Anything, mock.Anything, january, march).
+			Return(nil, errors.New("some error")).Once()
+
+		_, err := domain.NewAnalyticsService(
+			database.NewDummyProvider(mocks.NewMockConnection(t)),
+			repoAnalytics,
+		).Flow(t.Context(), domain.Period{Start: january.AddDate(0, 0, 5), End: march})
+
+		require.ErrorIs(t, err, domain.ErrServiceFlow)
+		require.ErrorContains(t, err, "some error")
+	})
+}
+
+func TestServicePVZ_Churn(t *testing.T) {
+	t.Parallel()
+
+	february := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	name := domain.ServiceName("Netflix")
+
+	repoAnalytics := mocks.NewMockAnalyticsRepository(t)
+	repoAnalytics.EXPECT().Churn(mock.Anything, mock.Anything, february, march, &name).
+		Return([]domain.ServiceChurn{
+			{Name: name, Month: february, Active: 4},
+			{Name: name, Month: march, Active: 4, Churned: 3, AverageLifetime: 5},
+		}, nil).Once()
+	repoAnalytics.EXPECT().ChurnReasons(mock.Anything, mock.Anything, february, march, &name).
+		Return([]domain.ChurnReason{
+			{Name: name, Month: march, Code: domain.CancellationTooExpensive, Count: 2},
+			{Name: name, Month: march, Code: "unspecified", Count: 1},
+		}, nil).Once()
+
+	churn, err := domain.NewAnalyticsService(
+		database.NewDummyProvider(mocks.NewMockConnection(t)),
+		repoAnalytics,
+	).Churn(t.Context(), domain.Period{Start: february, End: march.AddDate(0, 0, 10)}, &name)
+
+	require.NoError(t, err)
+	require.Equal(t, []domain.ServiceChurn{
+		{Name: name, Month: february, Active: 4, Reasons: map[domain.CancellationCode]int{}},
+		{
+			Name:            name,
+			Month:           march,
+			Active:          4,
+			Churned:         3,
+			AverageLifetime: 5,
+			Rate:            0.75,
+			Reasons: map[domain.CancellationCode]int{
+				domain.CancellationTooExpensive: 2,
+				"unspecified":                   1,
+			},
+		},
+	}, churn)
+}
+
+func TestServicePVZ_Retention(t *testing.T) {
+	t.Parallel()
+
+	january := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	february := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	repoAnalytics := mocks.NewMockAnalyticsRepository(t)
+	repoAnalytics.EXPECT().Retention(mock.Anything, mock.Anything, january, march, (*domain.ServiceName)(nil)).
+		Return([]domain.CohortMonth{
+			{Name: "Netflix", Month: january, MonthsLater: 0, Size: 4, Retained: 4},
+			{Name: "Netflix", Month: january, MonthsLater: 1, Size: 4, Retained: 3},
+			{Name: "Netflix", Month: january, MonthsLater: 2, Size: 4, Retained: 1},
+			{Name: "Spotify", Month: february, MonthsLater: 0, Size: 2, Retained: 2},
+			{Name: "Spotify", Month: february, MonthsLater: 1, Size: 2, Retained: 1},
+		}, nil).Once()
+
+	cohorts, err := domain.NewAnalyticsService(
+		database.NewDummyProvider(mocks.NewMockConnection(t)),
+		repoAnalytics,
+	).Retention(t.Context(), domain.Period{Start: january, End: march}, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, []domain.Cohort{
+		{Name: "Netflix", Month: january, Size: 4, Retained: []int{4, 3, 1}, Retention: []float64{1, 0.75, 0.25}},
+		{Name: "Spotify", Month: february, Size: 2, Retained: []int{2, 1}, Retention: []float64{1, 0.5}},
+	}, cohorts)
+}
+
+func TestServicePVZ_PriceAnomalies(t *testing.T) {
+	t.Parallel()
+
+	february := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	jump := domain.PriceAnomaly{
+		Kind: domain.PriceMedianJump, Name: "Netflix", Month: march, Price: 900, Reference: 700, Ratio: 900.0 / 700,
+	}
+	outlier := domain.PriceAnomaly{
+		Kind:           domain.PriceAboveMarket,
+		Name:           "Spotify",
+		Month:          march,
+		SubscriptionID: pointer.Ref(uuid.New()),
+		UserID:         pointer.Ref(uuid.New()),
+		Price:          600,
+		Reference:      300,
+		Ratio:          2,
+	}
+
+	repoAnalytics := mocks.NewMockAnalyticsRepository(t)
+	repoAnalytics.EXPECT().MedianJumps(mock.Anything, mock.Anything, february, march, 1.2, 3).
+		Return([]domain.PriceAnomaly{jump}, nil).Once()
+	repoAnalytics.EXPECT().PriceOutliers(mock.Anything, mock.Anything, (*domain.UserID)(nil), march, 1.5, 3).
+		Return([]domain.PriceAnomaly{outlier}, nil).Once()
+
+	anomalies, err := domain.NewAnalyticsService(
+		database.NewDummyProvider(mocks.NewMockConnection(t)),
+		repoAnalytics,
+	).PriceAnomalies(t.Context(), domain.Period{Start: february, End: march.AddDate(0, 0, 10)})
+
+	require.NoError(t, err)
+	require.Equal(t, []domain.PriceAnomaly{jump, outlier}, anomalies)
+}
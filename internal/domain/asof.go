@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sort"
+	"time"
+
+	"ef_project/internal/infra/log"
+)
+
+var (
+	ErrServiceReadActiveAt = errors.Join(
+		errServiseSubscription,
+		errors.New("read active subscriptions failed"),
+	)
+	ErrServiceActiveSet = errors.Join(
+		errServiseSubscription,
+		errors.New("active set failed"),
+	)
+)
+
+// ReadActiveAt returns the user's own subscriptions billed in the month of
+// asOf, oldest first.
+func (s *SubscriptionService) ReadActiveAt(
+	ctx context.Context,
+	userID UserID,
+	asOf time.Time,
+) ([]Subscription, error) {
+	slog.DebugContext(ctx, "Service: reading subscribtions active at a date.", log.RequestID(ctx))
+	month := BillingPeriod(asOf)
+
+	var subscriptions []Subscription
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		subscriptions, dbErr = s.subscriptionRepo.ReadActiveInPeriod(ctx, c, &userID, nil, month, month)
+		if dbErr != nil {
+			return dbErr
+		}
+		subscriptions = slices.DeleteFunc(subscriptions, func(subscription Subscription) bool {
+			return subscription.UserID != userID
+		})
+		subscriptions, dbErr = attachMembers(ctx, c, s.subscriptionRepo, subscriptions)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServiceReadActiveAt, err)
+	}
+
+	sortByStart(subscriptions)
+	return subscriptions, nil
+}
+
+// ActiveSet returns the subscriptions the user pays for or shares in the
+// month of date, oldest first, and what they owe for them that month.
+func (s *SubscriptionService) ActiveSet(ctx context.Context, userID UserID, date time.Time) (ActiveSet, error) {
+	slog.DebugContext(ctx, "Service: reading active set.", log.RequestID(ctx))
+	month := BillingPeriod(date)
+
+	var (
+		subscriptions []Subscription
+		charges       []Charge
+	)
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var err error
+		subscriptions, err = readActiveInPeriod(ctx, c, s.subscriptionRepo, &userID, nil, month, month)
+		if err != nil {
+			return err
+		}
+		charges, err = readCharges(ctx, c, s.ledger, s.subscriptionRepo, ChargeQuery{
+			UserID: &userID,
+			Start:  month,
+			End:    month,
+		})
+		return err
+	})
+	if err != nil {
+		return ActiveSet{}, errors.Join(ErrServiceActiveSet, err)
+	}
+
+	sortByStart(subscriptions)
+	set := ActiveSet{UserID: userID, Month: month, Subscriptions: subscriptions}
+	for _, charge := range charges {
+		set.Total += charge.Amount
+	}
+	return set, nil
+}
+
+func sortByStart(subscriptions []Subscription) {
+	sort.SliceStable(subscriptions, func(i, j int) bool {
+		if !subscriptions[i].StartDate.Equal(subscriptions[j].StartDate) {
+			return subscriptions[i].StartDate.Before(subscriptions[j].StartDate)
+		}
+		return subscriptions[i].Name < subscriptions[j].Name
+	})
+}
@@ -0,0 +1,94 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_ReadActiveAt(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	older := domain.Subscription{
+		ID: uuid.New(), Name: "Spotify", Cost: 300, UserID: userID,
+		StartDate: march.AddDate(0, -2, 0),
+	}
+	newer := domain.Subscription{
+		ID: uuid.New(), Name: "Netflix", Cost: 700, UserID: userID,
+		StartDate: march,
+	}
+	shared := domain.Subscription{
+		ID: uuid.New(), Name: "YouTube", Cost: 500, UserID: uuid.New(),
+		StartDate: march.AddDate(0, -1, 0),
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoSunbscriptions.EXPECT().
+		ReadActiveInPeriod(mock.Anything, mock.Anything, &userID, (*domain.ServiceName)(nil), march, march).
+		Return([]domain.Subscription{newer, shared, older}, nil).Once()
+
+	subscriptions, err := domain.NewSubscriptionService(provider, repoSunbscriptions, mocks.NewMockCatalogRepository(t)).
+		ReadActiveAt(t.Context(), userID, march.AddDate(0, 0, 20))
+
+	require.NoError(t, err)
+	require.Equal(t, []domain.Subscription{older, newer}, subscriptions)
+}
+
+func TestServicePVZ_ActiveSet(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	payer := uuid.New()
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	own := domain.Subscription{
+		ID: uuid.New(), Name: "Spotify", Cost: 300, UserID: userID,
+		StartDate: march.AddDate(0, -2, 0),
+	}
+	shared := domain.Subscription{
+		ID: uuid.New(), Name: "YouTube", Cost: 500, UserID: payer,
+		StartDate: march.AddDate(0, -1, 0), SplitRule: pointer.Ref(domain.SplitEqual),
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoSunbscriptions.EXPECT().
+		ReadActiveInPeriod(mock.Anything, mock.Anything, &userID, (*domain.ServiceName)(nil), march, march).
+		Return([]domain.Subscription{shared, own}, nil).Once()
+	repoSunbscriptions.EXPECT().ReadMembers(mock.Anything, mock.Anything, []domain.SubscriptionID{shared.ID}).
+		Return([]domain.SubscriptionMember{{SubscriptionID: shared.ID, UserID: userID}}, nil).Once()
+	repoSunbscriptions.EXPECT().ReadDiscounts(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	ledger := mocks.NewMockChargeLedger(t)
+	ledger.EXPECT().
+		Charges(mock.Anything, mock.Anything, domain.ChargeQuery{UserID: &userID, Start: march, End: march}).
+		Return([]domain.Charge{
+			{SubscriptionID: own.ID, UserID: userID, PayerID: userID, Month: march, Amount: 300},
+			{SubscriptionID: shared.ID, UserID: userID, PayerID: payer, Month: march, Amount: 250},
+		}, nil).Once()
+
+	set, err := domain.NewSubscriptionService(
+		provider,
+		repoSunbscriptions,
+		mocks.NewMockCatalogRepository(t),
+		domain.WithChargeLedger(ledger),
+	).ActiveSet(t.Context(), userID, march)
+
+	require.NoError(t, err)
+	require.Equal(t, userID, set.UserID)
+	require.Equal(t, march, set.Month)
+	require.Len(t, set.Subscriptions, 2)
+	require.Equal(t, own.ID, set.Subscriptions[0].ID)
+	require.Equal(t, shared.ID, set.Subscriptions[1].ID)
+	require.Equal(t, 300+250, set.Total)
+}
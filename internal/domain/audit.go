@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"ef_project/internal/infra/log"
+
+	"github.com/google/uuid"
+)
+
+var _ AuditInterface = (*AuditService)(nil)
+
+const (
+	AuditOperationRenameService       = "service_rename"
+	AuditOperationMergeServices       = "service_merge"
+	AuditOperationCreateSubscription  = "subscription_create"
+	AuditOperationUpdateSubscription  = "subscription_update"
+	AuditOperationDeleteSubscription  = "subscription_delete"
+	AuditOperationCancelSubscription  = "subscription_cancel"
+	AuditOperationRestoreSubscription = "subscription_restore"
+	AuditOperationUndoSubscription    = "subscription_undo"
+	AuditOperationQualityFix          = "data_quality_fix"
+	AuditOperationTransferUser        = "user_transfer"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+var (
+	errServiceAudit        = errors.New("audit service error")
+	ErrServiceAuditHistory = errors.Join(
+		errServiceAudit,
+		errors.New("history failed"),
+	)
+	ErrServiceAuditSearch = errors.Join(
+		errServiceAudit,
+		errors.New("search failed"),
+	)
+)
+
+// AuditService reads the audit log. Records are only ever written by the
+// services making the audited changes, in the same transaction.
+type AuditService struct {
+	provider  ConnectionProvider
+	auditRepo AuditRepository
+}
+
+func NewAuditService(provider ConnectionProvider, auditRepo AuditRepository) *AuditService {
+	return &AuditService{
+		provider:  provider,
+		auditRepo: auditRepo,
+	}
+}
+
+// History returns the audit records of a subscription, oldest first.
+func (s *AuditService) History(ctx context.Context, subscriptionID SubscriptionID) ([]AuditEntry, error) {
+	slog.DebugContext(ctx, "Service: reading subscription history.", log.RequestID(ctx))
+	var entries []AuditEntry
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		entries, dbErr = s.auditRepo.ListBySubscriptionID(ctx, c, subscriptionID)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServiceAuditHistory, err)
+	}
+	return entries, nil
+}
+
+// Search returns the audit records matching the filter, newest first.
+func (s *AuditService) Search(ctx context.Context, filter AuditFilter) ([]AuditEntry, error) {
+	slog.DebugContext(ctx, "Service: searching audit log.", log.RequestID(ctx))
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAuditLimit
+	}
+	filter.Limit = min(filter.Limit, maxAuditLimit)
+	filter.Offset = max(filter.Offset, 0)
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return nil, errors.Join(ErrServiceAuditSearch, errors.New("search end is before its start"))
+	}
+
+	var entries []AuditEntry
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		entries, dbErr = s.auditRepo.Search(ctx, c, filter)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServiceAuditSearch, err)
+	}
+	return entries, nil
+}
+
+// newAuditEntry starts an audit record attributed to the actor and request
+// found in the context.
+func newAuditEntry(ctx context.Context, operation string) AuditEntry {
+	return AuditEntry{
+		ID:        uuid.New(),
+		Actor:     log.Actor(ctx).Value.String(),
+		RequestID: log.RequestID(ctx).Value.String(),
+		Operation: operation,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// subscriptionAuditEntry records a subscription write with the subscription
+// as it was before and after it. A nil snapshot is stored as null.
+func subscriptionAuditEntry(ctx context.Context, operation string, before, after *Subscription) (AuditEntry, error) {
+	entry := newAuditEntry(ctx, operation)
+	for _, snapshot := range []*Subscription{before, after} {
+		if snapshot != nil {
+			entry.SubscriptionID, entry.UserID = &snapshot.ID, &snapshot.UserID
+		}
+	}
+
+	var err error
+	if before != nil {
+		if entry.Before, err = json.Marshal(before); err != nil {
+			return entry, err
+		}
+	}
+	if after != nil {
+		if entry.After, err = json.Marshal(after); err != nil {
+			return entry, err
+		}
+	}
+	return entry, nil
+}
@@ -0,0 +1,167 @@
+package domain_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_DeleteRecordsAudit(t *testing.T) {
+	t.Parallel()
+
+	before := domain.Subscription{
+		ID:        uuid.New(),
+		Kind:      domain.SubscriptionRecurring,
+		Name:      "Netflix",
+		Cost:      700,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	after := before
+	after.DeletedAt = pointer.Ref(time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC))
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoAudit := mocks.NewMockAuditRepository(t)
+	repoSunbscriptions.EXPECT().GetLatestByName(mock.Anything, mock.Anything, before.UserID, before.Name).
+		Return(before, nil).Once()
+	repoSunbscriptions.EXPECT().Delete(mock.Anything, mock.Anything, before.UserID, before.Name).
+		Return(nil).Once()
+	repoSunbscriptions.EXPECT().GetByID(mock.Anything, mock.Anything, before.ID).
+		Return(after, nil).Once()
+
+	var recorded domain.AuditEntry
+	repoAudit.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ domain.Connection, entry domain.AuditEntry) {
+			recorded = entry
+		}).
+		Return(nil).Once()
+
+	err := domain.NewSubscriptionService(
+		provider,
+		repoSunbscriptions,
+		mocks.NewMockCatalogRepository(t),
+		domain.WithAuditLog(repoAudit),
+	).Delete(t.Context(), before.UserID, before.Name)
+
+	require.NoError(t, err)
+	require.Equal(t, domain.AuditOperationDeleteSubscription, recorded.Operation)
+	require.Equal(t, &before.ID, recorded.SubscriptionID)
+	require.Equal(t, &before.UserID, recorded.UserID)
+
+	var snapshot domain.Subscription
+	require.NoError(t, json.Unmarshal(recorded.Before, &snapshot))
+	require.Equal(t, before, snapshot)
+	require.NoError(t, json.Unmarshal(recorded.After, &snapshot))
+	require.Equal(t, after.DeletedAt, snapshot.DeletedAt)
+}
+
+func TestServicePVZ_CancelRecordsAudit(t *testing.T) {
+	t.Parallel()
+
+	before := domain.Subscription{
+		ID:        uuid.New(),
+		Kind:      domain.SubscriptionRecurring,
+		Name:      "Netflix",
+		Cost:      700,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	endDate := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	after := before
+	after.EndDate = &endDate
+	after.CancellationCode = pointer.Ref(domain.CancellationNotUsing)
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoAudit := mocks.NewMockAuditRepository(t)
+	repoSunbscriptions.EXPECT().GetLatestByName(mock.Anything, mock.Anything, before.UserID, before.Name).
+		Return(before, nil).Once()
+	repoSunbscriptions.EXPECT().
+		Cancel(mock.Anything, mock.Anything, before.UserID, before.Name, endDate, domain.CancellationNotUsing, "").
+		Return(nil).Once()
+	repoSunbscriptions.EXPECT().GetByID(mock.Anything, mock.Anything, before.ID).
+		Return(after, nil).Once()
+
+	var recorded domain.AuditEntry
+	repoAudit.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ domain.Connection, entry domain.AuditEntry) {
+			recorded = entry
+		}).
+		Return(nil).Once()
+
+	err := domain.NewSubscriptionService(
+		provider,
+		repoSunbscriptions,
+		mocks.NewMockCatalogRepository(t),
+		domain.WithAuditLog(repoAudit),
+	).Cancel(t.Context(), domain.Cancellation{
+		UserID:  before.UserID,
+		Name:    before.Name,
+		EndDate: &endDate,
+		Code:    domain.CancellationNotUsing,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, domain.AuditOperationCancelSubscription, recorded.Operation)
+	require.Equal(t, &before.ID, recorded.SubscriptionID)
+
+	var snapshot domain.Subscription
+	require.NoError(t, json.Unmarshal(recorded.Before, &snapshot))
+	require.Equal(t, before, snapshot)
+	require.NoError(t, json.Unmarshal(recorded.After, &snapshot))
+	require.Equal(t, after.CancellationCode, snapshot.CancellationCode)
+}
+
+func TestServicePVZ_SearchAudit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{name: "Default limit", limit: 0, want: 100},
+		{name: "Requested limit", limit: 20, want: 20},
+		{name: "Capped limit", limit: 5000, want: 1000},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			actor := "support"
+			repoAudit := mocks.NewMockAuditRepository(t)
+			repoAudit.EXPECT().
+				Search(mock.Anything, mock.Anything, domain.AuditFilter{Actor: &actor, Limit: test.want}).
+				Return([]domain.AuditEntry{{ID: uuid.New(), Actor: actor}}, nil).Once()
+
+			entries, err := domain.NewAuditService(database.NewDummyProvider(mocks.NewMockConnection(t)), repoAudit).
+				Search(t.Context(), domain.AuditFilter{Actor: &actor, Limit: test.limit})
+
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+		})
+	}
+
+	t.Run("End before start", func(t *testing.T) {
+		t.Parallel()
+
+		from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+		_, err := domain.NewAuditService(
+			database.NewDummyProvider(mocks.NewMockConnection(t)),
+			mocks.NewMockAuditRepository(t),
+		).Search(t.Context(), domain.AuditFilter{From: &from, To: pointer.Ref(from.AddDate(0, 0, -1))})
+
+		require.ErrorIs(t, err, domain.ErrServiceAuditSearch)
+	})
+}
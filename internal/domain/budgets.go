@@ -0,0 +1,310 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"ef_project/internal/infra/log"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ BudgetInterface = (*BudgetService)(nil)
+	_ BudgetEvaluator = (*BudgetService)(nil)
+)
+
+var (
+	errServiceBudget       = errors.New("budget service error")
+	ErrServiceCreateBudget = errors.Join(
+		errServiceBudget,
+		errors.New("create failed"),
+	)
+	ErrServiceListBudgets = errors.Join(
+		errServiceBudget,
+		errors.New("list failed"),
+	)
+	ErrServiceDeleteBudget = errors.Join(
+		errServiceBudget,
+		errors.New("delete failed"),
+	)
+	ErrServiceBudgetStatus = errors.Join(
+		errServiceBudget,
+		errors.New("status failed"),
+	)
+	ErrServiceEvaluateBudgets = errors.Join(
+		errServiceBudget,
+		errors.New("evaluate failed"),
+	)
+)
+
+const (
+	BudgetScopeTotal    BudgetScope = "total"
+	BudgetScopeCategory BudgetScope = "category"
+	BudgetScopeService  BudgetScope = "service"
+)
+
+// defaultBudgetThresholds are the utilisation percentages reported as
+// breaches when a budget is created without its own thresholds.
+func defaultBudgetThresholds() []int {
+	return []int{80, 100}
+}
+
+type BudgetService struct {
+	provider         ConnectionProvider
+	budgetRepo       BudgetRepository
+	subscriptionRepo SubscriptionsRepository
+	ledger           ChargeLedger
+	publisher        BudgetEventPublisher
+}
+
+func NewBudgetService(
+	provider ConnectionProvider,
+	budgetRepo BudgetRepository,
+	subscriptionRepo SubscriptionsRepository,
+	ledger ChargeLedger,
+	publisher BudgetEventPublisher,
+) *BudgetService {
+	return &BudgetService{
+		provider:         provider,
+		budgetRepo:       budgetRepo,
+		subscriptionRepo: subscriptionRepo,
+		ledger:           ledger,
+		publisher:        publisher,
+	}
+}
+
+func (s *BudgetService) Create(ctx context.Context, budget Budget) (Budget, error) {
+	slog.DebugContext(ctx, "Service: creating budget.", log.RequestID(ctx))
+	budget, err := normalizeBudget(budget)
+	if err != nil {
+		return budget, errors.Join(ErrServiceCreateBudget, err)
+	}
+	budget.ID = uuid.New()
+
+	err = s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		return s.budgetRepo.Create(ctx, c, budget)
+	})
+	if err != nil {
+		return budget, errors.Join(ErrServiceCreateBudget, err)
+	}
+	return budget, nil
+}
+
+func (s *BudgetService) List(ctx context.Context, userID UserID) ([]Budget, error) {
+	slog.DebugContext(ctx, "Service: listing budgets.", log.RequestID(ctx))
+	var budgets []Budget
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		budgets, dbErr = s.budgetRepo.ListByUserID(ctx, c, userID)
+		return dbErr
+	})
+	if err != nil {
+		return budgets, errors.Join(ErrServiceListBudgets, err)
+	}
+	return budgets, nil
+}
+
+func (s *BudgetService) Delete(ctx context.Context, userID UserID, budgetID BudgetID) error {
+	slog.DebugContext(ctx, "Service: deleting budget.", log.RequestID(ctx))
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		return s.budgetRepo.Delete(ctx, c, userID, budgetID)
+	})
+	if err != nil {
+		return errors.Join(ErrServiceDeleteBudget, err)
+	}
+	return nil
+}
+
+// Status compares the spend of the month containing now and the forecast for
+// the next month against every budget of the user.
+func (s *BudgetService) Status(ctx context.Context, userID UserID, now time.Time) ([]BudgetStatus, error) {
+	slog.DebugContext(ctx, "Service: evaluating budgets.", log.RequestID(ctx))
+	month := BillingPeriod(now)
+	next := month.AddDate(0, 1, 0)
+
+	var statuses []BudgetStatus
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		budgets, err := s.budgetRepo.ListByUserID(ctx, c, userID)
+		if err != nil || len(budgets) == 0 {
+			return err
+		}
+		charges, err := readCharges(ctx, c, s.ledger, s.subscriptionRepo, ChargeQuery{
+			UserID: &userID,
+			Start:  month,
+			End:    next,
+		})
+		if err != nil {
+			return err
+		}
+
+		spent := budgetSpend(budgets, charges, month)
+		forecast := budgetSpend(budgets, charges, next)
+		for _, budget := range budgets {
+			statuses = append(statuses, BudgetStatus{
+				Budget:              budget,
+				Spent:               spent[budget.ID],
+				Forecast:            forecast[budget.ID],
+				Utilisation:         utilisation(spent[budget.ID], budget.Limit),
+				ForecastUtilisation: utilisation(forecast[budget.ID], budget.Limit),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServiceBudgetStatus, err)
+	}
+	return statuses, nil
+}
+
+// Snapshot records the user's spend against each budget for a month, before
+// a subscription write changes it.
+func (s *BudgetService) Snapshot(
+	ctx context.Context,
+	c Connection,
+	userID UserID,
+	month time.Time,
+) (BudgetSnapshot, error) {
+	snapshot := BudgetSnapshot{UserID: userID, Month: BillingPeriod(month)}
+	budgets, err := s.budgetRepo.ListByUserID(ctx, c, userID)
+	if err != nil {
+		return snapshot, errors.Join(ErrServiceEvaluateBudgets, err)
+	}
+	snapshot.Budgets = budgets
+	snapshot.Spent, err = s.monthSpend(ctx, c, snapshot)
+	if err != nil {
+		return snapshot, errors.Join(ErrServiceEvaluateBudgets, err)
+	}
+	return snapshot, nil
+}
+
+// Breaches recomputes the spend of a snapshot and returns every threshold that
+// was below it before and is reached now.
+func (s *BudgetService) Breaches(
+	ctx context.Context,
+	c Connection,
+	snapshot BudgetSnapshot,
+) ([]BudgetBreach, error) {
+	spent, err := s.monthSpend(ctx, c, snapshot)
+	if err != nil {
+		return nil, errors.Join(ErrServiceEvaluateBudgets, err)
+	}
+
+	var breaches []BudgetBreach
+	for _, budget := range snapshot.Budgets {
+		before, after := snapshot.Spent[budget.ID], spent[budget.ID]
+		for _, threshold := range budget.Thresholds {
+			if !reachesThreshold(before, budget.Limit, threshold) &&
+				reachesThreshold(after, budget.Limit, threshold) {
+				breaches = append(breaches, BudgetBreach{
+					Budget:    budget,
+					Month:     snapshot.Month,
+					Threshold: threshold,
+					Spent:     after,
+				})
+			}
+		}
+	}
+	return breaches, nil
+}
+
+// Publish hands breaches over to the publisher. The write that caused them is
+// already committed, so failures are only logged.
+func (s *BudgetService) Publish(ctx context.Context, breaches []BudgetBreach) {
+	for _, breach := range breaches {
+		if err := s.publisher.PublishBudgetBreach(ctx, breach); err != nil {
+			slog.ErrorContext(ctx, "Publishing budget breach failed.", log.ErrorAttr(err), log.RequestID(ctx))
+		}
+	}
+}
+
+func (s *BudgetService) monthSpend(
+	ctx context.Context,
+	c Connection,
+	snapshot BudgetSnapshot,
+) (map[BudgetID]int, error) {
+	if len(snapshot.Budgets) == 0 {
+		return map[BudgetID]int{}, nil
+	}
+	charges, err := readCharges(ctx, c, s.ledger, s.subscriptionRepo, ChargeQuery{
+		UserID: &snapshot.UserID,
+		Start:  snapshot.Month,
+		End:    snapshot.Month,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return budgetSpend(snapshot.Budgets, charges, snapshot.Month), nil
+}
+
+func budgetSpend(budgets []Budget, charges []Charge, month time.Time) map[BudgetID]int {
+	spent := make(map[BudgetID]int, len(budgets))
+	for _, charge := range charges {
+		if !charge.Month.Equal(month) {
+			continue
+		}
+		for _, budget := range budgets {
+			if budgetCovers(budget, charge) {
+				spent[budget.ID] += charge.Amount
+			}
+		}
+	}
+	return spent
+}
+
+func budgetCovers(budget Budget, charge Charge) bool {
+	switch budget.Scope {
+	case BudgetScopeTotal:
+		return true
+	case BudgetScopeCategory:
+		return budget.Target != nil && charge.Category != nil &&
+			strings.EqualFold(*budget.Target, *charge.Category)
+	case BudgetScopeService:
+		return budget.Target != nil && strings.EqualFold(*budget.Target, charge.Name)
+	}
+	return false
+}
+
+func reachesThreshold(spent int, limit int, threshold int) bool {
+	return spent*100 >= limit*threshold
+}
+
+// utilisation returns spent as a percentage of limit.
+func utilisation(spent int, limit int) float64 {
+	return float64(spent) * 100 / float64(limit)
+}
+
+func normalizeBudget(budget Budget) (Budget, error) {
+	if budget.Limit <= 0 {
+		return budget, errors.New("limit must be positive")
+	}
+
+	switch budget.Scope {
+	case BudgetScopeTotal:
+		budget.Target = nil
+	case BudgetScopeCategory, BudgetScopeService:
+		if budget.Target == nil || strings.TrimSpace(*budget.Target) == "" {
+			return budget, errors.New("budget scope " + string(budget.Scope) + " needs a target")
+		}
+		target := strings.TrimSpace(*budget.Target)
+		budget.Target = &target
+	default:
+		return budget, errors.New("unknown budget scope " + string(budget.Scope))
+	}
+
+	if len(budget.Thresholds) == 0 {
+		budget.Thresholds = defaultBudgetThresholds()
+	}
+	for _, threshold := range budget.Thresholds {
+		if threshold <= 0 {
+			return budget, errors.New("thresholds must be positive")
+		}
+	}
+	budget.Thresholds = slices.Compact(slices.Sorted(slices.Values(budget.Thresholds)))
+
+	return budget, nil
+}
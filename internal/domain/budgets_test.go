@@ -0,0 +1,253 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetService_Create(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name         string
+		budget       domain.Budget
+		prepareMocks func(*mocks.MockBudgetRepository)
+		check        func(*testing.T, domain.Budget, error)
+	}{
+		{
+			name:   "Default thresholds",
+			budget: domain.Budget{UserID: userID, Scope: domain.BudgetScopeTotal, Limit: 1000},
+			prepareMocks: func(repo *mocks.MockBudgetRepository) {
+				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			check: func(t *testing.T, budget domain.Budget, err error) {
+				require.NoError(t, err)
+				require.NotEqual(t, uuid.Nil, budget.ID)
+				require.Equal(t, []int{80, 100}, budget.Thresholds)
+			},
+		},
+		{
+			name: "Thresholds sorted",
+			budget: domain.Budget{
+				UserID:     userID,
+				Scope:      domain.BudgetScopeCategory,
+				Target:     pointer.Ref(" video "),
+				Limit:      500,
+				Thresholds: []int{100, 50, 100},
+			},
+			prepareMocks: func(repo *mocks.MockBudgetRepository) {
+				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			check: func(t *testing.T, budget domain.Budget, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "video", *budget.Target)
+				require.Equal(t, []int{50, 100}, budget.Thresholds)
+			},
+		},
+		{
+			name:   "Target missing",
+			budget: domain.Budget{UserID: userID, Scope: domain.BudgetScopeService, Limit: 500},
+			check: func(t *testing.T, _ domain.Budget, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceCreateBudget)
+			},
+		},
+		{
+			name:   "Limit not positive",
+			budget: domain.Budget{UserID: userID, Scope: domain.BudgetScopeTotal},
+			check: func(t *testing.T, _ domain.Budget, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceCreateBudget)
+			},
+		},
+		{
+			name:   "DB Error",
+			budget: domain.Budget{UserID: userID, Scope: domain.BudgetScopeTotal, Limit: 1000},
+			prepareMocks: func(repo *mocks.MockBudgetRepository) {
+				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, _ domain.Budget, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceCreateBudget)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+			repoBudgets := mocks.NewMockBudgetRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(repoBudgets)
+			}
+			budget, err := domain.NewBudgetService(
+				provider,
+				repoBudgets,
+				mocks.NewMockSubscriptionsRepository(t),
+				nil,
+				mocks.NewMockBudgetEventPublisher(t),
+			).Create(t.Context(), test.budget)
+
+			test.check(t, budget, err)
+		})
+	}
+}
+
+func TestBudgetService_Status(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	total := domain.Budget{ID: uuid.New(), UserID: userID, Scope: domain.BudgetScopeTotal, Limit: 1000}
+	video := domain.Budget{
+		ID:     uuid.New(),
+		UserID: userID,
+		Scope:  domain.BudgetScopeCategory,
+		Target: pointer.Ref("Video"),
+		Limit:  400,
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoBudgets := mocks.NewMockBudgetRepository(t)
+	ledger := mocks.NewMockChargeLedger(t)
+
+	repoBudgets.EXPECT().ListByUserID(mock.Anything, mock.Anything, userID).
+		Return([]domain.Budget{total, video}, nil).Once()
+	ledger.EXPECT().
+		Charges(mock.Anything, mock.Anything, domain.ChargeQuery{UserID: &userID, Start: march, End: april}).
+		Return([]domain.Charge{
+			{UserID: userID, Name: "Netflix", Category: pointer.Ref("Video"), Month: march, Amount: 500},
+			{UserID: userID, Name: "Spotify", Category: pointer.Ref("Music"), Month: march, Amount: 300},
+			{UserID: userID, Name: "Netflix", Category: pointer.Ref("Video"), Month: april, Amount: 500},
+			{UserID: userID, Name: "Cloud", Month: april, Amount: 100},
+		}, nil).Once()
+
+	statuses, err := domain.NewBudgetService(
+		provider,
+		repoBudgets,
+		mocks.NewMockSubscriptionsRepository(t),
+		ledger,
+		mocks.NewMockBudgetEventPublisher(t),
+	).Status(t.Context(), userID, time.Date(2025, time.March, 17, 12, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	require.Equal(t, []domain.BudgetStatus{
+		{Budget: total, Spent: 800, Forecast: 600, Utilisation: 80, ForecastUtilisation: 60},
+		{Budget: video, Spent: 500, Forecast: 500, Utilisation: 125, ForecastUtilisation: 125},
+	}, statuses)
+}
+
+func TestBudgetService_Breaches(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	total := domain.Budget{
+		ID:         uuid.New(),
+		UserID:     userID,
+		Scope:      domain.BudgetScopeTotal,
+		Limit:      1000,
+		Thresholds: []int{80, 100},
+	}
+	snapshot := domain.BudgetSnapshot{
+		UserID:  userID,
+		Month:   march,
+		Budgets: []domain.Budget{total},
+		Spent:   map[domain.BudgetID]int{total.ID: 600},
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	publisher := mocks.NewMockBudgetEventPublisher(t)
+
+	repoSunbscriptions.EXPECT().
+		ReadActiveInPeriod(mock.Anything, mock.Anything, &userID, (*domain.ServiceName)(nil), march, march).
+		Return(costFixture(userID), nil).Once()
+	repoSunbscriptions.EXPECT().ReadDiscounts(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).Once()
+	repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).Once()
+
+	service := domain.NewBudgetService(provider, mocks.NewMockBudgetRepository(t), repoSunbscriptions, nil, publisher)
+	breaches, err := service.Breaches(t.Context(), mocks.NewMockConnection(t), snapshot)
+
+	require.NoError(t, err)
+	require.Equal(t, []domain.BudgetBreach{
+		{Budget: total, Month: march, Threshold: 80, Spent: 800},
+	}, breaches)
+
+	publisher.EXPECT().PublishBudgetBreach(mock.Anything, breaches[0]).
+		Return(errors.New("some error")).Once()
+	service.Publish(t.Context(), breaches)
+}
+
+func TestServicePVZ_CreateReportsBudgetBreaches(t *testing.T) {
+	t.Parallel()
+
+	member := uuid.New()
+	subscription := domain.Subscription{
+		Name:      "Netflix",
+		Cost:      500,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2999, time.July, 1, 0, 0, 0, 0, time.UTC),
+		SplitRule: pointer.Ref(domain.SplitEqual),
+		Members:   []domain.SubscriptionMember{{UserID: member}},
+	}
+	snapshot := domain.BudgetSnapshot{UserID: subscription.UserID, Month: subscription.StartDate}
+	memberSnapshot := domain.BudgetSnapshot{UserID: member, Month: subscription.StartDate}
+	breaches := []domain.BudgetBreach{{Month: subscription.StartDate, Threshold: 100, Spent: 500}}
+	memberBreaches := []domain.BudgetBreach{{Month: subscription.StartDate, Threshold: 80, Spent: 250}}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoCatalog := mocks.NewMockCatalogRepository(t)
+	budgets := mocks.NewMockBudgetEvaluator(t)
+	ledger := mocks.NewMockChargeLedger(t)
+
+	repoCatalog.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, subscription.Name).
+		Return(nil, nil).Once()
+	repoCatalog.EXPECT().Search(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).Once()
+	repoSunbscriptions.EXPECT().
+		GetLatestSubscriptionDate(mock.Anything, mock.Anything, subscription.UserID, subscription.Name).
+		Return(nil, nil).Once()
+	repoSunbscriptions.EXPECT().ReadMembers(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	budgets.EXPECT().Snapshot(mock.Anything, mock.Anything, subscription.UserID, subscription.StartDate).
+		Return(snapshot, nil).Once()
+	budgets.EXPECT().Snapshot(mock.Anything, mock.Anything, member, subscription.StartDate).
+		Return(memberSnapshot, nil).Once()
+	repoSunbscriptions.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	repoSunbscriptions.EXPECT().ReplaceMembers(mock.Anything, mock.Anything, mock.Anything, subscription.Members).
+		Return(nil).Once()
+	// Breaches read the spend from the ledger, so it is synced first.
+	sync := ledger.EXPECT().Sync(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	budgets.EXPECT().Breaches(mock.Anything, mock.Anything, snapshot).Return(breaches, nil).Once().
+		NotBefore(sync)
+	budgets.EXPECT().Breaches(mock.Anything, mock.Anything, memberSnapshot).Return(memberBreaches, nil).Once().
+		NotBefore(sync)
+	budgets.EXPECT().Publish(mock.Anything, append(breaches, memberBreaches...)).Return().Once()
+
+	_, err := domain.NewSubscriptionService(
+		provider,
+		repoSunbscriptions,
+		repoCatalog,
+		domain.WithBudgetEvaluator(budgets),
+		domain.WithChargeLedger(ledger),
+	).Create(t.Context(), subscription)
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,258 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"ef_project/internal/infra/log"
+	"ef_project/internal/infra/translit"
+
+	"github.com/google/uuid"
+)
+
+var _ CatalogInterface = (*CatalogService)(nil)
+
+var (
+	errServiceCatalog       = errors.New("catalog service error")
+	ErrServiceCreateCatalog = errors.Join(
+		errServiceCatalog,
+		errors.New("create failed"),
+	)
+	ErrServiceGetCatalog = errors.Join(
+		errServiceCatalog,
+		errors.New("get failed"),
+	)
+	ErrServiceListCatalog = errors.Join(
+		errServiceCatalog,
+		errors.New("list failed"),
+	)
+	ErrServiceUpdateCatalog = errors.Join(
+		errServiceCatalog,
+		errors.New("update failed"),
+	)
+	ErrServiceDeleteCatalog = errors.Join(
+		errServiceCatalog,
+		errors.New("delete failed"),
+	)
+	ErrServiceResolveCatalog = errors.Join(
+		errServiceCatalog,
+		errors.New("resolve failed"),
+	)
+	ErrServiceSearchCatalog = errors.Join(
+		errServiceCatalog,
+		errors.New("search failed"),
+	)
+)
+
+const (
+	// SuggestionThreshold is the lowest trigram similarity for a catalog entry
+	// to be offered as a match.
+	SuggestionThreshold = 0.3
+
+	defaultSuggestionsLimit = 5
+	maxSuggestionsLimit     = 50
+)
+
+type CatalogService struct {
+	provider    ConnectionProvider
+	catalogRepo CatalogRepository
+}
+
+func NewCatalogService(
+	provider ConnectionProvider,
+	catalogRepo CatalogRepository,
+) *CatalogService {
+	return &CatalogService{
+		provider:    provider,
+		catalogRepo: catalogRepo,
+	}
+}
+
+func (s *CatalogService) Create(ctx context.Context, entry CatalogEntry) (CatalogEntry, error) {
+	slog.DebugContext(ctx, "Service: creating catalog entry.", log.RequestID(ctx))
+	entry, err := normalizeCatalogEntry(entry)
+	if err != nil {
+		return entry, errors.Join(ErrServiceCreateCatalog, err)
+	}
+	entry.ID = uuid.New()
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		if err := s.checkNamesAreFree(ctx, c, entry); err != nil {
+			return err
+		}
+
+		return s.catalogRepo.Create(ctx, c, entry)
+	})
+	if err != nil {
+		return entry, errors.Join(ErrServiceCreateCatalog, err)
+	}
+	return entry, nil
+}
+
+func (s *CatalogService) Get(ctx context.Context, id CatalogEntryID) (CatalogEntry, error) {
+	slog.DebugContext(ctx, "Service: getting catalog entry.", log.RequestID(ctx))
+	var entry CatalogEntry
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		entry, dbErr = s.catalogRepo.GetByID(ctx, c, id)
+		return dbErr
+	})
+	if err != nil {
+		return entry, errors.Join(ErrServiceGetCatalog, err)
+	}
+	return entry, nil
+}
+
+func (s *CatalogService) List(ctx context.Context) ([]CatalogEntry, error) {
+	slog.DebugContext(ctx, "Service: listing catalog.", log.RequestID(ctx))
+	var entries []CatalogEntry
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		entries, dbErr = s.catalogRepo.List(ctx, c)
+		return dbErr
+	})
+	if err != nil {
+		return entries, errors.Join(ErrServiceListCatalog, err)
+	}
+	return entries, nil
+}
+
+func (s *CatalogService) Update(ctx context.Context, entry CatalogEntry) error {
+	slog.DebugContext(ctx, "Service: updating catalog entry.", log.RequestID(ctx))
+	entry, err := normalizeCatalogEntry(entry)
+	if err != nil {
+		return errors.Join(ErrServiceUpdateCatalog, err)
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		if err := s.checkNamesAreFree(ctx, c, entry); err != nil {
+			return err
+		}
+
+		return s.catalogRepo.Update(ctx, c, entry)
+	})
+	if err != nil {
+		return errors.Join(ErrServiceUpdateCatalog, err)
+	}
+	return nil
+}
+
+func (s *CatalogService) Delete(ctx context.Context, id CatalogEntryID) error {
+	slog.DebugContext(ctx, "Service: deleting catalog entry.", log.RequestID(ctx))
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		return s.catalogRepo.Delete(ctx, c, id)
+	})
+	if err != nil {
+		return errors.Join(ErrServiceDeleteCatalog, err)
+	}
+	return nil
+}
+
+func (s *CatalogService) Search(ctx context.Context, query string, limit int) ([]CatalogMatch, error) {
+	slog.DebugContext(ctx, "Service: searching catalog.", log.RequestID(ctx))
+	var matches []CatalogMatch
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		matches, dbErr = searchCatalog(ctx, c, s.catalogRepo, query, limit)
+		return dbErr
+	})
+	if err != nil {
+		return matches, errors.Join(ErrServiceSearchCatalog, err)
+	}
+	return matches, nil
+}
+
+// checkNamesAreFree makes sure neither the canonical name nor any alias of the
+// entry already resolves to a different catalog entry.
+func (s *CatalogService) checkNamesAreFree(
+	ctx context.Context,
+	c Connection,
+	entry CatalogEntry,
+) error {
+	for _, name := range append([]string{entry.Name}, entry.Aliases...) {
+		matches, err := s.catalogRepo.FindByNameOrAlias(ctx, c, name)
+		if err != nil {
+			return err
+		}
+		for _, match := range matches {
+			if match.ID != entry.ID {
+				return errors.New("name " + name + " is already used by " + match.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveCatalogEntry maps a free-text service name onto the catalog entry
+// whose canonical name or alias matches it, ignoring case.
+func resolveCatalogEntry(
+	ctx context.Context,
+	c Connection,
+	catalogRepo CatalogRepository,
+	name ServiceName,
+) (CatalogEntry, bool, error) {
+	matches, err := catalogRepo.FindByNameOrAlias(ctx, c, name)
+	if err != nil {
+		return CatalogEntry{}, false, errors.Join(ErrServiceResolveCatalog, err)
+	}
+	if len(matches) != 1 {
+		return CatalogEntry{}, false, nil
+	}
+	return matches[0], true, nil
+}
+
+// searchCatalog looks the query up by trigram similarity, also trying its
+// Cyrillic and Latin transliterations so that "yandex" finds "Яндекс".
+func searchCatalog(
+	ctx context.Context,
+	c Connection,
+	catalogRepo CatalogRepository,
+	query string,
+	limit int,
+) ([]CatalogMatch, error) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return []CatalogMatch{}, nil
+	}
+	if limit <= 0 {
+		limit = defaultSuggestionsLimit
+	}
+	limit = min(limit, maxSuggestionsLimit)
+
+	variants := []string{query}
+	for _, variant := range []string{translit.ToLatin(query), translit.ToCyrillic(query)} {
+		if !slices.Contains(variants, variant) {
+			variants = append(variants, variant)
+		}
+	}
+
+	return catalogRepo.Search(ctx, c, variants, SuggestionThreshold, limit)
+}
+
+func normalizeCatalogEntry(entry CatalogEntry) (CatalogEntry, error) {
+	entry.Name = strings.TrimSpace(entry.Name)
+	if entry.Name == "" {
+		return entry, errors.New("name is empty")
+	}
+	if entry.DefaultPrice != nil && *entry.DefaultPrice < 0 {
+		return entry, errors.New("default price is negative")
+	}
+
+	seen := map[string]struct{}{strings.ToLower(entry.Name): {}}
+	aliases := make([]string, 0, len(entry.Aliases))
+	for _, alias := range entry.Aliases {
+		alias = strings.TrimSpace(alias)
+		key := strings.ToLower(alias)
+		if _, ok := seen[key]; ok || alias == "" {
+			continue
+		}
+		seen[key] = struct{}{}
+		aliases = append(aliases, alias)
+	}
+	entry.Aliases = aliases
+
+	return entry, nil
+}
@@ -0,0 +1,99 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogService_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		entry        domain.CatalogEntry
+		prepareMocks func(*mocks.MockCatalogRepository)
+		check        func(*testing.T, domain.CatalogEntry, error)
+	}{
+		{
+			name: "Success",
+			entry: domain.CatalogEntry{
+				Name:    " Yandex Plus ",
+				Aliases: []string{"yandex plus", "Яндекс Плюс", " ", "Яндекс Плюс"},
+			},
+			prepareMocks: func(repo *mocks.MockCatalogRepository) {
+				repo.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, nil).Twice()
+				repo.EXPECT().
+					Create(mock.Anything, mock.Anything, mock.MatchedBy(func(entry domain.CatalogEntry) bool {
+						return entry.ID != uuid.Nil && entry.Name == "Yandex Plus"
+					})).
+					Return(nil).Once()
+			},
+			check: func(t *testing.T, entry domain.CatalogEntry, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "Yandex Plus", entry.Name)
+				require.Equal(t, []string{"Яндекс Плюс"}, entry.Aliases)
+			},
+		},
+		{
+			name:  "Empty name",
+			entry: domain.CatalogEntry{Name: "  "},
+			check: func(t *testing.T, _ domain.CatalogEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceCreateCatalog)
+				require.ErrorContains(t, err, "name is empty")
+			},
+		},
+		{
+			name: "Name already used",
+			entry: domain.CatalogEntry{
+				Name: "Yandex Plus",
+			},
+			prepareMocks: func(repo *mocks.MockCatalogRepository) {
+				repo.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, "Yandex Plus").
+					Return([]domain.CatalogEntry{{ID: uuid.New(), Name: "Яндекс Плюс"}}, nil).Once()
+			},
+			check: func(t *testing.T, _ domain.CatalogEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceCreateCatalog)
+				require.ErrorContains(t, err, "already used")
+			},
+		},
+		{
+			name:  "DB create Error",
+			entry: domain.CatalogEntry{Name: "Yandex Plus"},
+			prepareMocks: func(repo *mocks.MockCatalogRepository) {
+				repo.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, nil).Once()
+				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, _ domain.CatalogEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceCreateCatalog)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+
+			repoCatalog := mocks.NewMockCatalogRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(repoCatalog)
+			}
+			entry, err := domain.NewCatalogService(provider, repoCatalog).Create(t.Context(), test.entry)
+
+			test.check(t, entry, err)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"time"
+
+	"ef_project/internal/infra/log"
+)
+
+var ErrServiceComparePeriods = errors.Join(
+	errServiseSubscription,
+	errors.New("compare periods failed"),
+)
+
+// ComparePeriods compares the spend of two periods and lists the services
+// added, removed or repriced between them. Services are told apart by payer
+// and name, so a subscription replaced by a new one at another price counts
+// as repriced.
+func (s *SubscriptionService) ComparePeriods(ctx context.Context, query ComparisonQuery) (PeriodComparison, error) {
+	slog.DebugContext(ctx, "Service: comparing periods.", log.RequestID(ctx))
+	for _, period := range []Period{query.Base, query.Compare} {
+		if BillingPeriod(period.End).Before(BillingPeriod(period.Start)) {
+			return PeriodComparison{}, errors.Join(
+				ErrServiceComparePeriods,
+				errors.New("period end is before its start"),
+			)
+		}
+	}
+
+	start, end := query.Base.Start, query.Base.End
+	if query.Compare.Start.Before(start) {
+		start = query.Compare.Start
+	}
+	if query.Compare.End.After(end) {
+		end = query.Compare.End
+	}
+
+	var (
+		subscriptions []Subscription
+		charges       []Charge
+	)
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var err error
+		charges, err = readCharges(ctx, c, s.ledger, s.subscriptionRepo, ChargeQuery{
+			UserID: query.UserID,
+			Name:   query.Name,
+			Start:  start,
+			End:    end,
+		})
+		if err != nil {
+			return err
+		}
+		// The list prices come from the subscriptions themselves.
+		subscriptions, err = s.subscriptionRepo.ReadActiveInPeriod(
+			ctx,
+			c,
+			query.UserID,
+			query.Name,
+			BillingPeriod(start),
+			BillingPeriod(end),
+		)
+		return err
+	})
+	if err != nil {
+		return PeriodComparison{}, errors.Join(ErrServiceComparePeriods, err)
+	}
+
+	costs := make(map[SubscriptionID]int, len(subscriptions))
+	for _, subscription := range subscriptions {
+		costs[subscription.ID] = subscription.Cost
+	}
+	base := periodServices(charges, costs, query.Base)
+	compare := periodServices(charges, costs, query.Compare)
+	return comparePeriodServices(base, compare), nil
+}
+
+type (
+	serviceKey struct {
+		payer UserID
+		name  ServiceName
+	}
+
+	periodService struct {
+		total int
+		cost  int
+		month time.Time
+	}
+)
+
+// periodServices sums the period's charges per service and remembers the
+// list price of the latest subscription billed for it.
+func periodServices(charges []Charge, costs map[SubscriptionID]int, period Period) map[serviceKey]periodService {
+	start, end := BillingPeriod(period.Start), BillingPeriod(period.End)
+
+	services := map[serviceKey]periodService{}
+	for _, charge := range charges {
+		if charge.Month.Before(start) || charge.Month.After(end) {
+			continue
+		}
+		key := serviceKey{payer: charge.PayerID, name: charge.Name}
+		service := services[key]
+		service.total += charge.Amount
+		if !charge.Month.Before(service.month) {
+			service.cost, service.month = costs[charge.SubscriptionID], charge.Month
+		}
+		services[key] = service
+	}
+	return services
+}
+
+func comparePeriodServices(base map[serviceKey]periodService, compare map[serviceKey]periodService) PeriodComparison {
+	var comparison PeriodComparison
+	for key, service := range base {
+		comparison.BaseTotal += service.total
+		change := SubscriptionChange{
+			UserID:    key.payer,
+			Name:      key.name,
+			BaseCost:  &service.cost,
+			BaseTotal: service.total,
+		}
+		compared, ok := compare[key]
+		if !ok {
+			comparison.Removed = append(comparison.Removed, change)
+			continue
+		}
+		if compared.cost != service.cost {
+			change.CompareCost, change.CompareTotal = &compared.cost, compared.total
+			comparison.Repriced = append(comparison.Repriced, change)
+		}
+	}
+	for key, service := range compare {
+		comparison.CompareTotal += service.total
+		if _, ok := base[key]; !ok {
+			comparison.Added = append(comparison.Added, SubscriptionChange{
+				UserID:       key.payer,
+				Name:         key.name,
+				CompareCost:  &service.cost,
+				CompareTotal: service.total,
+			})
+		}
+	}
+
+	comparison.Delta = comparison.CompareTotal - comparison.BaseTotal
+	if comparison.BaseTotal != 0 {
+		percent := float64(comparison.Delta) * 100 / float64(comparison.BaseTotal)
+		comparison.PercentChange = &percent
+	}
+	for _, changes := range [][]SubscriptionChange{comparison.Added, comparison.Removed, comparison.Repriced} {
+		sortSubscriptionChanges(changes)
+	}
+	return comparison
+}
+
+func sortSubscriptionChanges(changes []SubscriptionChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Name != changes[j].Name {
+			return changes[i].Name < changes[j].Name
+		}
+		return changes[i].UserID.String() < changes[j].UserID.String()
+	})
+}
@@ -0,0 +1,113 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_ComparePeriods(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	month := func(m time.Month) time.Time {
+		return time.Date(2025, m, 1, 0, 0, 0, 0, time.UTC)
+	}
+	subscriptions := []domain.Subscription{
+		{
+			// Kept at the same price.
+			ID: uuid.New(), Name: "Netflix", Cost: 700, UserID: userID,
+			StartDate: month(time.January),
+		},
+		{
+			// Cancelled after the base period.
+			ID: uuid.New(), Name: "Spotify", Cost: 300, UserID: userID,
+			StartDate: month(time.January), EndDate: pointer.Ref(month(time.February)),
+		},
+		{
+			// Replaced by a dearer plan.
+			ID: uuid.New(), Name: "Cloud", Cost: 100, UserID: userID,
+			StartDate: month(time.January), EndDate: pointer.Ref(month(time.February)),
+		},
+		{
+			ID: uuid.New(), Name: "Cloud", Cost: 200, UserID: userID,
+			StartDate: month(time.March),
+		},
+		{
+			// Started in the compared period.
+			ID: uuid.New(), Name: "Notion", Cost: 400, UserID: userID,
+			StartDate: month(time.March),
+		},
+	}
+
+	var charges []domain.Charge
+	for _, subscription := range subscriptions {
+		for m := subscription.StartDate; !m.After(month(time.April)); m = m.AddDate(0, 1, 0) {
+			if subscription.EndDate != nil && m.After(*subscription.EndDate) {
+				break
+			}
+			charges = append(charges, domain.Charge{
+				SubscriptionID: subscription.ID,
+				UserID:         userID,
+				PayerID:        userID,
+				Kind:           domain.ChargeKindSubscription,
+				Name:           subscription.Name,
+				Month:          m,
+				Amount:         subscription.Cost,
+			})
+		}
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	ledger := mocks.NewMockChargeLedger(t)
+	ledger.EXPECT().
+		Charges(mock.Anything, mock.Anything, domain.ChargeQuery{UserID: &userID, Start: month(time.January), End: month(time.April)}).
+		Return(charges, nil).Once()
+	repoSunbscriptions.EXPECT().
+		ReadActiveInPeriod(mock.Anything, mock.Anything, &userID, (*domain.ServiceName)(nil), month(time.January), month(time.April)).
+		Return(subscriptions, nil).Once()
+
+	comparison, err := domain.NewSubscriptionService(
+		provider,
+		repoSunbscriptions,
+		mocks.NewMockCatalogRepository(t),
+		domain.WithChargeLedger(ledger),
+	).
+		ComparePeriods(t.Context(), domain.ComparisonQuery{
+			UserID:  &userID,
+			Base:    domain.Period{Start: month(time.January), End: month(time.February)},
+			Compare: domain.Period{Start: month(time.March), End: month(time.April)},
+		})
+
+	require.NoError(t, err)
+	require.Equal(t, 2*(700+300+100), comparison.BaseTotal)
+	require.Equal(t, 2*(700+200+400), comparison.CompareTotal)
+	require.Equal(t, 400, comparison.Delta)
+	require.NotNil(t, comparison.PercentChange)
+	require.InDelta(t, 18.18, *comparison.PercentChange, 0.01)
+	require.Equal(t, []domain.SubscriptionChange{
+		{UserID: userID, Name: "Notion", CompareCost: pointer.Ref(400), CompareTotal: 800},
+	}, comparison.Added)
+	require.Equal(t, []domain.SubscriptionChange{
+		{UserID: userID, Name: "Spotify", BaseCost: pointer.Ref(300), BaseTotal: 600},
+	}, comparison.Removed)
+	require.Equal(t, []domain.SubscriptionChange{
+		{
+			UserID:       userID,
+			Name:         "Cloud",
+			BaseCost:     pointer.Ref(100),
+			CompareCost:  pointer.Ref(200),
+			BaseTotal:    200,
+			CompareTotal: 400,
+		},
+	}, comparison.Repriced)
+}
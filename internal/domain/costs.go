@@ -0,0 +1,265 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"time"
+)
+
+const (
+	GroupByNone     GroupBy = ""
+	GroupByCategory GroupBy = "category"
+	GroupByTag      GroupBy = "tag"
+
+	ChargeKindSubscription ChargeKind = "subscription"
+	ChargeKindDiscount     ChargeKind = "discount"
+
+	uncategorized = "uncategorized"
+	untagged      = "untagged"
+)
+
+// monthlyCharges is the cost engine: it bills every subscription once for
+// each month of [start, end] it is active in, both ends inclusive. Shared
+// subscriptions are billed to each member for their share. An actual charge
+// recorded for the month replaces the nominal cost; otherwise a discount
+// covering the month is billed as a separate negative charge. One-time
+// purchases are billed only in their purchase month.
+func monthlyCharges(subscriptions []Subscription, start time.Time, end time.Time) []Charge {
+	start, end = BillingPeriod(start), BillingPeriod(end)
+
+	var charges []Charge
+	for _, subscription := range subscriptions {
+		from := BillingPeriod(subscription.StartDate)
+		if from.Before(start) {
+			from = start
+		}
+		to := end
+		if subscription.EndDate != nil && BillingPeriod(*subscription.EndDate).Before(to) {
+			to = BillingPeriod(*subscription.EndDate)
+		}
+		if subscription.Kind == SubscriptionOneTime && BillingPeriod(subscription.StartDate).Before(to) {
+			to = BillingPeriod(subscription.StartDate)
+		}
+
+		for month := from; !month.After(to); month = month.AddDate(0, 1, 0) {
+			amount, actual := monthAmount(subscription, month)
+			nominal := subscriptionShares(subscription, amount)
+			charge := Charge{
+				SubscriptionID: subscription.ID,
+				PayerID:        subscription.UserID,
+				Kind:           ChargeKindSubscription,
+				Actual:         actual,
+				OneTime:        subscription.Kind == SubscriptionOneTime,
+				Name:           subscription.Name,
+				Category:       subscription.Category,
+				Tags:           subscription.Tags,
+				Month:          month,
+			}
+			for _, share := range nominal {
+				charge.UserID, charge.Amount = share.UserID, share.Amount
+				charges = append(charges, charge)
+			}
+
+			if actual {
+				continue
+			}
+			discount, price, ok := monthDiscount(subscription, month)
+			if !ok {
+				continue
+			}
+			charge.Kind, charge.DiscountID = ChargeKindDiscount, &discount.ID
+			for i, share := range subscriptionShares(subscription, price) {
+				if amount := share.Amount - nominal[i].Amount; amount != 0 {
+					charge.UserID, charge.Amount = share.UserID, amount
+					charges = append(charges, charge)
+				}
+			}
+		}
+	}
+
+	return charges
+}
+
+// readCharges returns the charges of the query. With a ledger they are read
+// from it; without one the cost engine bills the subscriptions active in the
+// period.
+func readCharges(
+	ctx context.Context,
+	c Connection,
+	ledger ChargeLedger,
+	subscriptionRepo SubscriptionsRepository,
+	query ChargeQuery,
+) ([]Charge, error) {
+	if ledger != nil {
+		return ledger.Charges(ctx, c, query)
+	}
+
+	participant := query.UserID
+	if participant == nil {
+		participant = query.PayerID
+	}
+	subscriptions, err := readActiveInPeriod(
+		ctx,
+		c,
+		subscriptionRepo,
+		participant,
+		query.Name,
+		BillingPeriod(query.Start),
+		BillingPeriod(query.End),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	charges := userCharges(monthlyCharges(subscriptions, query.Start, query.End), query.UserID)
+	if query.PayerID == nil {
+		return charges, nil
+	}
+	return slices.DeleteFunc(charges, func(charge Charge) bool {
+		return charge.PayerID != *query.PayerID
+	}), nil
+}
+
+// userCharges keeps the charges owed by userID, or all of them for nil.
+func userCharges(charges []Charge, userID *UserID) []Charge {
+	if userID == nil {
+		return charges
+	}
+	return slices.DeleteFunc(charges, func(charge Charge) bool {
+		return charge.UserID != *userID
+	})
+}
+
+// chargeGroups returns the groups a charge is counted in. A charge with
+// several tags is counted once in each of them.
+func chargeGroups(charge Charge, groupBy GroupBy) []string {
+	switch groupBy {
+	case GroupByCategory:
+		if charge.Category == nil || *charge.Category == "" {
+			return []string{uncategorized}
+		}
+		return []string{*charge.Category}
+	case GroupByTag:
+		if len(charge.Tags) == 0 {
+			return []string{untagged}
+		}
+		return charge.Tags
+	case GroupByNone:
+		return []string{""}
+	}
+
+	return []string{""}
+}
+
+func costReport(charges []Charge, groupBy GroupBy) CostReport {
+	report := CostReport{}
+	totals := map[string]int{}
+	for _, charge := range charges {
+		report.Total += charge.Amount
+		if groupBy == GroupByNone {
+			continue
+		}
+		for _, group := range chargeGroups(charge, groupBy) {
+			totals[group] += charge.Amount
+		}
+	}
+
+	for group, total := range totals {
+		report.Groups = append(report.Groups, GroupTotal{Group: group, Total: total})
+	}
+	sort.Slice(report.Groups, func(i, j int) bool {
+		if report.Groups[i].Total != report.Groups[j].Total {
+			return report.Groups[i].Total > report.Groups[j].Total
+		}
+		return report.Groups[i].Group < report.Groups[j].Group
+	})
+
+	return report
+}
+
+// spendSeries sums charges per month, and per group when grouping is
+// requested. Months without charges are reported with zero spend.
+func spendSeries(charges []Charge, start time.Time, end time.Time, groupBy GroupBy) []SeriesPoint {
+	type key struct {
+		month time.Time
+		group string
+	}
+
+	totals := map[key]int{}
+	groups := []string{}
+	for _, charge := range charges {
+		for _, group := range chargeGroups(charge, groupBy) {
+			totals[key{month: charge.Month, group: group}] += charge.Amount
+			if !slices.Contains(groups, group) {
+				groups = append(groups, group)
+			}
+		}
+	}
+	if len(groups) == 0 {
+		groups = append(groups, "")
+	}
+	slices.Sort(groups)
+
+	var series []SeriesPoint
+	for month := BillingPeriod(start); !month.After(BillingPeriod(end)); month = month.AddDate(0, 1, 0) {
+		for _, group := range groups {
+			series = append(series, SeriesPoint{
+				Month: month,
+				Group: group,
+				Total: totals[key{month: month, group: group}],
+			})
+		}
+	}
+
+	return series
+}
+
+// readActiveInPeriod reads the subscriptions billed in [start, end] together
+// with their members, discounts and actual charges.
+func readActiveInPeriod(
+	ctx context.Context,
+	c Connection,
+	subscriptionRepo SubscriptionsRepository,
+	userID *UserID,
+	name *ServiceName,
+	start time.Time,
+	end time.Time,
+) ([]Subscription, error) {
+	subscriptions, err := subscriptionRepo.ReadActiveInPeriod(ctx, c, userID, name, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return attachBilling(ctx, c, subscriptionRepo, subscriptions)
+}
+
+// attachBilling loads everything the cost engine needs besides the
+// subscriptions themselves: members, discounts and actual charges.
+func attachBilling(
+	ctx context.Context,
+	c Connection,
+	subscriptionRepo SubscriptionsRepository,
+	subscriptions []Subscription,
+) ([]Subscription, error) {
+	subscriptions, err := attachMembers(ctx, c, subscriptionRepo, subscriptions)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err = attachDiscounts(ctx, c, subscriptionRepo, subscriptions)
+	if err != nil {
+		return nil, err
+	}
+	return attachActuals(ctx, c, subscriptionRepo, subscriptions)
+}
+
+func validateCostQuery(query CostQuery) error {
+	if BillingPeriod(query.End).Before(BillingPeriod(query.Start)) {
+		return errors.New("period end is before its start")
+	}
+	switch query.GroupBy {
+	case GroupByNone, GroupByCategory, GroupByTag:
+		return nil
+	}
+	return errors.New("unknown grouping " + string(query.GroupBy))
+}
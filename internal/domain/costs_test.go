@@ -0,0 +1,184 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func costFixture(userID domain.UserID) []domain.Subscription {
+	return []domain.Subscription{
+		{
+			ID:        uuid.New(),
+			Name:      "Netflix",
+			Cost:      500,
+			UserID:    userID,
+			StartDate: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Category:  pointer.Ref("video"),
+			Tags:      []string{"family", "streaming"},
+		},
+		{
+			ID:        uuid.New(),
+			Name:      "Spotify",
+			Cost:      200,
+			UserID:    userID,
+			StartDate: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:   pointer.Ref(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)),
+			Category:  pointer.Ref("music"),
+			Tags:      []string{"streaming"},
+		},
+		{
+			ID:        uuid.New(),
+			Name:      "Notion",
+			Cost:      100,
+			UserID:    userID,
+			StartDate: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestServicePVZ_TotalSubscriptionsCost(t *testing.T) {
+	t.Parallel()
+
+	query := domain.CostQuery{
+		Start: time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name         string
+		groupBy      domain.GroupBy
+		prepareMocks func(*mocks.MockSubscriptionsRepository)
+		check        func(*testing.T, domain.CostReport, error)
+	}{
+		{
+			name: "Total",
+			check: func(t *testing.T, report domain.CostReport, err error) {
+				require.NoError(t, err)
+				require.Equal(t, domain.CostReport{Total: 3*500 + 200 + 3*100}, report)
+			},
+		},
+		{
+			name:    "By category",
+			groupBy: domain.GroupByCategory,
+			check: func(t *testing.T, report domain.CostReport, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2000, report.Total)
+				require.Equal(t, []domain.GroupTotal{
+					{Group: "video", Total: 1500},
+					{Group: "uncategorized", Total: 300},
+					{Group: "music", Total: 200},
+				}, report.Groups)
+			},
+		},
+		{
+			name:    "By tag",
+			groupBy: domain.GroupByTag,
+			check: func(t *testing.T, report domain.CostReport, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2000, report.Total)
+				require.Equal(t, []domain.GroupTotal{
+					{Group: "streaming", Total: 1700},
+					{Group: "family", Total: 1500},
+					{Group: "untagged", Total: 300},
+				}, report.Groups)
+			},
+		},
+		{
+			name:    "Unknown grouping",
+			groupBy: "color",
+			prepareMocks: func(*mocks.MockSubscriptionsRepository) {
+			},
+			check: func(t *testing.T, _ domain.CostReport, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceTotalSubscriptionsCostList)
+			},
+		},
+		{
+			name: "DB Error",
+			prepareMocks: func(repo *mocks.MockSubscriptionsRepository) {
+				repo.EXPECT().
+					ReadActiveInPeriod(mock.Anything, mock.Anything, (*domain.UserID)(nil), (*domain.ServiceName)(nil), query.Start, query.End).
+					Return(nil, errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, _ domain.CostReport, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceTotalSubscriptionsCostList)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+			repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(repoSunbscriptions)
+			} else {
+				repoSunbscriptions.EXPECT().
+					ReadActiveInPeriod(mock.Anything, mock.Anything, (*domain.UserID)(nil), (*domain.ServiceName)(nil), query.Start, query.End).
+					Return(costFixture(uuid.New()), nil).Once()
+				repoSunbscriptions.EXPECT().ReadDiscounts(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, nil).Once()
+				repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, nil).Once()
+			}
+
+			groupedQuery := query
+			groupedQuery.GroupBy = test.groupBy
+			report, err := domain.NewSubscriptionService(provider, repoSunbscriptions, mocks.NewMockCatalogRepository(t)).
+				TotalSubscriptionsCost(t.Context(), groupedQuery)
+
+			test.check(t, report, err)
+		})
+	}
+}
+
+func TestServicePVZ_SpendSeries(t *testing.T) {
+	t.Parallel()
+
+	query := domain.CostQuery{
+		Start:   time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+		End:     time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC),
+		GroupBy: domain.GroupByCategory,
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoSunbscriptions.EXPECT().
+		ReadActiveInPeriod(mock.Anything, mock.Anything, (*domain.UserID)(nil), (*domain.ServiceName)(nil), query.Start, query.End).
+		Return(costFixture(uuid.New()), nil).Once()
+	repoSunbscriptions.EXPECT().ReadDiscounts(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).Once()
+	repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).Once()
+
+	series, err := domain.NewSubscriptionService(provider, repoSunbscriptions, mocks.NewMockCatalogRepository(t)).
+		SpendSeries(t.Context(), query)
+	require.NoError(t, err)
+
+	february := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, []domain.SeriesPoint{
+		{Month: february, Group: "music", Total: 0},
+		{Month: february, Group: "uncategorized", Total: 100},
+		{Month: february, Group: "video", Total: 500},
+		{Month: march, Group: "music", Total: 200},
+		{Month: march, Group: "uncategorized", Total: 100},
+		{Month: march, Group: "video", Total: 500},
+		{Month: april, Group: "music", Total: 0},
+		{Month: april, Group: "uncategorized", Total: 100},
+		{Month: april, Group: "video", Total: 500},
+	}, series)
+}
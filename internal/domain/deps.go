@@ -2,22 +2,106 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+// ErrNotFound is joined by repositories into the error of a read that matched
+// no row.
+var ErrNotFound = errors.New("not found")
+
 type SubscriptionsRepository interface {
 	Create(context.Context, Connection, Subscription) error
 	Update(context.Context, Connection, Subscription) error
 	Delete(context.Context, Connection, UserID, ServiceName) error
+	Cancel(context.Context, Connection, UserID, ServiceName, time.Time, CancellationCode, string) error
 	ReadAllByUserID(context.Context, Connection, UserID) ([]Subscription, error)
 	GetLatest(context.Context, Connection, UserID) (Subscription, error)
-	AllMatchingSubscriptionsForPeriod(
+	GetLatestByName(context.Context, Connection, UserID, ServiceName) (Subscription, error)
+	ReadActiveInPeriod(
 		context.Context,
 		Connection,
-		UserID,
-		ServiceName,
+		*UserID,
+		*ServiceName,
+		time.Time,
 		time.Time,
-		*time.Time,
-	) ([]int, error)
+	) ([]Subscription, error)
 	GetLatestSubscriptionDate(context.Context, Connection, UserID, ServiceName) (*time.Time, error)
+	GetByID(context.Context, Connection, SubscriptionID) (Subscription, error)
+	Revert(context.Context, Connection, Subscription) error
+	ReadVersions(context.Context, Connection, SubscriptionID) ([]SubscriptionVersion, error)
+	GetVersionAt(context.Context, Connection, SubscriptionID, time.Time) (SubscriptionVersion, error)
+	ReadDeletedByUserID(context.Context, Connection, UserID) ([]Subscription, error)
+	Restore(context.Context, Connection, SubscriptionID) error
+	PurgeDeleted(context.Context, Connection, time.Time) (int64, error)
+	CountOverlapping(context.Context, Connection, Subscription) (int, error)
+	RenewDue(context.Context, Connection, time.Time) ([]SubscriptionID, error)
+	ExpireDue(context.Context, Connection, time.Time, time.Time) (int64, error)
+	FindServiceConflicts(context.Context, Connection, ServiceName, ServiceName) ([]ServiceConflict, error)
+	RenameService(context.Context, Connection, ServiceName, ServiceName, *CatalogEntryID) (int64, error)
+	FindUserConflicts(context.Context, Connection, UserID, UserID) ([]ServiceConflict, error)
+	ReadByUsers(context.Context, Connection, []UserID) ([]Subscription, error)
+	Trash(context.Context, Connection, []SubscriptionID) error
+	TransferOwner(context.Context, Connection, UserID, UserID) ([]SubscriptionID, error)
+	TransferMembers(context.Context, Connection, UserID, UserID) ([]SubscriptionID, error)
+	ReadMembers(context.Context, Connection, []SubscriptionID) ([]SubscriptionMember, error)
+	ReplaceMembers(context.Context, Connection, SubscriptionID, []SubscriptionMember) error
+	CreateDiscount(context.Context, Connection, Discount) error
+	ReadDiscounts(context.Context, Connection, []SubscriptionID) ([]Discount, error)
+	DeleteDiscount(context.Context, Connection, SubscriptionID, DiscountID) error
+	UpsertActual(context.Context, Connection, ActualCharge) error
+	ReadActuals(context.Context, Connection, []SubscriptionID) ([]ActualCharge, error)
+	DeleteActual(context.Context, Connection, SubscriptionID, time.Time) error
+}
+
+type CatalogRepository interface {
+	Create(context.Context, Connection, CatalogEntry) error
+	GetByID(context.Context, Connection, CatalogEntryID) (CatalogEntry, error)
+	List(context.Context, Connection) ([]CatalogEntry, error)
+	Update(context.Context, Connection, CatalogEntry) error
+	Delete(context.Context, Connection, CatalogEntryID) error
+	FindByNameOrAlias(context.Context, Connection, string) ([]CatalogEntry, error)
+	Search(context.Context, Connection, []string, float64, int) ([]CatalogMatch, error)
+}
+
+type AuditRepository interface {
+	Record(context.Context, Connection, AuditEntry) error
+	ListBySubscriptionID(context.Context, Connection, SubscriptionID) ([]AuditEntry, error)
+	Search(context.Context, Connection, AuditFilter) ([]AuditEntry, error)
+	ReadUndoable(context.Context, Connection, UserID, time.Time) ([]AuditEntry, error)
+}
+
+type BudgetRepository interface {
+	Create(context.Context, Connection, Budget) error
+	ListByUserID(context.Context, Connection, UserID) ([]Budget, error)
+	Delete(context.Context, Connection, UserID, BudgetID) error
+}
+
+type BudgetEventPublisher interface {
+	PublishBudgetBreach(context.Context, BudgetBreach) error
+}
+
+type AnalyticsRepository interface {
+	TopServices(context.Context, Connection, time.Time, int) ([]ServiceSubscribers, error)
+	Revenue(context.Context, Connection, time.Time, time.Time) ([]ServiceRevenue, error)
+	Prices(context.Context, Connection) ([]ServicePrice, error)
+	Flow(context.Context, Connection, time.Time, time.Time) ([]SubscriptionFlow, error)
+	Churn(context.Context, Connection, time.Time, time.Time, *ServiceName) ([]ServiceChurn, error)
+	ChurnReasons(context.Context, Connection, time.Time, time.Time, *ServiceName) ([]ChurnReason, error)
+	Retention(context.Context, Connection, time.Time, time.Time, *ServiceName) ([]CohortMonth, error)
+	PriceOutliers(context.Context, Connection, *UserID, time.Time, float64, int) ([]PriceAnomaly, error)
+	MedianJumps(context.Context, Connection, time.Time, time.Time, float64, int) ([]PriceAnomaly, error)
+}
+
+type QualityRepository interface {
+	FindAnomalies(context.Context, Connection) ([]Anomaly, error)
+	Fix(context.Context, Connection, AnomalyType) ([]SubscriptionID, error)
+}
+
+type LedgerRepository interface {
+	DeleteBySubscriptionID(context.Context, Connection, SubscriptionID) error
+	DeleteInPeriod(context.Context, Connection, *UserID, time.Time, time.Time) error
+	Insert(context.Context, Connection, []Charge) error
+	Read(context.Context, Connection, ChargeQuery) ([]Charge, error)
+	Payers(context.Context, Connection, time.Time, time.Time) ([]UserID, error)
 }
@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"ef_project/internal/infra/log"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrServiceAddDiscount = errors.Join(
+		errServiseSubscription,
+		errors.New("add discount failed"),
+	)
+	ErrServiceListDiscounts = errors.Join(
+		errServiseSubscription,
+		errors.New("list discounts failed"),
+	)
+	ErrServiceDeleteDiscount = errors.Join(
+		errServiseSubscription,
+		errors.New("delete discount failed"),
+	)
+	ErrServiceBreakdown = errors.Join(
+		errServiseSubscription,
+		errors.New("breakdown failed"),
+	)
+)
+
+const (
+	// DiscountPercent takes Value percent off the monthly cost.
+	DiscountPercent DiscountKind = "percent"
+	// DiscountFixedPrice makes the subscription cost Value a month.
+	DiscountFixedPrice DiscountKind = "fixed_price"
+)
+
+// monthDiscount returns the discount applied to a subscription in a month and
+// the discounted price. When several discounts cover the month the cheapest
+// price wins.
+func monthDiscount(subscription Subscription, month time.Time) (Discount, int, bool) {
+	var (
+		best  Discount
+		price = subscription.Cost
+		found bool
+	)
+	for _, discount := range subscription.Discounts {
+		if BillingPeriod(discount.StartDate).After(month) ||
+			(discount.EndDate != nil && BillingPeriod(*discount.EndDate).Before(month)) {
+			continue
+		}
+
+		discounted := subscription.Cost
+		switch discount.Kind {
+		case DiscountPercent:
+			discounted -= subscription.Cost * discount.Value / 100
+		case DiscountFixedPrice:
+			discounted = min(discount.Value, subscription.Cost)
+		}
+		if discounted < price {
+			best, price, found = discount, discounted, true
+		}
+	}
+	return best, price, found
+}
+
+func validateDiscount(discount Discount) error {
+	switch discount.Kind {
+	case DiscountPercent:
+		if discount.Value <= 0 || discount.Value > 100 {
+			return errors.New("percent discount must be between 1 and 100")
+		}
+	case DiscountFixedPrice:
+		if discount.Value < 0 {
+			return errors.New("fixed price must not be negative")
+		}
+	default:
+		return errors.New("unknown discount kind " + string(discount.Kind))
+	}
+
+	if discount.Months != nil && *discount.Months <= 0 {
+		return errors.New("discount months must be positive")
+	}
+	if discount.EndDate != nil && BillingPeriod(*discount.EndDate).Before(BillingPeriod(discount.StartDate)) {
+		return errors.New("discount ends before it starts")
+	}
+	return nil
+}
+
+// attachDiscounts loads the discounts of the given subscriptions.
+func attachDiscounts(
+	ctx context.Context,
+	c Connection,
+	subscriptionRepo SubscriptionsRepository,
+	subscriptions []Subscription,
+) ([]Subscription, error) {
+	if len(subscriptions) == 0 {
+		return subscriptions, nil
+	}
+	ids := make([]SubscriptionID, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		ids = append(ids, subscription.ID)
+	}
+
+	discounts, err := subscriptionRepo.ReadDiscounts(ctx, c, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range subscriptions {
+		for _, discount := range discounts {
+			if discount.SubscriptionID == subscriptions[i].ID {
+				subscriptions[i].Discounts = append(subscriptions[i].Discounts, discount)
+			}
+		}
+	}
+	return subscriptions, nil
+}
+
+// AddDiscount adds a discount to a subscription. Without a start date the
+// discount starts with the subscription; Months sets the end date when none
+// is given.
+func (s *SubscriptionService) AddDiscount(ctx context.Context, discount Discount) (Discount, error) {
+	slog.DebugContext(ctx, "Service: adding discount.", log.RequestID(ctx))
+	discount.ID = uuid.New()
+
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		subscription, err := s.subscriptionRepo.GetByID(ctx, c, discount.SubscriptionID)
+		if err != nil {
+			return err
+		}
+		if subscription.DeletedAt != nil {
+			return errors.New("subscription is deleted")
+		}
+
+		if discount.StartDate.IsZero() {
+			discount.StartDate = subscription.StartDate
+		}
+		discount.StartDate = BillingPeriod(discount.StartDate)
+		if discount.EndDate == nil && discount.Months != nil && *discount.Months > 0 {
+			discount.EndDate = pointer.Ref(TermEnd(discount.StartDate, *discount.Months))
+		}
+		if err := validateDiscount(discount); err != nil {
+			return err
+		}
+
+		if err := s.subscriptionRepo.CreateDiscount(ctx, c, discount); err != nil {
+			return err
+		}
+		return s.syncLedger(ctx, c, discount.SubscriptionID)
+	})
+	if err != nil {
+		return discount, errors.Join(ErrServiceAddDiscount, err)
+	}
+	return discount, nil
+}
+
+func (s *SubscriptionService) ListDiscounts(
+	ctx context.Context,
+	subscriptionID SubscriptionID,
+) ([]Discount, error) {
+	slog.DebugContext(ctx, "Service: listing discounts.", log.RequestID(ctx))
+	var discounts []Discount
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		discounts, dbErr = s.subscriptionRepo.ReadDiscounts(ctx, c, []SubscriptionID{subscriptionID})
+		return dbErr
+	})
+	if err != nil {
+		return discounts, errors.Join(ErrServiceListDiscounts, err)
+	}
+	return discounts, nil
+}
+
+func (s *SubscriptionService) DeleteDiscount(
+	ctx context.Context,
+	subscriptionID SubscriptionID,
+	discountID DiscountID,
+) error {
+	slog.DebugContext(ctx, "Service: deleting discount.", log.RequestID(ctx))
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		if err := s.subscriptionRepo.DeleteDiscount(ctx, c, subscriptionID, discountID); err != nil {
+			return err
+		}
+		return s.syncLedger(ctx, c, subscriptionID)
+	})
+	if err != nil {
+		return errors.Join(ErrServiceDeleteDiscount, err)
+	}
+	return nil
+}
+
+// Breakdown returns the line items behind a total: the monthly charge of every
+// subscription and, separately, the discounts applied to it.
+func (s *SubscriptionService) Breakdown(ctx context.Context, query CostQuery) ([]Charge, error) {
+	slog.DebugContext(ctx, "Service: calculating cost breakdown.", log.RequestID(ctx))
+	charges, err := s.charges(ctx, query)
+	if err != nil {
+		return nil, errors.Join(ErrServiceBreakdown, err)
+	}
+	return charges, nil
+}
@@ -0,0 +1,159 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_Breakdown(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	january := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	subscription := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "Netflix",
+		Cost:      500,
+		UserID:    userID,
+		StartDate: january,
+	}
+	halfOff := domain.Discount{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID,
+		Kind:           domain.DiscountPercent,
+		Value:          50,
+		StartDate:      january,
+		EndDate:        pointer.Ref(time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	fixedPrice := domain.Discount{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID,
+		Kind:           domain.DiscountFixedPrice,
+		Value:          99,
+		StartDate:      time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:        pointer.Ref(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoSunbscriptions.EXPECT().
+		ReadActiveInPeriod(mock.Anything, mock.Anything, &userID, (*domain.ServiceName)(nil), january, april).
+		Return([]domain.Subscription{subscription}, nil).Once()
+	repoSunbscriptions.EXPECT().
+		ReadDiscounts(mock.Anything, mock.Anything, []domain.SubscriptionID{subscription.ID}).
+		Return([]domain.Discount{halfOff, fixedPrice}, nil).Once()
+	repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).Once()
+
+	service := domain.NewSubscriptionService(provider, repoSunbscriptions, mocks.NewMockCatalogRepository(t))
+	charges, err := service.Breakdown(t.Context(), domain.CostQuery{UserID: &userID, Start: january, End: april})
+	require.NoError(t, err)
+
+	type line struct {
+		month  time.Month
+		kind   domain.ChargeKind
+		amount int
+	}
+	lines := make([]line, 0, len(charges))
+	total := 0
+	for _, charge := range charges {
+		lines = append(lines, line{month: charge.Month.Month(), kind: charge.Kind, amount: charge.Amount})
+		total += charge.Amount
+	}
+	require.Equal(t, []line{
+		{month: time.January, kind: domain.ChargeKindSubscription, amount: 500},
+		{month: time.January, kind: domain.ChargeKindDiscount, amount: -250},
+		{month: time.February, kind: domain.ChargeKindSubscription, amount: 500},
+		{month: time.February, kind: domain.ChargeKindDiscount, amount: -401},
+		{month: time.March, kind: domain.ChargeKindSubscription, amount: 500},
+		{month: time.March, kind: domain.ChargeKindDiscount, amount: -401},
+		{month: time.April, kind: domain.ChargeKindSubscription, amount: 500},
+	}, lines)
+	require.Equal(t, 250+99+99+500, total)
+}
+
+func TestServicePVZ_AddDiscount(t *testing.T) {
+	t.Parallel()
+
+	subscription := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "Netflix",
+		Cost:      500,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name     string
+		discount domain.Discount
+		created  bool
+		check    func(*testing.T, domain.Discount, error)
+	}{
+		{
+			name: "First months of subscription",
+			discount: domain.Discount{
+				SubscriptionID: subscription.ID,
+				Kind:           domain.DiscountPercent,
+				Value:          50,
+				Months:         pointer.Ref(3),
+			},
+			created: true,
+			check: func(t *testing.T, discount domain.Discount, err error) {
+				require.NoError(t, err)
+				require.Equal(t, subscription.StartDate, discount.StartDate)
+				require.Equal(t, time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC), *discount.EndDate)
+			},
+		},
+		{
+			name: "Percent over 100",
+			discount: domain.Discount{
+				SubscriptionID: subscription.ID,
+				Kind:           domain.DiscountPercent,
+				Value:          150,
+			},
+			check: func(t *testing.T, _ domain.Discount, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceAddDiscount)
+			},
+		},
+		{
+			name: "Unknown kind",
+			discount: domain.Discount{
+				SubscriptionID: subscription.ID,
+				Kind:           "cashback",
+				Value:          10,
+			},
+			check: func(t *testing.T, _ domain.Discount, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceAddDiscount)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+			repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+			repoSunbscriptions.EXPECT().GetByID(mock.Anything, mock.Anything, subscription.ID).
+				Return(subscription, nil).Once()
+			if test.created {
+				repoSunbscriptions.EXPECT().CreateDiscount(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).Once()
+			}
+
+			discount, err := domain.NewSubscriptionService(provider, repoSunbscriptions, mocks.NewMockCatalogRepository(t)).
+				AddDiscount(t.Context(), test.discount)
+
+			test.check(t, discount, err)
+		})
+	}
+}
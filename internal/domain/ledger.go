@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"ef_project/internal/infra/log"
+)
+
+var (
+	_ LedgerInterface = (*LedgerService)(nil)
+	_ ChargeLedger    = (*LedgerService)(nil)
+)
+
+var (
+	errServiceLedger        = errors.New("ledger service error")
+	ErrServiceRebuildLedger = errors.Join(
+		errServiceLedger,
+		errors.New("rebuild failed"),
+	)
+	ErrServiceSyncLedger = errors.Join(
+		errServiceLedger,
+		errors.New("sync failed"),
+	)
+	ErrServiceReadLedger = errors.Join(
+		errServiceLedger,
+		errors.New("read failed"),
+	)
+)
+
+const (
+	// BillingCurrency is the currency of every cost: whole rubles.
+	BillingCurrency = "RUB"
+
+	// ledgerHorizonMonths is how far past the current month the ledger is
+	// materialised for subscriptions without an end date.
+	ledgerHorizonMonths = 12
+)
+
+// LedgerHorizon returns the last month the ledger holds charges for.
+func LedgerHorizon(now time.Time) time.Time {
+	return BillingPeriod(now).AddDate(0, ledgerHorizonMonths, 0)
+}
+
+// LedgerService materialises the output of the cost engine into the charges
+// ledger, one row per subscription, participant and billed month.
+type LedgerService struct {
+	provider         ConnectionProvider
+	ledgerRepo       LedgerRepository
+	subscriptionRepo SubscriptionsRepository
+}
+
+func NewLedgerService(
+	provider ConnectionProvider,
+	ledgerRepo LedgerRepository,
+	subscriptionRepo SubscriptionsRepository,
+) *LedgerService {
+	return &LedgerService{
+		provider:         provider,
+		ledgerRepo:       ledgerRepo,
+		subscriptionRepo: subscriptionRepo,
+	}
+}
+
+// Rebuild replaces the ledger rows of the requested months and returns how
+// many rows were written. Without a user every payer is rebuilt in a
+// transaction of its own, so that the ledger is not locked as a whole.
+func (s *LedgerService) Rebuild(ctx context.Context, rebuild LedgerRebuild) (int, error) {
+	slog.DebugContext(ctx, "Service: rebuilding ledger.", log.RequestID(ctx))
+	start, end := BillingPeriod(rebuild.Start), BillingPeriod(rebuild.End)
+	if end.Before(start) {
+		return 0, errors.Join(ErrServiceRebuildLedger, errors.New("period end is before its start"))
+	}
+	if end.After(LedgerHorizon(time.Now())) {
+		return 0, errors.Join(ErrServiceRebuildLedger, errors.New("period ends after the ledger horizon"))
+	}
+
+	if rebuild.UserID != nil {
+		written, err := s.rebuildPayer(ctx, *rebuild.UserID, start, end)
+		if err != nil {
+			return 0, errors.Join(ErrServiceRebuildLedger, err)
+		}
+		return written, nil
+	}
+
+	var payers []UserID
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		payers, dbErr = s.ledgerRepo.Payers(ctx, c, start, end)
+		return dbErr
+	})
+	if err != nil {
+		return 0, errors.Join(ErrServiceRebuildLedger, err)
+	}
+	var written int
+	for _, payer := range payers {
+		payerWritten, err := s.rebuildPayer(ctx, payer, start, end)
+		if err != nil {
+			return written, errors.Join(ErrServiceRebuildLedger, err)
+		}
+		written += payerWritten
+	}
+	return written, nil
+}
+
+// rebuildPayer replaces the ledger rows of [start, end] of the subscriptions
+// the user pays, shares of their members included.
+func (s *LedgerService) rebuildPayer(ctx context.Context, payer UserID, start, end time.Time) (int, error) {
+	var written int
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		if err := s.ledgerRepo.DeleteInPeriod(ctx, c, &payer, start, end); err != nil {
+			return err
+		}
+		subscriptions, err := readActiveInPeriod(ctx, c, s.subscriptionRepo, &payer, nil, start, end)
+		if err != nil {
+			return err
+		}
+		subscriptions = slices.DeleteFunc(subscriptions, func(subscription Subscription) bool {
+			return subscription.UserID != payer
+		})
+
+		charges := monthlyCharges(subscriptions, start, end)
+		written = len(charges)
+		return s.ledgerRepo.Insert(ctx, c, charges)
+	})
+	return written, err
+}
+
+// Sync replaces the ledger rows of a subscription after a write to it or to
+// its members, discounts or actual charges. Deleted subscriptions have no rows.
+func (s *LedgerService) Sync(ctx context.Context, c Connection, subscriptionID SubscriptionID) error {
+	if err := s.ledgerRepo.DeleteBySubscriptionID(ctx, c, subscriptionID); err != nil {
+		return errors.Join(ErrServiceSyncLedger, err)
+	}
+	subscription, err := s.subscriptionRepo.GetByID(ctx, c, subscriptionID)
+	if err != nil {
+		return errors.Join(ErrServiceSyncLedger, err)
+	}
+	if subscription.DeletedAt != nil {
+		return nil
+	}
+
+	subscriptions, err := attachBilling(ctx, c, s.subscriptionRepo, []Subscription{subscription})
+	if err != nil {
+		return errors.Join(ErrServiceSyncLedger, err)
+	}
+
+	charges := monthlyCharges(subscriptions, subscription.StartDate, LedgerHorizon(time.Now()))
+	if err := s.ledgerRepo.Insert(ctx, c, charges); err != nil {
+		return errors.Join(ErrServiceSyncLedger, err)
+	}
+	return nil
+}
+
+// Charges reads the charges of the query from the ledger. Months past the
+// ledger horizon have no rows.
+func (s *LedgerService) Charges(ctx context.Context, c Connection, query ChargeQuery) ([]Charge, error) {
+	query.Start, query.End = BillingPeriod(query.Start), BillingPeriod(query.End)
+	charges, err := s.ledgerRepo.Read(ctx, c, query)
+	if err != nil {
+		return nil, errors.Join(ErrServiceReadLedger, err)
+	}
+	return charges, nil
+}
@@ -0,0 +1,179 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_RebuildLedger(t *testing.T) {
+	t.Parallel()
+
+	payer := uuid.New()
+	member := uuid.New()
+	january := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	february := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	family := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "Family plan",
+		Cost:      1000,
+		UserID:    payer,
+		StartDate: january,
+		SplitRule: pointer.Ref(domain.SplitEqual),
+	}
+	// The payer only shares this one, its rows belong to the other payer.
+	shared := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "Cloud",
+		Cost:      300,
+		UserID:    uuid.New(),
+		StartDate: january,
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoLedger := mocks.NewMockLedgerRepository(t)
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoLedger.EXPECT().DeleteInPeriod(mock.Anything, mock.Anything, &payer, january, february).
+		Return(nil).Once()
+	repoSunbscriptions.EXPECT().
+		ReadActiveInPeriod(mock.Anything, mock.Anything, &payer, (*domain.ServiceName)(nil), january, february).
+		Return([]domain.Subscription{family, shared}, nil).Once()
+	repoSunbscriptions.EXPECT().ReadMembers(mock.Anything, mock.Anything, []domain.SubscriptionID{family.ID}).
+		Return([]domain.SubscriptionMember{{SubscriptionID: family.ID, UserID: member}}, nil).Once()
+	repoSunbscriptions.EXPECT().ReadDiscounts(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+	var inserted []domain.Charge
+	repoLedger.EXPECT().Insert(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ domain.Connection, charges []domain.Charge) {
+			inserted = charges
+		}).
+		Return(nil).Once()
+
+	written, err := domain.NewLedgerService(provider, repoLedger, repoSunbscriptions).
+		Rebuild(t.Context(), domain.LedgerRebuild{UserID: &payer, Start: january, End: february})
+
+	require.NoError(t, err)
+	require.Equal(t, 4, written)
+	require.Len(t, inserted, 4)
+	for _, charge := range inserted {
+		require.Equal(t, family.ID, charge.SubscriptionID)
+		require.Equal(t, payer, charge.PayerID)
+		require.Equal(t, 500, charge.Amount)
+	}
+}
+
+func TestServicePVZ_RebuildLedgerPerPayer(t *testing.T) {
+	t.Parallel()
+
+	january := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	subscriptions := []domain.Subscription{
+		{ID: uuid.New(), Name: "Netflix", Cost: 700, UserID: uuid.New(), StartDate: january},
+		{ID: uuid.New(), Name: "Spotify", Cost: 300, UserID: uuid.New(), StartDate: january},
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoLedger := mocks.NewMockLedgerRepository(t)
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoLedger.EXPECT().Payers(mock.Anything, mock.Anything, january, january).
+		Return([]domain.UserID{subscriptions[0].UserID, subscriptions[1].UserID}, nil).Once()
+	for _, subscription := range subscriptions {
+		repoLedger.EXPECT().DeleteInPeriod(mock.Anything, mock.Anything, &subscription.UserID, january, january).
+			Return(nil).Once()
+		repoSunbscriptions.EXPECT().
+			ReadActiveInPeriod(mock.Anything, mock.Anything, &subscription.UserID, (*domain.ServiceName)(nil), january, january).
+			Return([]domain.Subscription{subscription}, nil).Once()
+		repoLedger.EXPECT().Insert(mock.Anything, mock.Anything, mock.MatchedBy(func(charges []domain.Charge) bool {
+			return len(charges) == 1 && charges[0].PayerID == subscription.UserID
+		})).Return(nil).Once()
+	}
+	repoSunbscriptions.EXPECT().ReadDiscounts(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Twice()
+	repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Twice()
+
+	written, err := domain.NewLedgerService(provider, repoLedger, repoSunbscriptions).
+		Rebuild(t.Context(), domain.LedgerRebuild{Start: january, End: january})
+
+	require.NoError(t, err)
+	require.Equal(t, 2, written)
+}
+
+func TestServicePVZ_RebuildLedgerPastHorizon(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	_, err := domain.NewLedgerService(
+		database.NewDummyProvider(mocks.NewMockConnection(t)),
+		mocks.NewMockLedgerRepository(t),
+		mocks.NewMockSubscriptionsRepository(t),
+	).Rebuild(t.Context(), domain.LedgerRebuild{Start: now, End: domain.LedgerHorizon(now).AddDate(0, 1, 0)})
+
+	require.ErrorIs(t, err, domain.ErrServiceRebuildLedger)
+}
+
+func TestServicePVZ_SyncLedger(t *testing.T) {
+	t.Parallel()
+
+	subscription := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "Cloud",
+		Cost:      300,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   pointer.Ref(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		name     string
+		deleted  bool
+		inserted int
+	}{
+		{name: "Active subscription rebuilt", inserted: 3},
+		{name: "Deleted subscription removed", deleted: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			stored := subscription
+			if test.deleted {
+				stored.DeletedAt = pointer.Ref(time.Now())
+			}
+
+			connection := mocks.NewMockConnection(t)
+			repoLedger := mocks.NewMockLedgerRepository(t)
+			repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+			repoLedger.EXPECT().DeleteBySubscriptionID(mock.Anything, connection, subscription.ID).
+				Return(nil).Once()
+			repoSunbscriptions.EXPECT().GetByID(mock.Anything, connection, subscription.ID).
+				Return(stored, nil).Once()
+			if !test.deleted {
+				repoSunbscriptions.EXPECT().ReadDiscounts(mock.Anything, connection, mock.Anything).
+					Return(nil, nil).Once()
+				repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, connection, mock.Anything).
+					Return(nil, nil).Once()
+				repoLedger.EXPECT().
+					Insert(mock.Anything, connection, mock.MatchedBy(func(charges []domain.Charge) bool {
+						return len(charges) == test.inserted
+					})).
+					Return(nil).Once()
+			}
+
+			err := domain.NewLedgerService(
+				database.NewDummyProvider(connection),
+				repoLedger,
+				repoSunbscriptions,
+			).Sync(t.Context(), connection, subscription.ID)
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_SpendSeriesOneTimePurchase(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	january := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	february := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoSunbscriptions.EXPECT().
+		ReadActiveInPeriod(mock.Anything, mock.Anything, &userID, (*domain.ServiceName)(nil), january, march).
+		Return([]domain.Subscription{
+			{
+				ID:        uuid.New(),
+				Kind:      domain.SubscriptionRecurring,
+				Name:      "Cloud",
+				Cost:      100,
+				UserID:    userID,
+				StartDate: january,
+			},
+			{
+				// A lifetime license stored without an end date is still billed
+				// only once.
+				ID:        uuid.New(),
+				Kind:      domain.SubscriptionOneTime,
+				Name:      "Editor license",
+				Cost:      5000,
+				UserID:    userID,
+				StartDate: february,
+			},
+		}, nil).Once()
+	repoSunbscriptions.EXPECT().ReadDiscounts(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+	series, err := domain.NewSubscriptionService(provider, repoSunbscriptions, mocks.NewMockCatalogRepository(t)).
+		SpendSeries(t.Context(), domain.CostQuery{UserID: &userID, Start: january, End: march})
+
+	require.NoError(t, err)
+	require.Equal(t, []domain.SeriesPoint{
+		{Month: january, Total: 100},
+		{Month: february, Total: 5100},
+		{Month: march, Total: 100},
+	}, series)
+}
+
+func TestServicePVZ_CreateOneTimePurchase(t *testing.T) {
+	t.Parallel()
+
+	purchase := domain.Subscription{
+		Kind:      domain.SubscriptionOneTime,
+		Name:      "Editor license",
+		Cost:      5000,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.February, 14, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("Ends in purchase month", func(t *testing.T) {
+		t.Parallel()
+
+		provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+		repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+		repoCatalog := mocks.NewMockCatalogRepository(t)
+		repoCatalog.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, purchase.Name).
+			Return([]domain.CatalogEntry{{ID: uuid.New(), Name: purchase.Name}}, nil).Once()
+		repoSunbscriptions.EXPECT().
+			Create(mock.Anything, mock.Anything, mock.MatchedBy(func(subscription domain.Subscription) bool {
+				month := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+				return subscription.StartDate.Equal(month) &&
+					subscription.EndDate != nil && subscription.EndDate.Equal(month)
+			})).
+			Return(nil).Once()
+
+		_, err := domain.NewSubscriptionService(provider, repoSunbscriptions, repoCatalog).
+			Create(t.Context(), purchase)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Term rejected", func(t *testing.T) {
+		t.Parallel()
+
+		invalid := purchase
+		invalid.TermMonths = pointer.Ref(12)
+		provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+
+		_, err := domain.NewSubscriptionService(
+			provider,
+			mocks.NewMockSubscriptionsRepository(t),
+			mocks.NewMockCatalogRepository(t),
+		).Create(t.Context(), invalid)
+
+		require.ErrorIs(t, err, domain.ErrServiceCreateSubscription)
+	})
+}
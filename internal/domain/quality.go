@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"ef_project/internal/infra/log"
+)
+
+var _ QualityInterface = (*QualityService)(nil)
+
+const (
+	// AnomalyDuplicate is a copy of an earlier record of the same service,
+	// period and cost.
+	AnomalyDuplicate AnomalyType = "duplicate"
+	// AnomalyOverlap is a recurring subscription running into a later one of
+	// the same user and service.
+	AnomalyOverlap         AnomalyType = "overlap"
+	AnomalyEndBeforeStart  AnomalyType = "end_before_start"
+	AnomalyNonPositiveCost AnomalyType = "non_positive_cost"
+	// AnomalySentinelEndDate is an end date in the year 9999 once written for
+	// open-ended subscriptions, which are now stored without an end date.
+	AnomalySentinelEndDate AnomalyType = "sentinel_end_date"
+)
+
+var (
+	errServiceQuality     = errors.New("quality service error")
+	ErrServiceQualityScan = errors.Join(
+		errServiceQuality,
+		errors.New("scan failed"),
+	)
+	ErrServiceQualityFix = errors.Join(
+		errServiceQuality,
+		errors.New("fix failed"),
+	)
+	errDryRun = errors.New("dry run")
+)
+
+// AnomalyFixOrder lists the anomaly types in the order they are fixed.
+// Duplicates go first so that they are not truncated as overlaps, and dates
+// are straightened out before overlaps are looked for.
+func AnomalyFixOrder() []AnomalyType {
+	return []AnomalyType{
+		AnomalyDuplicate,
+		AnomalyEndBeforeStart,
+		AnomalySentinelEndDate,
+		AnomalyOverlap,
+		AnomalyNonPositiveCost,
+	}
+}
+
+// QualityService finds subscription records that predate validation and
+// fixes the ones that have an unambiguous fix.
+type QualityService struct {
+	provider    ConnectionProvider
+	qualityRepo QualityRepository
+	auditRepo   AuditRepository
+	ledger      ChargeLedger
+}
+
+func NewQualityService(
+	provider ConnectionProvider,
+	qualityRepo QualityRepository,
+	auditRepo AuditRepository,
+	ledger ChargeLedger,
+) *QualityService {
+	return &QualityService{
+		provider:    provider,
+		qualityRepo: qualityRepo,
+		auditRepo:   auditRepo,
+		ledger:      ledger,
+	}
+}
+
+// Scan reports every anomaly among the subscriptions not in the trash.
+func (s *QualityService) Scan(ctx context.Context) ([]Anomaly, error) {
+	slog.DebugContext(ctx, "Service: scanning subscriptions for anomalies.", log.RequestID(ctx))
+	var anomalies []Anomaly
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		anomalies, dbErr = s.qualityRepo.FindAnomalies(ctx, c)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServiceQualityScan, err)
+	}
+	return anomalies, nil
+}
+
+// Fix runs every fix in one transaction and reports what is left:
+//   - duplicates are moved to the trash, keeping the first created record;
+//   - swapped start and end dates are swapped back;
+//   - 9999 end dates are cleared;
+//   - an overlapping subscription is ended the month before the next one
+//     starts, as both months of an end date and a start date are billed;
+//   - a non-positive cost is replaced by the catalog's default price.
+//
+// A dry run rolls the transaction back, so it previews the same result
+// without changing anything.
+func (s *QualityService) Fix(ctx context.Context, dryRun bool) (QualityFix, error) {
+	slog.DebugContext(ctx, "Service: fixing subscription anomalies.", log.RequestID(ctx), slog.Bool("dry_run", dryRun))
+	var fix QualityFix
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		fix = QualityFix{Fixed: map[AnomalyType]int{}, DryRun: dryRun}
+		fixed := map[SubscriptionID]struct{}{}
+		for _, anomaly := range AnomalyFixOrder() {
+			ids, err := s.qualityRepo.Fix(ctx, c, anomaly)
+			if err != nil {
+				return err
+			}
+			fix.Fixed[anomaly] = len(ids)
+			for _, id := range ids {
+				fixed[id] = struct{}{}
+			}
+		}
+
+		var err error
+		if fix.Remaining, err = s.qualityRepo.FindAnomalies(ctx, c); err != nil {
+			return err
+		}
+		if dryRun {
+			return errDryRun
+		}
+
+		entry := newAuditEntry(ctx, AuditOperationQualityFix)
+		if entry.Details, err = json.Marshal(map[string]any{"fixed": fix.Fixed}); err != nil {
+			return err
+		}
+		if err := s.auditRepo.Record(ctx, c, entry); err != nil {
+			return err
+		}
+		if s.ledger == nil {
+			return nil
+		}
+		for id := range fixed {
+			if err := s.ledger.Sync(ctx, c, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return QualityFix{}, errors.Join(ErrServiceQualityFix, err)
+	}
+	return fix, nil
+}
@@ -0,0 +1,91 @@
+package domain_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_QualityFix(t *testing.T) {
+	t.Parallel()
+
+	duplicate := uuid.New()
+	overlapping := uuid.New()
+	remaining := []domain.Anomaly{{
+		Type:         domain.AnomalyNonPositiveCost,
+		Subscription: domain.Subscription{ID: uuid.New(), Name: "Custom"},
+	}}
+
+	tests := []struct {
+		name   string
+		dryRun bool
+	}{
+		{name: "Applied", dryRun: false},
+		{name: "Dry run", dryRun: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+			repoQuality := mocks.NewMockQualityRepository(t)
+			repoAudit := mocks.NewMockAuditRepository(t)
+			ledger := mocks.NewMockChargeLedger(t)
+			for _, anomaly := range domain.AnomalyFixOrder() {
+				var fixed []domain.SubscriptionID
+				switch anomaly {
+				case domain.AnomalyDuplicate:
+					fixed = []domain.SubscriptionID{duplicate}
+				case domain.AnomalyOverlap:
+					fixed = []domain.SubscriptionID{overlapping}
+				}
+				repoQuality.EXPECT().Fix(mock.Anything, mock.Anything, anomaly).Return(fixed, nil).Once()
+			}
+			repoQuality.EXPECT().FindAnomalies(mock.Anything, mock.Anything).Return(remaining, nil).Once()
+
+			var recorded domain.AuditEntry
+			if !tt.dryRun {
+				repoAudit.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything).
+					Run(func(_ context.Context, _ domain.Connection, entry domain.AuditEntry) {
+						recorded = entry
+					}).
+					Return(nil).Once()
+				ledger.EXPECT().Sync(mock.Anything, mock.Anything, duplicate).Return(nil).Once()
+				ledger.EXPECT().Sync(mock.Anything, mock.Anything, overlapping).Return(nil).Once()
+			}
+
+			fix, err := domain.NewQualityService(provider, repoQuality, repoAudit, ledger).
+				Fix(t.Context(), tt.dryRun)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.dryRun, fix.DryRun)
+			require.Equal(t, map[domain.AnomalyType]int{
+				domain.AnomalyDuplicate:       1,
+				domain.AnomalyEndBeforeStart:  0,
+				domain.AnomalySentinelEndDate: 0,
+				domain.AnomalyOverlap:         1,
+				domain.AnomalyNonPositiveCost: 0,
+			}, fix.Fixed)
+			require.Equal(t, remaining, fix.Remaining)
+			if tt.dryRun {
+				return
+			}
+
+			require.Equal(t, domain.AuditOperationQualityFix, recorded.Operation)
+			var details struct {
+				Fixed map[domain.AnomalyType]int `json:"fixed"`
+			}
+			require.NoError(t, json.Unmarshal(recorded.Details, &details))
+			require.Equal(t, fix.Fixed, details.Fixed)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"ef_project/internal/infra/log"
+	"ef_project/internal/infra/pointer"
+)
+
+var ErrServiceOwed = errors.Join(
+	errServiseSubscription,
+	errors.New("owed failed"),
+)
+
+const (
+	SplitEqual      SplitRule = "equal"
+	SplitPercentage SplitRule = "percentage"
+	SplitFixed      SplitRule = "fixed"
+)
+
+type userShare struct {
+	UserID UserID
+	Amount int
+}
+
+// subscriptionShares splits a monthly amount of a subscription between its
+// payer and members. The payer always comes first, is never listed as a
+// member and takes whatever the members do not owe, including rounding
+// remainders.
+func subscriptionShares(subscription Subscription, amount int) []userShare {
+	if subscription.SplitRule == nil || len(subscription.Members) == 0 {
+		return []userShare{{UserID: subscription.UserID, Amount: amount}}
+	}
+
+	shares := make([]userShare, 0, len(subscription.Members)+1)
+	owed := 0
+	for _, member := range subscription.Members {
+		var share int
+		switch *subscription.SplitRule {
+		case SplitEqual:
+			share = amount / (len(subscription.Members) + 1)
+		case SplitPercentage:
+			share = amount * pointer.Deref(member.Share) / 100
+		case SplitFixed:
+			share = min(pointer.Deref(member.Share), amount-owed)
+		}
+		owed += share
+		shares = append(shares, userShare{UserID: member.UserID, Amount: share})
+	}
+
+	return append([]userShare{{UserID: subscription.UserID, Amount: amount - owed}}, shares...)
+}
+
+func validateSplit(subscription Subscription) error {
+	if subscription.SplitRule == nil {
+		if len(subscription.Members) > 0 {
+			return errors.New("members need a split rule")
+		}
+		return nil
+	}
+	if len(subscription.Members) == 0 {
+		return errors.New("split rule needs members")
+	}
+
+	seen := map[UserID]struct{}{subscription.UserID: {}}
+	total := 0
+	for _, member := range subscription.Members {
+		if _, ok := seen[member.UserID]; ok {
+			return errors.New("member " + member.UserID.String() + " is listed twice or pays the subscription")
+		}
+		seen[member.UserID] = struct{}{}
+
+		switch *subscription.SplitRule {
+		case SplitEqual:
+			if member.Share != nil {
+				return errors.New("equal split takes no shares")
+			}
+		case SplitPercentage, SplitFixed:
+			if member.Share == nil || *member.Share < 0 {
+				return errors.New("every member needs a non-negative share")
+			}
+			total += *member.Share
+		default:
+			return errors.New("unknown split rule " + string(*subscription.SplitRule))
+		}
+	}
+
+	switch {
+	case *subscription.SplitRule == SplitPercentage && total > 100:
+		return errors.New("shares exceed 100 percent")
+	case *subscription.SplitRule == SplitFixed && total > subscription.Cost:
+		return errors.New("shares exceed the subscription cost")
+	}
+	return nil
+}
+
+// attachMembers loads the members of the shared subscriptions among the given
+// ones.
+func attachMembers(
+	ctx context.Context,
+	c Connection,
+	subscriptionRepo SubscriptionsRepository,
+	subscriptions []Subscription,
+) ([]Subscription, error) {
+	var ids []SubscriptionID
+	for _, subscription := range subscriptions {
+		if subscription.SplitRule != nil {
+			ids = append(ids, subscription.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return subscriptions, nil
+	}
+
+	members, err := subscriptionRepo.ReadMembers(ctx, c, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range subscriptions {
+		for _, member := range members {
+			if member.SubscriptionID == subscriptions[i].ID {
+				subscriptions[i].Members = append(subscriptions[i].Members, member)
+			}
+		}
+	}
+	return subscriptions, nil
+}
+
+// Owed returns what the members of the subscriptions paid by payerID owe
+// them for [start, end], per member and subscription.
+func (s *SubscriptionService) Owed(
+	ctx context.Context,
+	payerID UserID,
+	start time.Time,
+	end time.Time,
+) ([]MemberDebt, error) {
+	slog.DebugContext(ctx, "Service: calculating shares owed.", log.RequestID(ctx))
+	if err := validateCostQuery(CostQuery{Start: start, End: end}); err != nil {
+		return nil, errors.Join(ErrServiceOwed, err)
+	}
+
+	var charges []Charge
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		charges, dbErr = readCharges(ctx, c, s.ledger, s.subscriptionRepo, ChargeQuery{
+			PayerID: &payerID,
+			Start:   start,
+			End:     end,
+		})
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServiceOwed, err)
+	}
+
+	type key struct {
+		userID         UserID
+		subscriptionID SubscriptionID
+	}
+	debts := map[key]*MemberDebt{}
+	for _, charge := range charges {
+		if charge.UserID == payerID {
+			continue
+		}
+		k := key{userID: charge.UserID, subscriptionID: charge.SubscriptionID}
+		if debts[k] == nil {
+			debts[k] = &MemberDebt{UserID: charge.UserID, SubscriptionID: charge.SubscriptionID, Name: charge.Name}
+		}
+		debts[k].Amount += charge.Amount
+	}
+
+	owed := make([]MemberDebt, 0, len(debts))
+	for _, debt := range debts {
+		owed = append(owed, *debt)
+	}
+	slices.SortFunc(owed, func(a, b MemberDebt) int {
+		if c := strings.Compare(a.UserID.String(), b.UserID.String()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return owed, nil
+}
@@ -0,0 +1,197 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_TotalSubscriptionsCostShared(t *testing.T) {
+	t.Parallel()
+
+	payer, first, second := uuid.New(), uuid.New(), uuid.New()
+	month := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		rule     domain.SplitRule
+		shares   []*int
+		expected map[domain.UserID]int
+	}{
+		{
+			name:     "Equal",
+			rule:     domain.SplitEqual,
+			shares:   []*int{nil, nil},
+			expected: map[domain.UserID]int{payer: 334, first: 333, second: 333},
+		},
+		{
+			name:     "Percentage",
+			rule:     domain.SplitPercentage,
+			shares:   []*int{pointer.Ref(50), pointer.Ref(25)},
+			expected: map[domain.UserID]int{payer: 250, first: 500, second: 250},
+		},
+		{
+			name:     "Fixed",
+			rule:     domain.SplitFixed,
+			shares:   []*int{pointer.Ref(100), pointer.Ref(300)},
+			expected: map[domain.UserID]int{payer: 600, first: 100, second: 300},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			subscription := domain.Subscription{
+				ID:        uuid.New(),
+				Name:      "Family plan",
+				Cost:      1000,
+				UserID:    payer,
+				StartDate: month,
+				SplitRule: pointer.Ref(test.rule),
+			}
+			members := []domain.SubscriptionMember{
+				{SubscriptionID: subscription.ID, UserID: first, Share: test.shares[0]},
+				{SubscriptionID: subscription.ID, UserID: second, Share: test.shares[1]},
+			}
+
+			for userID, expected := range test.expected {
+				provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+				repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+				repoSunbscriptions.EXPECT().
+					ReadActiveInPeriod(mock.Anything, mock.Anything, &userID, (*domain.ServiceName)(nil), month, month).
+					Return([]domain.Subscription{subscription}, nil).Once()
+				repoSunbscriptions.EXPECT().
+					ReadMembers(mock.Anything, mock.Anything, []domain.SubscriptionID{subscription.ID}).
+					Return(members, nil).Once()
+				repoSunbscriptions.EXPECT().ReadDiscounts(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, nil).Once()
+				repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, nil).Once()
+
+				report, err := domain.NewSubscriptionService(provider, repoSunbscriptions, mocks.NewMockCatalogRepository(t)).
+					TotalSubscriptionsCost(t.Context(), domain.CostQuery{UserID: &userID, Start: month, End: month})
+
+				require.NoError(t, err)
+				require.Equal(t, expected, report.Total)
+			}
+		})
+	}
+}
+
+func TestServicePVZ_Owed(t *testing.T) {
+	t.Parallel()
+
+	payer, member := uuid.New(), uuid.New()
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	shared := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "Family plan",
+		Cost:      900,
+		UserID:    payer,
+		StartDate: start,
+		SplitRule: pointer.Ref(domain.SplitEqual),
+	}
+	// The payer is only a member here, so it owes rather than is owed.
+	foreign := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "Other plan",
+		Cost:      500,
+		UserID:    member,
+		StartDate: start,
+		SplitRule: pointer.Ref(domain.SplitEqual),
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoSunbscriptions.EXPECT().
+		ReadActiveInPeriod(mock.Anything, mock.Anything, &payer, (*domain.ServiceName)(nil), start, end).
+		Return([]domain.Subscription{shared, foreign}, nil).Once()
+	repoSunbscriptions.EXPECT().
+		ReadMembers(mock.Anything, mock.Anything, []domain.SubscriptionID{shared.ID, foreign.ID}).
+		Return([]domain.SubscriptionMember{
+			{SubscriptionID: shared.ID, UserID: member},
+			{SubscriptionID: foreign.ID, UserID: payer},
+		}, nil).Once()
+	repoSunbscriptions.EXPECT().ReadDiscounts(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).Once()
+	repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).Once()
+
+	owed, err := domain.NewSubscriptionService(provider, repoSunbscriptions, mocks.NewMockCatalogRepository(t)).
+		Owed(t.Context(), payer, start, end)
+
+	require.NoError(t, err)
+	require.Equal(t, []domain.MemberDebt{
+		{UserID: member, SubscriptionID: shared.ID, Name: shared.Name, Amount: 3 * 450},
+	}, owed)
+}
+
+func TestServicePVZ_CreateRejectsInvalidSplit(t *testing.T) {
+	t.Parallel()
+
+	payer := uuid.New()
+
+	tests := []struct {
+		name      string
+		splitRule *domain.SplitRule
+		members   []domain.SubscriptionMember
+	}{
+		{
+			name:    "Members without rule",
+			members: []domain.SubscriptionMember{{UserID: uuid.New()}},
+		},
+		{
+			name:      "Rule without members",
+			splitRule: pointer.Ref(domain.SplitEqual),
+		},
+		{
+			name:      "Payer as member",
+			splitRule: pointer.Ref(domain.SplitEqual),
+			members:   []domain.SubscriptionMember{{UserID: payer}},
+		},
+		{
+			name:      "Percentages over 100",
+			splitRule: pointer.Ref(domain.SplitPercentage),
+			members: []domain.SubscriptionMember{
+				{UserID: uuid.New(), Share: pointer.Ref(60)},
+				{UserID: uuid.New(), Share: pointer.Ref(50)},
+			},
+		},
+		{
+			name:      "Fixed over cost",
+			splitRule: pointer.Ref(domain.SplitFixed),
+			members:   []domain.SubscriptionMember{{UserID: uuid.New(), Share: pointer.Ref(1001)}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+
+			_, err := domain.NewSubscriptionService(
+				provider,
+				mocks.NewMockSubscriptionsRepository(t),
+				mocks.NewMockCatalogRepository(t),
+			).Create(t.Context(), domain.Subscription{
+				Name:      "Family plan",
+				Cost:      1000,
+				UserID:    payer,
+				StartDate: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+				SplitRule: test.splitRule,
+				Members:   test.members,
+			})
+
+			require.ErrorIs(t, err, domain.ErrServiceCreateSubscription)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"time"
+
+	"ef_project/internal/infra/log"
+)
+
+var ErrServiceStatement = errors.Join(
+	errServiseSubscription,
+	errors.New("statement failed"),
+)
+
+// Statement lists what the user owes for each subscription in the month, with
+// totals by category and the total of the previous month for comparison.
+func (s *SubscriptionService) Statement(ctx context.Context, userID UserID, month time.Time) (Statement, error) {
+	slog.DebugContext(ctx, "Service: building statement.", log.RequestID(ctx))
+	month = BillingPeriod(month)
+	previous := month.AddDate(0, -1, 0)
+
+	var (
+		subscriptions []Subscription
+		charges       []Charge
+	)
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var err error
+		charges, err = readCharges(ctx, c, s.ledger, s.subscriptionRepo, ChargeQuery{
+			UserID: &userID,
+			Start:  previous,
+			End:    month,
+		})
+		if err != nil {
+			return err
+		}
+		// The lines show the split rule and the price billed for the month.
+		subscriptions, err = s.subscriptionRepo.ReadActiveInPeriod(ctx, c, &userID, nil, month, month)
+		if err != nil {
+			return err
+		}
+		subscriptions, err = attachActuals(ctx, c, s.subscriptionRepo, subscriptions)
+		return err
+	})
+	if err != nil {
+		return Statement{}, errors.Join(ErrServiceStatement, err)
+	}
+
+	statement := Statement{UserID: userID, Month: month}
+	var current []Charge
+	for _, charge := range charges {
+		if charge.Month.Equal(previous) {
+			statement.PreviousTotal += charge.Amount
+			continue
+		}
+		current = append(current, charge)
+	}
+
+	report := costReport(current, GroupByCategory)
+	statement.Total, statement.Categories = report.Total, report.Groups
+	statement.Lines = statementLines(subscriptions, current, month)
+
+	return statement, nil
+}
+
+// statementLines folds the month's charges into one line per subscription.
+func statementLines(subscriptions []Subscription, charges []Charge, month time.Time) []StatementLine {
+	lines := map[SubscriptionID]*StatementLine{}
+	for _, charge := range charges {
+		line, ok := lines[charge.SubscriptionID]
+		if !ok {
+			line = &StatementLine{
+				SubscriptionID: charge.SubscriptionID,
+				Name:           charge.Name,
+				Category:       charge.Category,
+				PayerID:        charge.PayerID,
+				Actual:         charge.Actual,
+				OneTime:        charge.OneTime,
+			}
+			lines[charge.SubscriptionID] = line
+		}
+
+		switch charge.Kind {
+		case ChargeKindSubscription:
+			line.Share += charge.Amount
+		case ChargeKindDiscount:
+			line.Discount += charge.Amount
+		}
+		line.Amount += charge.Amount
+	}
+
+	result := make([]StatementLine, 0, len(lines))
+	for _, subscription := range subscriptions {
+		line, ok := lines[subscription.ID]
+		if !ok {
+			continue
+		}
+		line.SplitRule = subscription.SplitRule
+		line.Cost, _ = monthAmount(subscription, month)
+		result = append(result, *line)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
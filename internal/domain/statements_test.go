@@ -0,0 +1,107 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_Statement(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	february := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	family := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "Netflix",
+		Cost:      1000,
+		UserID:    userID,
+		StartDate: february,
+		Category:  pointer.Ref("video"),
+		SplitRule: pointer.Ref(domain.SplitEqual),
+	}
+	music := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "Spotify",
+		Cost:      300,
+		UserID:    userID,
+		StartDate: march,
+		Category:  pointer.Ref("music"),
+	}
+
+	charge := func(subscription domain.Subscription, kind domain.ChargeKind, month time.Time, amount int) domain.Charge {
+		return domain.Charge{
+			SubscriptionID: subscription.ID,
+			UserID:         userID,
+			PayerID:        userID,
+			Kind:           kind,
+			Name:           subscription.Name,
+			Category:       subscription.Category,
+			Month:          month,
+			Amount:         amount,
+		}
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	ledger := mocks.NewMockChargeLedger(t)
+	ledger.EXPECT().
+		Charges(mock.Anything, mock.Anything, domain.ChargeQuery{UserID: &userID, Start: february, End: march}).
+		Return([]domain.Charge{
+			charge(family, domain.ChargeKindSubscription, february, 500),
+			charge(family, domain.ChargeKindSubscription, march, 500),
+			charge(music, domain.ChargeKindSubscription, march, 300),
+			charge(music, domain.ChargeKindDiscount, march, -150),
+		}, nil).Once()
+	repoSunbscriptions.EXPECT().
+		ReadActiveInPeriod(mock.Anything, mock.Anything, &userID, (*domain.ServiceName)(nil), march, march).
+		Return([]domain.Subscription{music, family}, nil).Once()
+	repoSunbscriptions.EXPECT().ReadActuals(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+	statement, err := domain.NewSubscriptionService(
+		provider,
+		repoSunbscriptions,
+		mocks.NewMockCatalogRepository(t),
+		domain.WithChargeLedger(ledger),
+	).Statement(t.Context(), userID, march.AddDate(0, 0, 14))
+
+	require.NoError(t, err)
+	require.Equal(t, march, statement.Month)
+	require.Equal(t, []domain.StatementLine{
+		{
+			SubscriptionID: family.ID,
+			Name:           "Netflix",
+			Category:       family.Category,
+			PayerID:        userID,
+			SplitRule:      family.SplitRule,
+			Cost:           1000,
+			Share:          500,
+			Amount:         500,
+		},
+		{
+			SubscriptionID: music.ID,
+			Name:           "Spotify",
+			Category:       music.Category,
+			PayerID:        userID,
+			Cost:           300,
+			Share:          300,
+			Discount:       -150,
+			Amount:         150,
+		},
+	}, statement.Lines)
+	require.Equal(t, []domain.GroupTotal{
+		{Group: "video", Total: 500},
+		{Group: "music", Total: 150},
+	}, statement.Categories)
+	require.Equal(t, 650, statement.Total)
+	require.Equal(t, 500, statement.PreviousTotal)
+}
@@ -4,13 +4,46 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"slices"
 	"time"
 
 	"ef_project/internal/infra/log"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
 )
 
 var _ SubscriptionInterface = (*SubscriptionService)(nil)
 
+const (
+	SubscriptionRecurring SubscriptionKind = "recurring"
+	// SubscriptionOneTime is a one-off purchase, such as a lifetime license,
+	// billed only in its purchase month.
+	SubscriptionOneTime SubscriptionKind = "one_time"
+)
+
+const (
+	CancellationTooExpensive    CancellationCode = "too_expensive"
+	CancellationNotUsing        CancellationCode = "not_using"
+	CancellationSwitchedService CancellationCode = "switched_service"
+	CancellationMissingFeatures CancellationCode = "missing_features"
+	CancellationTechnicalIssues CancellationCode = "technical_issues"
+	CancellationTemporary       CancellationCode = "temporary"
+	CancellationOther           CancellationCode = "other"
+)
+
+func cancellationCodes() []CancellationCode {
+	return []CancellationCode{
+		CancellationTooExpensive,
+		CancellationNotUsing,
+		CancellationSwitchedService,
+		CancellationMissingFeatures,
+		CancellationTechnicalIssues,
+		CancellationTemporary,
+		CancellationOther,
+	}
+}
+
 var (
 	errServiseSubscription       = errors.New("service error")
 	ErrServiceCreateSubscription = errors.Join(
@@ -29,6 +62,10 @@ var (
 		errServiseSubscription,
 		errors.New("delete failed"),
 	)
+	ErrServiceCancelSubscription = errors.Join(
+		errServiseSubscription,
+		errors.New("cancel failed"),
+	)
 	ErrServiceReadAllByUserIDList = errors.Join(
 		errServiseSubscription,
 		errors.New("read all by user id failed"),
@@ -37,20 +74,103 @@ var (
 		errServiseSubscription,
 		errors.New("total cost failed"),
 	)
+	ErrServiceSpendSeries = errors.Join(
+		errServiseSubscription,
+		errors.New("spend series failed"),
+	)
+	ErrServiceReadTrash = errors.Join(
+		errServiseSubscription,
+		errors.New("read trash failed"),
+	)
+	ErrServiceRestoreSubscription = errors.Join(
+		errServiseSubscription,
+		errors.New("restore failed"),
+	)
+	ErrServicePurgeTrash = errors.Join(
+		errServiseSubscription,
+		errors.New("purge trash failed"),
+	)
+	ErrServiceProcessRenewals = errors.Join(
+		errServiseSubscription,
+		errors.New("process renewals failed"),
+	)
 )
 
-type SubscriptionService struct {
-	provider         ConnectionProvider
-	subscriptionRepo SubscriptionsRepository
-}
+type (
+	SubscriptionService struct {
+		provider         ConnectionProvider
+		subscriptionRepo SubscriptionsRepository
+		catalogRepo      CatalogRepository
+		autoMapThreshold float64
+		budgets          BudgetEvaluator
+		ledger           ChargeLedger
+		audit            AuditRepository
+		undoWindow       time.Duration
+	}
+
+	SubscriptionServiceOption func(*SubscriptionService)
+)
 
 func NewSubscriptionService(
 	provider ConnectionProvider,
 	subscriptionRepo SubscriptionsRepository,
+	catalogRepo CatalogRepository,
+	options ...SubscriptionServiceOption,
 ) *SubscriptionService {
-	return &SubscriptionService{
+	service := &SubscriptionService{
 		provider:         provider,
 		subscriptionRepo: subscriptionRepo,
+		catalogRepo:      catalogRepo,
+		undoWindow:       defaultUndoWindow,
+	}
+
+	for _, o := range options {
+		o(service)
+	}
+
+	return service
+}
+
+// WithAutoMapThreshold makes Create map an unknown service name onto a catalog
+// entry when it is the only suggestion with at least the given similarity.
+// Zero disables automatic mapping.
+func WithAutoMapThreshold(threshold float64) SubscriptionServiceOption {
+	return func(s *SubscriptionService) {
+		s.autoMapThreshold = threshold
+	}
+}
+
+// WithBudgetEvaluator makes Create and Update report the budget thresholds
+// their write pushes the user's spend over.
+func WithBudgetEvaluator(budgets BudgetEvaluator) SubscriptionServiceOption {
+	return func(s *SubscriptionService) {
+		s.budgets = budgets
+	}
+}
+
+// WithChargeLedger makes every subscription write keep the charges ledger in
+// sync within its transaction.
+func WithChargeLedger(ledger ChargeLedger) SubscriptionServiceOption {
+	return func(s *SubscriptionService) {
+		s.ledger = ledger
+	}
+}
+
+// WithAuditLog makes Create, Update and Delete record the subscription before
+// and after the change in the audit log within their transaction.
+func WithAuditLog(audit AuditRepository) SubscriptionServiceOption {
+	return func(s *SubscriptionService) {
+		s.audit = audit
+	}
+}
+
+// WithUndoWindow sets how old a change Undo may still revert. Zero keeps the
+// default of a day.
+func WithUndoWindow(window time.Duration) SubscriptionServiceOption {
+	return func(s *SubscriptionService) {
+		if window > 0 {
+			s.undoWindow = window
+		}
 	}
 }
 
@@ -71,30 +191,135 @@ func (s *SubscriptionService) GetLatest(
 	return subscription, nil
 }
 
-func (s *SubscriptionService) Create(ctx context.Context, subscription Subscription) error {
+// Create stores a new subscription. When its name matches no catalog entry,
+// the closest catalog entries are returned as suggestions.
+func (s *SubscriptionService) Create(
+	ctx context.Context,
+	subscription Subscription,
+) ([]CatalogMatch, error) {
 	slog.DebugContext(ctx, "Service: creating subscription.", log.RequestID(ctx))
-	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
-		latestEndDate, err := s.subscriptionRepo.GetLatestSubscriptionDate(
-			ctx,
-			c,
-			subscription.UserID,
-			subscription.Name,
-		)
+	if subscription.ID == uuid.Nil {
+		subscription.ID = uuid.New()
+	}
+	if err := validateSplit(subscription); err != nil {
+		return nil, errors.Join(ErrServiceCreateSubscription, err)
+	}
+	subscription, err := normalizeKind(subscription)
+	if err != nil {
+		return nil, errors.Join(ErrServiceCreateSubscription, err)
+	}
+	if subscription.TermMonths != nil {
+		if *subscription.TermMonths <= 0 {
+			return nil, errors.Join(ErrServiceCreateSubscription, errors.New("term must be positive"))
+		}
+		if subscription.EndDate == nil {
+			subscription.EndDate = pointer.Ref(TermEnd(subscription.StartDate, *subscription.TermMonths))
+		}
+	}
+	var (
+		suggestions []CatalogMatch
+		breaches    []BudgetBreach
+	)
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		entry, found, err := resolveCatalogEntry(ctx, c, s.catalogRepo, subscription.Name)
 		if err != nil {
-			return errors.Join(ErrGetLatestSubscription, err)
+			return err
 		}
-		if latestEndDate != nil && (latestEndDate.After(subscription.StartDate)) {
-			return errors.Join(
-				ErrServiceCreateSubscription,
-				errors.New("previous subscription has not ended"))
+		if !found {
+			suggestions, err = searchCatalog(ctx, c, s.catalogRepo, subscription.Name, 0)
+			if err != nil {
+				return errors.Join(ErrServiceSearchCatalog, err)
+			}
+			entry, found = s.autoMap(suggestions)
+		}
+		if found {
+			subscription.Name = entry.Name
+			subscription.ServiceID = &entry.ID
+			suggestions = nil
+		}
+
+		// One-time purchases may repeat, only recurring subscriptions must
+		// not overlap.
+		if subscription.Kind == SubscriptionRecurring {
+			latestEndDate, err := s.subscriptionRepo.GetLatestSubscriptionDate(
+				ctx,
+				c,
+				subscription.UserID,
+				subscription.Name,
+			)
+			if err != nil {
+				return errors.Join(ErrGetLatestSubscription, err)
+			}
+			if latestEndDate != nil && (latestEndDate.After(subscription.StartDate)) {
+				return errors.Join(
+					ErrServiceCreateSubscription,
+					errors.New("previous subscription has not ended"))
+			}
 		}
 
-		return s.subscriptionRepo.Create(ctx, c, subscription)
+		breaches, err = s.watchBudgets(ctx, c, subscription, func() error {
+			if err := s.subscriptionRepo.Create(ctx, c, subscription); err != nil {
+				return err
+			}
+			if len(subscription.Members) > 0 {
+				err := s.subscriptionRepo.ReplaceMembers(ctx, c, subscription.ID, subscription.Members)
+				if err != nil {
+					return err
+				}
+			}
+			return s.syncLedger(ctx, c, subscription.ID)
+		})
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, c, AuditOperationCreateSubscription, nil, &subscription)
 	})
 	if err != nil {
-		return errors.Join(ErrServiceCreateSubscription, err)
+		return nil, errors.Join(ErrServiceCreateSubscription, err)
 	}
-	return nil
+	s.publishBreaches(ctx, breaches)
+	return suggestions, nil
+}
+
+// latestByName finds the latest subscription a write addresses. The name is
+// matched as stored first, and only a name nothing is stored under is looked
+// up in the catalog by name or alias. It is never matched by similarity, as
+// the write may cancel or delete the subscription.
+func (s *SubscriptionService) latestByName(
+	ctx context.Context,
+	c Connection,
+	userID UserID,
+	name ServiceName,
+) (Subscription, error) {
+	latest, err := s.subscriptionRepo.GetLatestByName(ctx, c, userID, name)
+	if !errors.Is(err, ErrNotFound) {
+		return latest, err
+	}
+	entry, found, resolveErr := resolveCatalogEntry(ctx, c, s.catalogRepo, name)
+	if resolveErr != nil {
+		return latest, resolveErr
+	}
+	if !found || entry.Name == name {
+		return latest, err
+	}
+	return s.subscriptionRepo.GetLatestByName(ctx, c, userID, entry.Name)
+}
+
+func (s *SubscriptionService) autoMap(suggestions []CatalogMatch) (CatalogEntry, bool) {
+	if s.autoMapThreshold <= 0 {
+		return CatalogEntry{}, false
+	}
+
+	var confident []CatalogMatch
+	for _, suggestion := range suggestions {
+		if suggestion.Score >= s.autoMapThreshold {
+			confident = append(confident, suggestion)
+		}
+	}
+	if len(confident) != 1 {
+		return CatalogEntry{}, false
+	}
+	return confident[0].CatalogEntry, true
 }
 
 func (s *SubscriptionService) Delete(
@@ -103,8 +328,21 @@ func (s *SubscriptionService) Delete(
 	subscriptionName ServiceName,
 ) error {
 	slog.DebugContext(ctx, "Service: deleting subscribtion.", log.RequestID(ctx))
-	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
-		return s.subscriptionRepo.Delete(ctx, c, subscriptionUserID, subscriptionName)
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		latest, err := s.latestByName(ctx, c, subscriptionUserID, subscriptionName)
+		if err != nil {
+			return err
+		}
+		if latest, err = s.auditSnapshot(ctx, c, latest); err != nil {
+			return err
+		}
+		if err := s.subscriptionRepo.Delete(ctx, c, subscriptionUserID, latest.Name); err != nil {
+			return err
+		}
+		if err := s.auditChange(ctx, c, AuditOperationDeleteSubscription, latest); err != nil {
+			return err
+		}
+		return s.syncLedger(ctx, c, latest.ID)
 	})
 	if err != nil {
 		return errors.Join(ErrServiceDeleteSubscription, err)
@@ -112,14 +350,101 @@ func (s *SubscriptionService) Delete(
 	return nil
 }
 
+func (s *SubscriptionService) Cancel(ctx context.Context, cancellation Cancellation) error {
+	slog.DebugContext(ctx, "Service: cancelling subscribtion.", log.RequestID(ctx))
+	if cancellation.Code == "" {
+		cancellation.Code = CancellationOther
+	}
+	if !slices.Contains(cancellationCodes(), cancellation.Code) {
+		return errors.Join(
+			ErrServiceCancelSubscription,
+			errors.New("unknown cancellation code "+string(cancellation.Code)),
+		)
+	}
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		latest, err := s.latestByName(ctx, c, cancellation.UserID, cancellation.Name)
+		if err != nil {
+			return err
+		}
+		cancellation.Name = latest.Name
+		if latest, err = s.auditSnapshot(ctx, c, latest); err != nil {
+			return err
+		}
+
+		endDate := BillingPeriod(time.Now())
+		if cancellation.EndDate != nil {
+			endDate = BillingPeriod(*cancellation.EndDate)
+		}
+		if endDate.Before(BillingPeriod(latest.StartDate)) {
+			return errors.New("cancellation period is before subscription start")
+		}
+		if latest.EndDate != nil && latest.EndDate.Before(endDate) {
+			return errors.New("subscription ends before cancellation period")
+		}
+
+		err = s.subscriptionRepo.Cancel(
+			ctx,
+			c,
+			cancellation.UserID,
+			cancellation.Name,
+			endDate,
+			cancellation.Code,
+			cancellation.Reason,
+		)
+		if err != nil {
+			return err
+		}
+		if err := s.auditChange(ctx, c, AuditOperationCancelSubscription, latest); err != nil {
+			return err
+		}
+		return s.syncLedger(ctx, c, latest.ID)
+	})
+	if err != nil {
+		return errors.Join(ErrServiceCancelSubscription, err)
+	}
+	return nil
+}
+
 func (s *SubscriptionService) Update(ctx context.Context, subscription Subscription) error {
 	slog.DebugContext(ctx, "Service: updating subscribtion.", log.RequestID(ctx))
-	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
-		return s.subscriptionRepo.Update(ctx, c, subscription)
+	if err := validateSplit(subscription); err != nil {
+		return errors.Join(ErrServiceUpdateSubscription, err)
+	}
+	if subscription.TermMonths != nil && *subscription.TermMonths <= 0 {
+		return errors.Join(ErrServiceUpdateSubscription, errors.New("term must be positive"))
+	}
+	var breaches []BudgetBreach
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		latest, err := s.latestByName(ctx, c, subscription.UserID, subscription.Name)
+		if err != nil {
+			return err
+		}
+		// The start date is not updated, so a term runs from the stored one.
+		subscription.ID, subscription.Name, subscription.StartDate = latest.ID, latest.Name, latest.StartDate
+		if subscription.TermMonths != nil && subscription.EndDate == nil {
+			subscription.EndDate = pointer.Ref(TermEnd(subscription.StartDate, *subscription.TermMonths))
+		}
+		if latest, err = s.auditSnapshot(ctx, c, latest); err != nil {
+			return err
+		}
+		breaches, err = s.watchBudgets(ctx, c, subscription, func() error {
+			if err := s.subscriptionRepo.Update(ctx, c, subscription); err != nil {
+				return err
+			}
+			if err := s.subscriptionRepo.ReplaceMembers(ctx, c, latest.ID, subscription.Members); err != nil {
+				return err
+			}
+			return s.syncLedger(ctx, c, latest.ID)
+		})
+		if err != nil {
+			return err
+		}
+		return s.auditChange(ctx, c, AuditOperationUpdateSubscription, latest)
 	})
 	if err != nil {
 		return errors.Join(ErrServiceUpdateSubscription, err)
 	}
+	s.publishBreaches(ctx, breaches)
 	return nil
 }
 
@@ -132,6 +457,10 @@ func (s *SubscriptionService) ReadAllByUserID(
 	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
 		var dbErr error
 		subscriptions, dbErr = s.subscriptionRepo.ReadAllByUserID(ctx, c, subscriptionUserID)
+		if dbErr != nil {
+			return dbErr
+		}
+		subscriptions, dbErr = attachMembers(ctx, c, s.subscriptionRepo, subscriptions)
 		return dbErr
 	})
 	if err != nil {
@@ -140,33 +469,290 @@ func (s *SubscriptionService) ReadAllByUserID(
 	return subscriptions, nil
 }
 
-func (s *SubscriptionService) TotalSubscriptionsCost(
+func (s *SubscriptionService) ReadTrash(
 	ctx context.Context,
 	subscriptionUserID UserID,
-	subscriptionName ServiceName,
-	start time.Time,
-	end *time.Time,
-) (int, error) {
-	var totalCosts []int
+) ([]Subscription, error) {
+	slog.DebugContext(ctx, "Service: reading deleted subscribtions.", log.RequestID(ctx))
+	var subscriptions []Subscription
 	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
-		slog.DebugContext(ctx, "Service: calculating total cost.", log.RequestID(ctx))
 		var dbErr error
-		totalCosts, dbErr = s.subscriptionRepo.AllMatchingSubscriptionsForPeriod(
-			ctx,
-			c,
-			subscriptionUserID,
-			subscriptionName,
-			start,
-			end,
-		)
+		subscriptions, dbErr = s.subscriptionRepo.ReadDeletedByUserID(ctx, c, subscriptionUserID)
+		return dbErr
+	})
+	if err != nil {
+		return subscriptions, errors.Join(ErrServiceReadTrash, err)
+	}
+	return subscriptions, nil
+}
+
+func (s *SubscriptionService) Restore(ctx context.Context, subscriptionID SubscriptionID) error {
+	slog.DebugContext(ctx, "Service: restoring subscribtion.", log.RequestID(ctx))
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		subscription, err := s.subscriptionRepo.GetByID(ctx, c, subscriptionID)
+		if err != nil {
+			return err
+		}
+		if subscription.DeletedAt == nil {
+			return errors.New("subscription is not deleted")
+		}
+
+		overlapping, err := s.subscriptionRepo.CountOverlapping(ctx, c, subscription)
+		if err != nil {
+			return err
+		}
+		if overlapping > 0 {
+			return errors.New("restored subscription overlaps an active one")
+		}
+
+		if subscription, err = s.auditSnapshot(ctx, c, subscription); err != nil {
+			return err
+		}
+		if err := s.subscriptionRepo.Restore(ctx, c, subscriptionID); err != nil {
+			return err
+		}
+		if err := s.auditChange(ctx, c, AuditOperationRestoreSubscription, subscription); err != nil {
+			return err
+		}
+		return s.syncLedger(ctx, c, subscriptionID)
+	})
+	if err != nil {
+		return errors.Join(ErrServiceRestoreSubscription, err)
+	}
+	return nil
+}
+
+// PurgeTrash permanently removes subscriptions that were deleted before the
+// given moment.
+func (s *SubscriptionService) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	slog.DebugContext(ctx, "Service: purging deleted subscribtions.", log.RequestID(ctx))
+	var purged int64
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		purged, dbErr = s.subscriptionRepo.PurgeDeleted(ctx, c, deletedBefore)
+		return dbErr
+	})
+	if err != nil {
+		return purged, errors.Join(ErrServicePurgeTrash, err)
+	}
+	return purged, nil
+}
+
+// ProcessRenewals extends lapsed auto-renewing term subscriptions and marks
+// the rest of the lapsed term subscriptions expired. Running it again for the
+// same moment changes nothing.
+func (s *SubscriptionService) ProcessRenewals(ctx context.Context, now time.Time) (RenewalResult, error) {
+	slog.DebugContext(ctx, "Service: processing renewals.", log.RequestID(ctx))
+	var result RenewalResult
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		period := BillingPeriod(now)
+		renewed, err := s.subscriptionRepo.RenewDue(ctx, c, period)
+		if err != nil {
+			return err
+		}
+		// A renewal moves the end date, so the renewed months are billed now.
+		for _, id := range renewed {
+			if err := s.syncLedger(ctx, c, id); err != nil {
+				return err
+			}
+		}
+		result.Renewed = int64(len(renewed))
+		result.Expired, err = s.subscriptionRepo.ExpireDue(ctx, c, period, now)
+		return err
+	})
+	if err != nil {
+		return RenewalResult{}, errors.Join(ErrServiceProcessRenewals, err)
+	}
+	return result, nil
+}
+
+func (s *SubscriptionService) TotalSubscriptionsCost(
+	ctx context.Context,
+	query CostQuery,
+) (CostReport, error) {
+	slog.DebugContext(ctx, "Service: calculating total cost.", log.RequestID(ctx))
+	charges, err := s.charges(ctx, query)
+	if err != nil {
+		return CostReport{}, errors.Join(ErrServiceTotalSubscriptionsCostList, err)
+	}
+	return costReport(charges, query.GroupBy), nil
+}
+
+func (s *SubscriptionService) SpendSeries(
+	ctx context.Context,
+	query CostQuery,
+) ([]SeriesPoint, error) {
+	slog.DebugContext(ctx, "Service: calculating spend series.", log.RequestID(ctx))
+	charges, err := s.charges(ctx, query)
+	if err != nil {
+		return nil, errors.Join(ErrServiceSpendSeries, err)
+	}
+	return spendSeries(charges, query.Start, query.End, query.GroupBy), nil
+}
+
+// charges reads the charges of the queried period. With a user in the query
+// only that user's shares are kept.
+func (s *SubscriptionService) charges(ctx context.Context, query CostQuery) ([]Charge, error) {
+	if err := validateCostQuery(query); err != nil {
+		return nil, err
+	}
+
+	var charges []Charge
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		charges, dbErr = readCharges(ctx, c, s.ledger, s.subscriptionRepo, ChargeQuery{
+			UserID: query.UserID,
+			Name:   query.Name,
+			Start:  query.Start,
+			End:    query.End,
+		})
 		return dbErr
 	})
-	totalCost := 0
 	if err != nil {
-		return totalCost, errors.Join(ErrServiceTotalSubscriptionsCostList, err)
+		return nil, err
+	}
+	return charges, nil
+}
+
+// watchBudgets runs write and returns the budget thresholds it pushed the
+// spend of the payer or of a member over. Members are the stored ones and the
+// ones being written, as both may see their share change. The month evaluated
+// is the current one, or the first month of the subscription when it starts
+// later. Spend is read from the ledger, so write must bring it in sync.
+func (s *SubscriptionService) watchBudgets(
+	ctx context.Context,
+	c Connection,
+	subscription Subscription,
+	write func() error,
+) ([]BudgetBreach, error) {
+	if s.budgets == nil {
+		return nil, write()
+	}
+
+	month := BillingPeriod(time.Now())
+	if subscription.StartDate.After(month) {
+		month = BillingPeriod(subscription.StartDate)
+	}
+	stored, err := s.subscriptionRepo.ReadMembers(ctx, c, []SubscriptionID{subscription.ID})
+	if err != nil {
+		return nil, err
+	}
+	users := []UserID{subscription.UserID}
+	for _, member := range slices.Concat(stored, subscription.Members) {
+		if !slices.Contains(users, member.UserID) {
+			users = append(users, member.UserID)
+		}
+	}
+	snapshots := make([]BudgetSnapshot, 0, len(users))
+	for _, userID := range users {
+		snapshot, err := s.budgets.Snapshot(ctx, c, userID, month)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	if err := write(); err != nil {
+		return nil, err
+	}
+	var breaches []BudgetBreach
+	for _, snapshot := range snapshots {
+		userBreaches, err := s.budgets.Breaches(ctx, c, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		breaches = append(breaches, userBreaches...)
+	}
+	return breaches, nil
+}
+
+// syncLedger keeps the charges ledger in step with a write to a subscription.
+func (s *SubscriptionService) syncLedger(ctx context.Context, c Connection, subscriptionID SubscriptionID) error {
+	if s.ledger == nil {
+		return nil
+	}
+	return s.ledger.Sync(ctx, c, subscriptionID)
+}
+
+// auditSnapshot loads the members of a subscription about to be recorded in
+// the audit log, so that undoing the change restores them too.
+func (s *SubscriptionService) auditSnapshot(ctx context.Context, c Connection, subscription Subscription) (Subscription, error) {
+	if s.audit == nil {
+		return subscription, nil
+	}
+	snapshot, err := attachMembers(ctx, c, s.subscriptionRepo, []Subscription{subscription})
+	if err != nil {
+		return subscription, err
+	}
+	return snapshot[0], nil
+}
+
+// auditChange records a write to an existing subscription, reading it back to
+// snapshot its state after the write. The snapshot before it must be taken
+// with auditSnapshot.
+func (s *SubscriptionService) auditChange(ctx context.Context, c Connection, operation string, before Subscription) error {
+	if s.audit == nil {
+		return nil
 	}
-	for _, curCost := range totalCosts {
-		totalCost += curCost
+	after, err := s.subscriptionRepo.GetByID(ctx, c, before.ID)
+	if err != nil {
+		return err
+	}
+	if after, err = s.auditSnapshot(ctx, c, after); err != nil {
+		return err
+	}
+	return s.recordAudit(ctx, c, operation, &before, &after)
+}
+
+func (s *SubscriptionService) recordAudit(
+	ctx context.Context,
+	c Connection,
+	operation string,
+	before *Subscription,
+	after *Subscription,
+) error {
+	if s.audit == nil {
+		return nil
 	}
-	return totalCost, nil
+	entry, err := subscriptionAuditEntry(ctx, operation, before, after)
+	if err != nil {
+		return err
+	}
+	return s.audit.Record(ctx, c, entry)
+}
+
+func (s *SubscriptionService) publishBreaches(ctx context.Context, breaches []BudgetBreach) {
+	if s.budgets != nil && len(breaches) > 0 {
+		s.budgets.Publish(ctx, breaches)
+	}
+}
+
+// normalizeKind defaults the kind to recurring. A one-time purchase is billed
+// only in its purchase month, so it cannot have a term or renew.
+func normalizeKind(subscription Subscription) (Subscription, error) {
+	switch subscription.Kind {
+	case "":
+		subscription.Kind = SubscriptionRecurring
+	case SubscriptionRecurring:
+	case SubscriptionOneTime:
+		if subscription.TermMonths != nil || subscription.AutoRenew {
+			return subscription, errors.New("one-time purchase cannot have a term or renew")
+		}
+		subscription.StartDate = BillingPeriod(subscription.StartDate)
+		subscription.EndDate = pointer.Ref(subscription.StartDate)
+	default:
+		return subscription, errors.New("unknown subscription kind " + string(subscription.Kind))
+	}
+	return subscription, nil
+}
+
+// TermEnd returns the last billed month of a term of the given length.
+func TermEnd(start time.Time, termMonths int) time.Time {
+	return BillingPeriod(start).AddDate(0, termMonths-1, 0)
+}
+
+// BillingPeriod returns the first day of the month containing t, which is how
+// subscription dates are stored.
+func BillingPeriod(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
 }
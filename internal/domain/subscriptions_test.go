@@ -87,14 +87,563 @@ func TestServicePVZ_Create(t *testing.T) {
 			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
 
 			repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+			repoCatalog := mocks.NewMockCatalogRepository(t)
+			repoCatalog.EXPECT().
+				FindByNameOrAlias(mock.Anything, mock.Anything, validSubscription.Name).
+				Return(nil, nil).
+				Once()
+			repoCatalog.EXPECT().
+				Search(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(nil, nil).
+				Once()
 
 			if test.prepareMocks != nil {
 				test.prepareMocks(repoSunbscriptions)
 			}
-			err := domain.NewSubscriptionService(provider, repoSunbscriptions).
+			_, err := domain.NewSubscriptionService(provider, repoSunbscriptions, repoCatalog).
 				Create(t.Context(), validSubscription)
 
 			test.check(t, err)
 		})
 	}
 }
+
+func TestServicePVZ_Cancel(t *testing.T) {
+	t.Parallel()
+
+	latest := domain.Subscription{
+		Name:      "service_name",
+		Cost:      100,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   pointer.Ref(time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		name         string
+		cancellation domain.Cancellation
+		prepareMocks func(*mocks.MockSubscriptionsRepository, *mocks.MockCatalogRepository)
+		check        func(*testing.T, error)
+	}{
+		{
+			name: "Success",
+			cancellation: domain.Cancellation{
+				UserID:  latest.UserID,
+				Name:    latest.Name,
+				EndDate: pointer.Ref(time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC)),
+				Code:    domain.CancellationTooExpensive,
+				Reason:  "too expensive",
+			},
+			prepareMocks: func(repo *mocks.MockSubscriptionsRepository, _ *mocks.MockCatalogRepository) {
+				repo.EXPECT().
+					GetLatestByName(mock.Anything, mock.Anything, latest.UserID, latest.Name).
+					Return(latest, nil).
+					Once()
+				repo.EXPECT().
+					Cancel(
+						mock.Anything,
+						mock.Anything,
+						latest.UserID,
+						latest.Name,
+						time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+						domain.CancellationTooExpensive,
+						"too expensive",
+					).
+					Return(nil).
+					Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Alias",
+			cancellation: domain.Cancellation{
+				UserID:  latest.UserID,
+				Name:    "Service alias",
+				EndDate: pointer.Ref(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			prepareMocks: func(repo *mocks.MockSubscriptionsRepository, catalog *mocks.MockCatalogRepository) {
+				repo.EXPECT().
+					GetLatestByName(mock.Anything, mock.Anything, latest.UserID, domain.ServiceName("Service alias")).
+					Return(domain.Subscription{}, domain.ErrNotFound).
+					Once()
+				catalog.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, domain.ServiceName("Service alias")).
+					Return([]domain.CatalogEntry{{ID: uuid.New(), Name: latest.Name}}, nil).
+					Once()
+				repo.EXPECT().
+					GetLatestByName(mock.Anything, mock.Anything, latest.UserID, latest.Name).
+					Return(latest, nil).
+					Once()
+				repo.EXPECT().
+					Cancel(
+						mock.Anything,
+						mock.Anything,
+						latest.UserID,
+						latest.Name,
+						time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+						domain.CancellationOther,
+						"",
+					).
+					Return(nil).
+					Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Unknown reason code",
+			cancellation: domain.Cancellation{
+				UserID: latest.UserID,
+				Name:   latest.Name,
+				Code:   "bored",
+			},
+			prepareMocks: func(*mocks.MockSubscriptionsRepository, *mocks.MockCatalogRepository) {},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceCancelSubscription)
+				require.ErrorContains(t, err, "unknown cancellation code bored")
+			},
+		},
+		{
+			name: "Period before start",
+			cancellation: domain.Cancellation{
+				UserID:  latest.UserID,
+				Name:    latest.Name,
+				EndDate: pointer.Ref(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			prepareMocks: func(repo *mocks.MockSubscriptionsRepository, _ *mocks.MockCatalogRepository) {
+				repo.EXPECT().
+					GetLatestByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(latest, nil).
+					Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceCancelSubscription)
+				require.ErrorContains(t, err, "before subscription start")
+			},
+		},
+		{
+			name: "Period after end",
+			cancellation: domain.Cancellation{
+				UserID:  latest.UserID,
+				Name:    latest.Name,
+				EndDate: pointer.Ref(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			prepareMocks: func(repo *mocks.MockSubscriptionsRepository, _ *mocks.MockCatalogRepository) {
+				repo.EXPECT().
+					GetLatestByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(latest, nil).
+					Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceCancelSubscription)
+				require.ErrorContains(t, err, "ends before cancellation period")
+			},
+		},
+		{
+			name: "DB get latest Error",
+			cancellation: domain.Cancellation{
+				UserID: latest.UserID,
+				Name:   latest.Name,
+			},
+			prepareMocks: func(repo *mocks.MockSubscriptionsRepository, _ *mocks.MockCatalogRepository) {
+				repo.EXPECT().
+					GetLatestByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.Subscription{}, errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceCancelSubscription)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+
+			repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+			repoCatalog := mocks.NewMockCatalogRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(repoSunbscriptions, repoCatalog)
+			}
+			err := domain.NewSubscriptionService(provider, repoSunbscriptions, repoCatalog).
+				Cancel(t.Context(), test.cancellation)
+
+			test.check(t, err)
+		})
+	}
+}
+
+func TestServicePVZ_Restore(t *testing.T) {
+	t.Parallel()
+
+	deleted := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "service_name",
+		Cost:      100,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		DeletedAt: pointer.Ref(time.Now()),
+	}
+	active := deleted
+	active.DeletedAt = nil
+
+	tests := []struct {
+		name         string
+		prepareMocks func(*mocks.MockSubscriptionsRepository)
+		check        func(*testing.T, error)
+	}{
+		{
+			name: "Success",
+			prepareMocks: func(repo *mocks.MockSubscriptionsRepository) {
+				repo.EXPECT().GetByID(mock.Anything, mock.Anything, deleted.ID).
+					Return(deleted, nil).Once()
+				repo.EXPECT().CountOverlapping(mock.Anything, mock.Anything, deleted).
+					Return(0, nil).Once()
+				repo.EXPECT().Restore(mock.Anything, mock.Anything, deleted.ID).
+					Return(nil).Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not deleted",
+			prepareMocks: func(repo *mocks.MockSubscriptionsRepository) {
+				repo.EXPECT().GetByID(mock.Anything, mock.Anything, deleted.ID).
+					Return(active, nil).Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceRestoreSubscription)
+				require.ErrorContains(t, err, "not deleted")
+			},
+		},
+		{
+			name: "Overlaps active subscription",
+			prepareMocks: func(repo *mocks.MockSubscriptionsRepository) {
+				repo.EXPECT().GetByID(mock.Anything, mock.Anything, deleted.ID).
+					Return(deleted, nil).Once()
+				repo.EXPECT().CountOverlapping(mock.Anything, mock.Anything, deleted).
+					Return(1, nil).Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceRestoreSubscription)
+				require.ErrorContains(t, err, "overlaps")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+
+			repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(repoSunbscriptions)
+			}
+			err := domain.NewSubscriptionService(provider, repoSunbscriptions, mocks.NewMockCatalogRepository(t)).
+				Restore(t.Context(), deleted.ID)
+
+			test.check(t, err)
+		})
+	}
+}
+
+func TestServicePVZ_ProcessRenewals(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.August, 17, 12, 0, 0, 0, time.UTC)
+	period := time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)
+	renewed := []domain.SubscriptionID{uuid.New(), uuid.New()}
+
+	tests := []struct {
+		name         string
+		prepareMocks func(*mocks.MockSubscriptionsRepository, *mocks.MockChargeLedger)
+		check        func(*testing.T, domain.RenewalResult, error)
+	}{
+		{
+			name: "Success",
+			prepareMocks: func(repo *mocks.MockSubscriptionsRepository, ledger *mocks.MockChargeLedger) {
+				repo.EXPECT().RenewDue(mock.Anything, mock.Anything, period).
+					Return(renewed, nil).Once()
+				for _, id := range renewed {
+					ledger.EXPECT().Sync(mock.Anything, mock.Anything, id).Return(nil).Once()
+				}
+				repo.EXPECT().ExpireDue(mock.Anything, mock.Anything, period, now).
+					Return(1, nil).Once()
+			},
+			check: func(t *testing.T, result domain.RenewalResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, domain.RenewalResult{Renewed: 2, Expired: 1}, result)
+			},
+		},
+		{
+			name: "DB renew Error",
+			prepareMocks: func(repo *mocks.MockSubscriptionsRepository, _ *mocks.MockChargeLedger) {
+				repo.EXPECT().RenewDue(mock.Anything, mock.Anything, period).
+					Return(nil, errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, result domain.RenewalResult, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceProcessRenewals)
+				require.ErrorContains(t, err, "some error")
+				require.Zero(t, result)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+
+			repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+			ledger := mocks.NewMockChargeLedger(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(repoSunbscriptions, ledger)
+			}
+			result, err := domain.NewSubscriptionService(
+				provider,
+				repoSunbscriptions,
+				mocks.NewMockCatalogRepository(t),
+				domain.WithChargeLedger(ledger),
+			).ProcessRenewals(t.Context(), now)
+
+			test.check(t, result, err)
+		})
+	}
+}
+
+func TestTermEnd(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	require.Equal(t, time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC), domain.TermEnd(start, 12))
+	require.Equal(t, start, domain.TermEnd(start, 1))
+}
+
+func TestServicePVZ_CreateResolvesCatalogName(t *testing.T) {
+	t.Parallel()
+
+	entry := domain.CatalogEntry{ID: uuid.New(), Name: "Yandex Plus"}
+	subscription := domain.Subscription{
+		Name:      "яндекс плюс",
+		Cost:      400,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoCatalog := mocks.NewMockCatalogRepository(t)
+
+	repoCatalog.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, subscription.Name).
+		Return([]domain.CatalogEntry{entry}, nil).Once()
+	repoSunbscriptions.EXPECT().
+		GetLatestSubscriptionDate(mock.Anything, mock.Anything, subscription.UserID, entry.Name).
+		Return(nil, nil).Once()
+	repoSunbscriptions.EXPECT().
+		Create(mock.Anything, mock.Anything, mock.MatchedBy(func(created domain.Subscription) bool {
+			return created.Name == entry.Name && *created.ServiceID == entry.ID
+		})).
+		Return(nil).Once()
+
+	suggestions, err := domain.NewSubscriptionService(provider, repoSunbscriptions, repoCatalog).
+		Create(t.Context(), subscription)
+
+	require.NoError(t, err)
+	require.Empty(t, suggestions)
+}
+
+func TestServicePVZ_CreateSuggestsCatalogNames(t *testing.T) {
+	t.Parallel()
+
+	yandexPlus := domain.CatalogMatch{
+		CatalogEntry: domain.CatalogEntry{ID: uuid.New(), Name: "Yandex Plus"},
+		Score:        0.7,
+	}
+	yandexMusic := domain.CatalogMatch{
+		CatalogEntry: domain.CatalogEntry{ID: uuid.New(), Name: "Yandex Music"},
+		Score:        0.4,
+	}
+	subscription := domain.Subscription{
+		Name:      "Яндекс Плю",
+		Cost:      400,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name             string
+		autoMapThreshold float64
+		matches          []domain.CatalogMatch
+		expectedName     domain.ServiceName
+		expectedSuggest  []domain.CatalogMatch
+	}{
+		{
+			name:            "Suggestions without auto map",
+			matches:         []domain.CatalogMatch{yandexPlus, yandexMusic},
+			expectedName:    subscription.Name,
+			expectedSuggest: []domain.CatalogMatch{yandexPlus, yandexMusic},
+		},
+		{
+			name:             "Auto map single confident match",
+			autoMapThreshold: 0.6,
+			matches:          []domain.CatalogMatch{yandexPlus, yandexMusic},
+			expectedName:     yandexPlus.Name,
+		},
+		{
+			name:             "No auto map for ambiguous matches",
+			autoMapThreshold: 0.3,
+			matches:          []domain.CatalogMatch{yandexPlus, yandexMusic},
+			expectedName:     subscription.Name,
+			expectedSuggest:  []domain.CatalogMatch{yandexPlus, yandexMusic},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+			repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+			repoCatalog := mocks.NewMockCatalogRepository(t)
+
+			repoCatalog.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, subscription.Name).
+				Return(nil, nil).Once()
+			repoCatalog.EXPECT().
+				Search(
+					mock.Anything,
+					mock.Anything,
+					[]string{"яндекс плю", "yandeks plyu"},
+					domain.SuggestionThreshold,
+					mock.Anything,
+				).
+				Return(test.matches, nil).Once()
+			repoSunbscriptions.EXPECT().
+				GetLatestSubscriptionDate(mock.Anything, mock.Anything, subscription.UserID, test.expectedName).
+				Return(nil, nil).Once()
+			repoSunbscriptions.EXPECT().
+				Create(mock.Anything, mock.Anything, mock.MatchedBy(func(created domain.Subscription) bool {
+					return created.Name == test.expectedName
+				})).
+				Return(nil).Once()
+
+			suggestions, err := domain.NewSubscriptionService(
+				provider,
+				repoSunbscriptions,
+				repoCatalog,
+				domain.WithAutoMapThreshold(test.autoMapThreshold),
+			).Create(t.Context(), subscription)
+
+			require.NoError(t, err)
+			require.Equal(t, test.expectedSuggest, suggestions)
+		})
+	}
+}
+
+func TestServicePVZ_UpdateFindsSubscriptionByAlias(t *testing.T) {
+	t.Parallel()
+
+	yandexPlus := domain.CatalogEntry{ID: uuid.New(), Name: "Yandex Plus", Aliases: []string{"Яндекс Плюс"}}
+	latest := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      yandexPlus.Name,
+		Cost:      400,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+	}
+	update := latest
+	update.Name = "Яндекс Плюс"
+	update.Cost = 500
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoCatalog := mocks.NewMockCatalogRepository(t)
+
+	repoSunbscriptions.EXPECT().GetLatestByName(mock.Anything, mock.Anything, latest.UserID, update.Name).
+		Return(domain.Subscription{}, domain.ErrNotFound).Once()
+	repoCatalog.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, update.Name).
+		Return([]domain.CatalogEntry{yandexPlus}, nil).Once()
+	repoSunbscriptions.EXPECT().GetLatestByName(mock.Anything, mock.Anything, latest.UserID, latest.Name).
+		Return(latest, nil).Once()
+	repoSunbscriptions.EXPECT().
+		Update(mock.Anything, mock.Anything, mock.MatchedBy(func(updated domain.Subscription) bool {
+			return updated.Name == latest.Name && updated.Cost == 500
+		})).
+		Return(nil).Once()
+	repoSunbscriptions.EXPECT().ReplaceMembers(mock.Anything, mock.Anything, latest.ID, mock.Anything).
+		Return(nil).Once()
+
+	err := domain.NewSubscriptionService(
+		provider,
+		repoSunbscriptions,
+		repoCatalog,
+		domain.WithAutoMapThreshold(0.6),
+	).Update(t.Context(), update)
+
+	require.NoError(t, err)
+}
+
+func TestServicePVZ_DeleteDoesNotGuessName(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoCatalog := mocks.NewMockCatalogRepository(t)
+
+	repoSunbscriptions.EXPECT().GetLatestByName(mock.Anything, mock.Anything, userID, "Яндекс Плю").
+		Return(domain.Subscription{}, domain.ErrNotFound).Once()
+	repoCatalog.EXPECT().FindByNameOrAlias(mock.Anything, mock.Anything, "Яндекс Плю").
+		Return(nil, nil).Once()
+
+	err := domain.NewSubscriptionService(
+		provider,
+		repoSunbscriptions,
+		repoCatalog,
+		domain.WithAutoMapThreshold(0.6),
+	).Delete(t.Context(), userID, "Яндекс Плю")
+
+	require.ErrorIs(t, err, domain.ErrServiceDeleteSubscription)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestServicePVZ_UpdateTermFromStoredStart(t *testing.T) {
+	t.Parallel()
+
+	latest := domain.Subscription{
+		ID:        uuid.New(),
+		Name:      "Netflix",
+		Cost:      700,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+	}
+	update := latest
+	update.StartDate = time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+	update.TermMonths = pointer.Ref(12)
+
+	provider := database.NewDummyProvider(mocks.NewMockConnection(t))
+	repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+	repoSunbscriptions.EXPECT().GetLatestByName(mock.Anything, mock.Anything, latest.UserID, latest.Name).
+		Return(latest, nil).Once()
+	repoSunbscriptions.EXPECT().
+		Update(mock.Anything, mock.Anything, mock.MatchedBy(func(updated domain.Subscription) bool {
+			return updated.EndDate != nil && updated.EndDate.Equal(time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC))
+		})).
+		Return(nil).Once()
+	repoSunbscriptions.EXPECT().ReplaceMembers(mock.Anything, mock.Anything, latest.ID, mock.Anything).
+		Return(nil).Once()
+
+	err := domain.NewSubscriptionService(provider, repoSunbscriptions, mocks.NewMockCatalogRepository(t)).
+		Update(t.Context(), update)
+
+	require.NoError(t, err)
+}
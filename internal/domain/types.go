@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"time"
 
@@ -9,15 +10,441 @@ import (
 )
 
 type (
-	UserID      = uuid.UUID
-	ServiceName = string
+	UserID         = uuid.UUID
+	SubscriptionID = uuid.UUID
+	ServiceName    = string
+	CatalogEntryID = uuid.UUID
+	BudgetID       = uuid.UUID
+	DiscountID     = uuid.UUID
+
+	SubscriptionKind string
+
+	CancellationCode string
 
 	Subscription struct {
-		Name      ServiceName `db:"service_name"`
-		Cost      int         `db:"month_cost"`
-		UserID    UserID      `db:"user_id"`
-		StartDate time.Time   `db:"subs_start_date"`
-		EndDate   *time.Time  `db:"subs_end_date"`
+		ID        SubscriptionID   `db:"subscription_id"`
+		Kind      SubscriptionKind `db:"kind"`
+		Name      ServiceName      `db:"service_name"`
+		Cost      int              `db:"month_cost"`
+		UserID    UserID           `db:"user_id"`
+		StartDate time.Time        `db:"subs_start_date"`
+		EndDate   *time.Time       `db:"subs_end_date"`
+		ServiceID *CatalogEntryID  `db:"service_id"`
+
+		Category *string  `db:"category"`
+		Tags     []string `db:"tags"`
+
+		SplitRule *SplitRule           `db:"split_rule"`
+		Members   []SubscriptionMember `db:"-"`
+		Discounts []Discount           `db:"-"`
+		Actuals   []ActualCharge       `db:"-"`
+
+		AutoRenew  bool       `db:"auto_renew"`
+		TermMonths *int       `db:"term_months"`
+		ExpiredAt  *time.Time `db:"expired_at"`
+
+		CancellationCode   *CancellationCode `db:"cancellation_code"`
+		CancellationReason *string           `db:"cancellation_reason"`
+		DeletedAt          *time.Time        `db:"deleted_at"`
+	}
+
+	AnomalyType string
+
+	// Anomaly is a subscription record breaking a rule the application now
+	// enforces. ConflictID is the other record of a duplicate or overlap.
+	Anomaly struct {
+		Type AnomalyType `db:"anomaly"`
+		Subscription
+		ConflictID *SubscriptionID `db:"conflict_id"`
+	}
+
+	// QualityFix is the outcome of fixing anomalies: how many records each
+	// fix changed and the anomalies left for manual review. A dry run rolls
+	// the fixes back.
+	QualityFix struct {
+		Fixed     map[AnomalyType]int
+		Remaining []Anomaly
+		DryRun    bool
+	}
+
+	// SubscriptionVersion is a subscription row as it was from ValidFrom until
+	// ValidTo, or until now when ValidTo is nil.
+	SubscriptionVersion struct {
+		Subscription
+		ValidFrom time.Time  `db:"valid_from"`
+		ValidTo   *time.Time `db:"valid_to"`
+	}
+
+	SplitRule string
+
+	// SubscriptionMember is a user sharing a subscription paid by its owner.
+	// Share is a percentage or an amount depending on the split rule.
+	SubscriptionMember struct {
+		SubscriptionID SubscriptionID `db:"subscription_id"`
+		UserID         UserID         `db:"user_id"`
+		Share          *int           `db:"share"`
+	}
+
+	DiscountKind string
+
+	// Discount lowers the price of a subscription for the months from
+	// StartDate to EndDate inclusive, or for Months months when EndDate is
+	// not given.
+	Discount struct {
+		ID             DiscountID     `db:"discount_id"`
+		SubscriptionID SubscriptionID `db:"subscription_id"`
+		Kind           DiscountKind   `db:"kind"`
+		Value          int            `db:"value"`
+		StartDate      time.Time      `db:"start_date"`
+		EndDate        *time.Time     `db:"end_date"`
+		Months         *int           `db:"-"`
+	}
+
+	// ActualCharge is the amount actually billed for a subscription in a month.
+	// It replaces the nominal monthly cost for that month.
+	ActualCharge struct {
+		SubscriptionID SubscriptionID `db:"subscription_id"`
+		Month          time.Time      `db:"month"`
+		Amount         int            `db:"amount"`
+	}
+
+	MemberDebt struct {
+		UserID         UserID
+		SubscriptionID SubscriptionID
+		Name           ServiceName
+		Amount         int
+	}
+
+	CatalogEntry struct {
+		ID           CatalogEntryID `db:"service_id"`
+		Name         ServiceName    `db:"name"`
+		Aliases      []string       `db:"aliases"`
+		Category     *string        `db:"category"`
+		DefaultPrice *int           `db:"default_price"`
+		Website      *string        `db:"website"`
+	}
+
+	CatalogMatch struct {
+		CatalogEntry
+		Score float64 `db:"score"`
+	}
+
+	ServiceConflict struct {
+		UserID   UserID         `db:"user_id"`
+		SourceID SubscriptionID `db:"source_id"`
+		TargetID SubscriptionID `db:"target_id"`
+	}
+
+	ServiceSubscribers struct {
+		Name        ServiceName `db:"service_name"`
+		Subscribers int         `db:"subscribers"`
+	}
+
+	ServiceRevenue struct {
+		Name    ServiceName `db:"service_name"`
+		Month   time.Time   `db:"month"`
+		Revenue int         `db:"revenue"`
+	}
+
+	ServicePrice struct {
+		Name          ServiceName `db:"service_name"`
+		Subscriptions int         `db:"subscriptions"`
+		Average       float64     `db:"average"`
+		Median        float64     `db:"median"`
+	}
+
+	// SubscriptionFlow counts the subscriptions started and the ones billed
+	// for the last time in a month.
+	SubscriptionFlow struct {
+		Month   time.Time `db:"month"`
+		New     int       `db:"new_subscriptions"`
+		Churned int       `db:"churned"`
+	}
+
+	// ServiceChurn describes the subscriptions of a service that ended in a
+	// month: how many were billed in it, how many of them left, after how
+	// many months on average and why.
+	ServiceChurn struct {
+		Name            ServiceName              `db:"service_name"`
+		Month           time.Time                `db:"month"`
+		Active          int                      `db:"active"`
+		Churned         int                      `db:"churned"`
+		AverageLifetime float64                  `db:"average_lifetime"`
+		Rate            float64                  `db:"-"`
+		Reasons         map[CancellationCode]int `db:"-"`
+	}
+
+	// CohortMonth counts the subscriptions of a cohort, the recurring
+	// subscriptions of a service started in the same month, still billed a
+	// number of months after it.
+	CohortMonth struct {
+		Name        ServiceName `db:"service_name"`
+		Month       time.Time   `db:"cohort_month"`
+		MonthsLater int         `db:"months_later"`
+		Size        int         `db:"size"`
+		Retained    int         `db:"retained"`
+	}
+
+	// Cohort is a row of the retention matrix: Retained[n] subscriptions of
+	// the cohort were still billed n months after it started, a Retention[n]
+	// fraction of its size.
+	Cohort struct {
+		Name      ServiceName
+		Month     time.Time
+		Size      int
+		Retained  []int
+		Retention []float64
+	}
+
+	PriceAnomalyKind string
+
+	// PriceAnomaly is a price far above what it is compared with. For a
+	// subscription Price is its monthly cost and Reference the catalog price
+	// or the median other users pay; for a median jump Price is the median of
+	// the service in Month and Reference its median the month before.
+	PriceAnomaly struct {
+		Kind           PriceAnomalyKind `db:"kind"`
+		Name           ServiceName      `db:"service_name"`
+		Month          time.Time        `db:"month"`
+		SubscriptionID *SubscriptionID  `db:"subscription_id"`
+		UserID         *UserID          `db:"user_id"`
+		Price          float64          `db:"price"`
+		Reference      float64          `db:"reference"`
+		Ratio          float64          `db:"ratio"`
+	}
+
+	// ChurnReason counts the churned subscriptions of a service in a month
+	// cancelled for one reason.
+	ChurnReason struct {
+		Name  ServiceName      `db:"service_name"`
+		Month time.Time        `db:"month"`
+		Code  CancellationCode `db:"cancellation_code"`
+		Count int              `db:"churned"`
+	}
+
+	ServiceRewrite struct {
+		Updated   int64
+		Conflicts []ServiceConflict
+	}
+
+	TransferStrategy string
+
+	// UserTransfer moves everything of the Source user to the Target one.
+	UserTransfer struct {
+		Source   UserID
+		Target   UserID
+		Strategy TransferStrategy
+	}
+
+	// TransferResult reports the subscriptions moved to the target user, the
+	// ones moved to the trash to resolve overlaps and, when the transfer is
+	// refused, the overlaps it would create.
+	TransferResult struct {
+		Moved     int64
+		Trashed   []SubscriptionID
+		Conflicts []ServiceConflict
+	}
+
+	AuditEntry struct {
+		ID             uuid.UUID       `db:"audit_id"`
+		Actor          string          `db:"actor"`
+		RequestID      string          `db:"request_id"`
+		Operation      string          `db:"operation"`
+		SubscriptionID *SubscriptionID `db:"subscription_id"`
+		UserID         *UserID         `db:"user_id"`
+		Before         json.RawMessage `db:"before"`
+		After          json.RawMessage `db:"after"`
+		Details        json.RawMessage `db:"details"`
+		CreatedAt      time.Time       `db:"created_at"`
+	}
+
+	// AuditFilter narrows an audit log search. Nil fields match everything.
+	AuditFilter struct {
+		Actor          *string
+		Operation      *string
+		UserID         *UserID
+		SubscriptionID *SubscriptionID
+		From           *time.Time
+		To             *time.Time
+		Limit          int
+		Offset         int
+	}
+
+	RenewalResult struct {
+		Renewed int64
+		Expired int64
+	}
+
+	GroupBy string
+
+	CostQuery struct {
+		UserID  *UserID
+		Name    *ServiceName
+		Start   time.Time
+		End     time.Time
+		GroupBy GroupBy
+	}
+
+	ChargeKind string
+
+	// Charge is what UserID owes for a subscription in a month. For shared
+	// subscriptions every member gets their own charge and PayerID pays them.
+	Charge struct {
+		SubscriptionID SubscriptionID `db:"subscription_id"`
+		UserID         UserID         `db:"user_id"`
+		PayerID        UserID         `db:"payer_id"`
+		Kind           ChargeKind     `db:"kind"`
+		DiscountID     *DiscountID    `db:"discount_id"`
+		Actual         bool           `db:"actual"`
+		OneTime        bool           `db:"one_time"`
+		Name           ServiceName    `db:"service_name"`
+		Category       *string        `db:"category"`
+		Tags           []string       `db:"tags"`
+		Month          time.Time      `db:"month"`
+		Amount         int            `db:"amount"`
+	}
+
+	GroupTotal struct {
+		Group string
+		Total int
+	}
+
+	CostReport struct {
+		Total  int
+		Groups []GroupTotal
+	}
+
+	SeriesPoint struct {
+		Month time.Time
+		Group string
+		Total int
+	}
+
+	Period struct {
+		Start time.Time
+		End   time.Time
+	}
+
+	ComparisonQuery struct {
+		UserID  *UserID
+		Name    *ServiceName
+		Base    Period
+		Compare Period
+	}
+
+	// SubscriptionChange is a service a user pays for whose presence or price
+	// differs between the compared periods. Costs are the list prices of the
+	// latest subscription billed in each period, nil when it is not billed.
+	SubscriptionChange struct {
+		UserID       UserID
+		Name         ServiceName
+		BaseCost     *int
+		CompareCost  *int
+		BaseTotal    int
+		CompareTotal int
+	}
+
+	PeriodComparison struct {
+		BaseTotal     int
+		CompareTotal  int
+		Delta         int
+		PercentChange *float64
+		Added         []SubscriptionChange
+		Removed       []SubscriptionChange
+		Repriced      []SubscriptionChange
+	}
+
+	// StatementLine is what a user owes for one subscription in a month.
+	StatementLine struct {
+		SubscriptionID SubscriptionID
+		Name           ServiceName
+		Category       *string
+		PayerID        UserID
+		SplitRule      *SplitRule
+		Cost           int
+		Share          int
+		Discount       int
+		Amount         int
+		Actual         bool
+		OneTime        bool
+	}
+
+	Statement struct {
+		UserID        UserID
+		Month         time.Time
+		Lines         []StatementLine
+		Categories    []GroupTotal
+		Total         int
+		PreviousTotal int
+	}
+
+	// ActiveSet is everything a user pays for or shares in a month, with the
+	// total of their own charges.
+	ActiveSet struct {
+		UserID        UserID
+		Month         time.Time
+		Subscriptions []Subscription
+		Total         int
+	}
+
+	BudgetScope string
+
+	Budget struct {
+		ID         BudgetID    `db:"budget_id"`
+		UserID     UserID      `db:"user_id"`
+		Scope      BudgetScope `db:"scope"`
+		Target     *string     `db:"target"`
+		Limit      int         `db:"monthly_limit"`
+		Thresholds []int       `db:"thresholds"`
+	}
+
+	BudgetStatus struct {
+		Budget              Budget
+		Spent               int
+		Forecast            int
+		Utilisation         float64
+		ForecastUtilisation float64
+	}
+
+	BudgetSnapshot struct {
+		UserID  UserID
+		Month   time.Time
+		Budgets []Budget
+		Spent   map[BudgetID]int
+	}
+
+	BudgetBreach struct {
+		Budget    Budget
+		Month     time.Time
+		Threshold int
+		Spent     int
+	}
+
+	// LedgerRebuild selects the part of the charges ledger to rebuild: the
+	// months of [Start, End] of the subscriptions paid by UserID, or of all
+	// subscriptions for nil.
+	LedgerRebuild struct {
+		UserID *UserID
+		Start  time.Time
+		End    time.Time
+	}
+
+	// ChargeQuery selects charges of the months of [Start, End], optionally
+	// narrowed to the user owing them, the user paying them and a service.
+	ChargeQuery struct {
+		UserID  *UserID
+		PayerID *UserID
+		Name    *ServiceName
+		Start   time.Time
+		End     time.Time
+	}
+
+	Cancellation struct {
+		UserID  UserID
+		Name    ServiceName
+		EndDate *time.Time
+		Code    CancellationCode
+		Reason  string
 	}
 
 	Connection interface {
@@ -32,17 +459,92 @@ type (
 	}
 
 	SubscriptionInterface interface {
-		Create(context.Context, Subscription) error
+		Create(context.Context, Subscription) ([]CatalogMatch, error)
 		Update(context.Context, Subscription) error
 		Delete(context.Context, UserID, ServiceName) error
+		Cancel(context.Context, Cancellation) error
 		GetLatest(context.Context, UserID) (Subscription, error)
 		ReadAllByUserID(context.Context, UserID) ([]Subscription, error)
-		TotalSubscriptionsCost(
-			context.Context,
-			UserID,
-			ServiceName,
-			time.Time,
-			*time.Time,
-		) (int, error)
+		ReadActiveAt(context.Context, UserID, time.Time) ([]Subscription, error)
+		Versions(context.Context, SubscriptionID) ([]SubscriptionVersion, error)
+		VersionAt(context.Context, SubscriptionID, time.Time) (SubscriptionVersion, error)
+		Undo(context.Context, UserID) (AuditEntry, error)
+		ActiveSet(context.Context, UserID, time.Time) (ActiveSet, error)
+		ReadTrash(context.Context, UserID) ([]Subscription, error)
+		Restore(context.Context, SubscriptionID) error
+		TotalSubscriptionsCost(context.Context, CostQuery) (CostReport, error)
+		SpendSeries(context.Context, CostQuery) ([]SeriesPoint, error)
+		Owed(context.Context, UserID, time.Time, time.Time) ([]MemberDebt, error)
+		Breakdown(context.Context, CostQuery) ([]Charge, error)
+		Statement(context.Context, UserID, time.Time) (Statement, error)
+		ComparePeriods(context.Context, ComparisonQuery) (PeriodComparison, error)
+		AddDiscount(context.Context, Discount) (Discount, error)
+		ListDiscounts(context.Context, SubscriptionID) ([]Discount, error)
+		DeleteDiscount(context.Context, SubscriptionID, DiscountID) error
+		SetActual(context.Context, ActualCharge) error
+		ListActuals(context.Context, SubscriptionID) ([]ActualCharge, error)
+		DeleteActual(context.Context, SubscriptionID, time.Time) error
+	}
+
+	CatalogInterface interface {
+		Create(context.Context, CatalogEntry) (CatalogEntry, error)
+		Get(context.Context, CatalogEntryID) (CatalogEntry, error)
+		List(context.Context) ([]CatalogEntry, error)
+		Update(context.Context, CatalogEntry) error
+		Delete(context.Context, CatalogEntryID) error
+		Search(context.Context, string, int) ([]CatalogMatch, error)
+	}
+
+	BudgetInterface interface {
+		Create(context.Context, Budget) (Budget, error)
+		List(context.Context, UserID) ([]Budget, error)
+		Delete(context.Context, UserID, BudgetID) error
+		Status(context.Context, UserID, time.Time) ([]BudgetStatus, error)
+	}
+
+	// BudgetEvaluator lets subscription writes report the budget thresholds
+	// they push a user's spend over.
+	BudgetEvaluator interface {
+		Snapshot(context.Context, Connection, UserID, time.Time) (BudgetSnapshot, error)
+		Breaches(context.Context, Connection, BudgetSnapshot) ([]BudgetBreach, error)
+		Publish(context.Context, []BudgetBreach)
+	}
+
+	QualityInterface interface {
+		Scan(context.Context) ([]Anomaly, error)
+		Fix(context.Context, bool) (QualityFix, error)
+	}
+
+	AuditInterface interface {
+		History(context.Context, SubscriptionID) ([]AuditEntry, error)
+		Search(context.Context, AuditFilter) ([]AuditEntry, error)
+	}
+
+	AdminInterface interface {
+		RenameService(context.Context, ServiceName, ServiceName) (ServiceRewrite, error)
+		MergeServices(context.Context, ServiceName, ServiceName) (ServiceRewrite, error)
+		TransferUser(context.Context, UserTransfer) (TransferResult, error)
+	}
+
+	AnalyticsInterface interface {
+		TopServices(context.Context, time.Time, int) ([]ServiceSubscribers, error)
+		Revenue(context.Context, Period) ([]ServiceRevenue, error)
+		Prices(context.Context) ([]ServicePrice, error)
+		Flow(context.Context, Period) ([]SubscriptionFlow, error)
+		Churn(context.Context, Period, *ServiceName) ([]ServiceChurn, error)
+		Retention(context.Context, Period, *ServiceName) ([]Cohort, error)
+		PriceInsights(context.Context, UserID, time.Time) ([]PriceAnomaly, error)
+		PriceAnomalies(context.Context, Period) ([]PriceAnomaly, error)
+	}
+
+	LedgerInterface interface {
+		Rebuild(context.Context, LedgerRebuild) (int, error)
+	}
+
+	// ChargeLedger lets subscription writes keep the charges ledger in sync
+	// and reports read their charges from it.
+	ChargeLedger interface {
+		Sync(context.Context, Connection, SubscriptionID) error
+		Charges(context.Context, Connection, ChargeQuery) ([]Charge, error)
 	}
 )
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"ef_project/internal/infra/log"
+	"ef_project/internal/infra/pointer"
+)
+
+const defaultUndoWindow = 24 * time.Hour
+
+var (
+	ErrServiceUndo = errors.Join(
+		errServiseSubscription,
+		errors.New("undo failed"),
+	)
+	ErrNothingToUndo = errors.New("no change to undo")
+	ErrUndoConflict  = errors.New("subscription changed after the change to undo")
+)
+
+// Undo reverts the user's most recent create, update, delete, cancellation or
+// restore recorded in the audit log within the undo window and returns its
+// record. Undoing again reverts the change before it. It refuses when the subscription no longer
+// looks as the change left it, or when bringing it back would overlap another
+// subscription of the same service.
+func (s *SubscriptionService) Undo(ctx context.Context, userID UserID) (AuditEntry, error) {
+	slog.DebugContext(ctx, "Service: undoing last change.", log.RequestID(ctx))
+	if s.audit == nil {
+		return AuditEntry{}, errors.Join(ErrServiceUndo, errors.New("audit log is disabled"))
+	}
+
+	var undone AuditEntry
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		entries, err := s.audit.ReadUndoable(ctx, c, userID, time.Now().Add(-s.undoWindow))
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 || entries[0].SubscriptionID == nil {
+			return ErrNothingToUndo
+		}
+		undone = entries[0]
+
+		var after Subscription
+		if err := json.Unmarshal(undone.After, &after); err != nil {
+			return err
+		}
+		current, err := s.subscriptionRepo.GetByID(ctx, c, *undone.SubscriptionID)
+		if err != nil {
+			return err
+		}
+		if current, err = s.auditSnapshot(ctx, c, current); err != nil {
+			return err
+		}
+		if !sameSubscription(current, after) {
+			return ErrUndoConflict
+		}
+
+		// A created subscription has no state before it, so undoing its
+		// creation moves it to the trash.
+		target := current
+		if undone.Operation == AuditOperationCreateSubscription {
+			target.DeletedAt = pointer.Ref(time.Now().UTC())
+		} else if err := json.Unmarshal(undone.Before, &target); err != nil {
+			return err
+		}
+
+		if target.DeletedAt == nil && target.Kind == SubscriptionRecurring {
+			overlapping, err := s.subscriptionRepo.CountOverlapping(ctx, c, target)
+			if err != nil {
+				return err
+			}
+			if overlapping > 0 {
+				return ErrUndoConflict
+			}
+		}
+
+		if err := s.subscriptionRepo.Revert(ctx, c, target); err != nil {
+			return err
+		}
+		if target.DeletedAt == nil {
+			if err := s.subscriptionRepo.ReplaceMembers(ctx, c, target.ID, target.Members); err != nil {
+				return err
+			}
+		}
+
+		entry, err := subscriptionAuditEntry(ctx, AuditOperationUndoSubscription, &current, &target)
+		if err != nil {
+			return err
+		}
+		if entry.Details, err = json.Marshal(map[string]any{
+			"auditId":   undone.ID,
+			"operation": undone.Operation,
+		}); err != nil {
+			return err
+		}
+		if err := s.audit.Record(ctx, c, entry); err != nil {
+			return err
+		}
+		return s.syncLedger(ctx, c, target.ID)
+	})
+	if err != nil {
+		return AuditEntry{}, errors.Join(ErrServiceUndo, err)
+	}
+	return undone, nil
+}
+
+// sameSubscription compares everything a subscription write can change.
+// Times are compared as instants, since snapshots do not keep the location
+// the database returned them in.
+func sameSubscription(a, b Subscription) bool {
+	return a.ID == b.ID &&
+		a.Kind == b.Kind &&
+		a.Name == b.Name &&
+		a.Cost == b.Cost &&
+		a.UserID == b.UserID &&
+		a.StartDate.Equal(b.StartDate) &&
+		equalTime(a.EndDate, b.EndDate) &&
+		equalRef(a.ServiceID, b.ServiceID) &&
+		equalRef(a.Category, b.Category) &&
+		slices.Equal(a.Tags, b.Tags) &&
+		equalRef(a.SplitRule, b.SplitRule) &&
+		a.AutoRenew == b.AutoRenew &&
+		equalRef(a.TermMonths, b.TermMonths) &&
+		equalTime(a.ExpiredAt, b.ExpiredAt) &&
+		equalRef(a.CancellationCode, b.CancellationCode) &&
+		equalRef(a.CancellationReason, b.CancellationReason) &&
+		equalTime(a.DeletedAt, b.DeletedAt) &&
+		sameMembers(a.Members, b.Members)
+}
+
+// sameMembers compares members regardless of the order they were read in.
+func sameMembers(a, b []SubscriptionMember) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	shares := make(map[UserID]*int, len(a))
+	for _, member := range a {
+		shares[member.UserID] = member.Share
+	}
+	for _, member := range b {
+		share, ok := shares[member.UserID]
+		if !ok || !equalRef(share, member.Share) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalRef[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,139 @@
+package domain_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_Undo(t *testing.T) {
+	t.Parallel()
+
+	before := domain.Subscription{
+		ID:        uuid.New(),
+		Kind:      domain.SubscriptionRecurring,
+		Name:      "Netflix",
+		Cost:      700,
+		UserID:    uuid.New(),
+		StartDate: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	deleted := before
+	deleted.DeletedAt = pointer.Ref(time.Date(2025, time.March, 3, 10, 0, 0, 0, time.UTC))
+
+	marshal := func(t *testing.T, subscription domain.Subscription) json.RawMessage {
+		t.Helper()
+		snapshot, err := json.Marshal(subscription)
+		require.NoError(t, err)
+		return snapshot
+	}
+	deletion := func(t *testing.T) domain.AuditEntry {
+		t.Helper()
+		return domain.AuditEntry{
+			ID:             uuid.New(),
+			Operation:      domain.AuditOperationDeleteSubscription,
+			SubscriptionID: &before.ID,
+			UserID:         &before.UserID,
+			Before:         marshal(t, before),
+			After:          marshal(t, deleted),
+		}
+	}
+
+	tests := []struct {
+		name         string
+		prepareMocks func(*testing.T, *mocks.MockSubscriptionsRepository, *mocks.MockAuditRepository)
+		check        func(*testing.T, domain.AuditEntry, error)
+	}{
+		{
+			name: "Restores deleted subscription",
+			prepareMocks: func(t *testing.T, repo *mocks.MockSubscriptionsRepository, audit *mocks.MockAuditRepository) {
+				audit.EXPECT().ReadUndoable(mock.Anything, mock.Anything, before.UserID, mock.Anything).
+					Return([]domain.AuditEntry{deletion(t)}, nil).Once()
+				// Read back from the database in another location.
+				current := deleted
+				current.DeletedAt = pointer.Ref(deleted.DeletedAt.In(time.FixedZone("MSK", 3*60*60)))
+				repo.EXPECT().GetByID(mock.Anything, mock.Anything, before.ID).Return(current, nil).Once()
+				repo.EXPECT().CountOverlapping(mock.Anything, mock.Anything, mock.Anything).Return(0, nil).Once()
+				repo.EXPECT().Revert(mock.Anything, mock.Anything, mock.Anything).
+					Run(func(_ context.Context, _ domain.Connection, target domain.Subscription) {
+						require.Nil(t, target.DeletedAt)
+						require.Equal(t, before.Cost, target.Cost)
+					}).
+					Return(nil).Once()
+				repo.EXPECT().ReplaceMembers(mock.Anything, mock.Anything, before.ID, mock.Anything).Return(nil).Once()
+				audit.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything).
+					Run(func(_ context.Context, _ domain.Connection, entry domain.AuditEntry) {
+						require.Equal(t, domain.AuditOperationUndoSubscription, entry.Operation)
+					}).
+					Return(nil).Once()
+			},
+			check: func(t *testing.T, undone domain.AuditEntry, err error) {
+				require.NoError(t, err)
+				require.Equal(t, domain.AuditOperationDeleteSubscription, undone.Operation)
+			},
+		},
+		{
+			name: "Changed since",
+			prepareMocks: func(t *testing.T, repo *mocks.MockSubscriptionsRepository, audit *mocks.MockAuditRepository) {
+				audit.EXPECT().ReadUndoable(mock.Anything, mock.Anything, before.UserID, mock.Anything).
+					Return([]domain.AuditEntry{deletion(t)}, nil).Once()
+				current := deleted
+				current.CancellationReason = pointer.Ref("too expensive")
+				repo.EXPECT().GetByID(mock.Anything, mock.Anything, before.ID).Return(current, nil).Once()
+			},
+			check: func(t *testing.T, _ domain.AuditEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrServiceUndo)
+				require.ErrorIs(t, err, domain.ErrUndoConflict)
+			},
+		},
+		{
+			name: "Overlaps another subscription",
+			prepareMocks: func(t *testing.T, repo *mocks.MockSubscriptionsRepository, audit *mocks.MockAuditRepository) {
+				audit.EXPECT().ReadUndoable(mock.Anything, mock.Anything, before.UserID, mock.Anything).
+					Return([]domain.AuditEntry{deletion(t)}, nil).Once()
+				repo.EXPECT().GetByID(mock.Anything, mock.Anything, before.ID).Return(deleted, nil).Once()
+				repo.EXPECT().CountOverlapping(mock.Anything, mock.Anything, mock.Anything).Return(1, nil).Once()
+			},
+			check: func(t *testing.T, _ domain.AuditEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrUndoConflict)
+			},
+		},
+		{
+			name: "Nothing to undo",
+			prepareMocks: func(_ *testing.T, _ *mocks.MockSubscriptionsRepository, audit *mocks.MockAuditRepository) {
+				audit.EXPECT().ReadUndoable(mock.Anything, mock.Anything, before.UserID, mock.Anything).
+					Return(nil, nil).Once()
+			},
+			check: func(t *testing.T, _ domain.AuditEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrNothingToUndo)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+			repoAudit := mocks.NewMockAuditRepository(t)
+			test.prepareMocks(t, repoSunbscriptions, repoAudit)
+
+			undone, err := domain.NewSubscriptionService(
+				database.NewDummyProvider(mocks.NewMockConnection(t)),
+				repoSunbscriptions,
+				mocks.NewMockCatalogRepository(t),
+				domain.WithAuditLog(repoAudit),
+			).Undo(t.Context(), before.UserID)
+
+			test.check(t, undone, err)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"ef_project/internal/infra/log"
+)
+
+var ErrServiceSubscriptionVersions = errors.Join(
+	errServiseSubscription,
+	errors.New("read versions failed"),
+)
+
+// Versions returns every version of the subscription kept by the database,
+// oldest first.
+func (s *SubscriptionService) Versions(ctx context.Context, subscriptionID SubscriptionID) ([]SubscriptionVersion, error) {
+	slog.DebugContext(ctx, "Service: reading subscription versions.", log.RequestID(ctx))
+	var versions []SubscriptionVersion
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		versions, dbErr = s.subscriptionRepo.ReadVersions(ctx, c, subscriptionID)
+		return dbErr
+	})
+	if err != nil {
+		return nil, errors.Join(ErrServiceSubscriptionVersions, err)
+	}
+	return versions, nil
+}
+
+// VersionAt reconstructs the subscription as it was at the moment. It fails
+// with ErrNotFound when the subscription did not exist then.
+func (s *SubscriptionService) VersionAt(
+	ctx context.Context,
+	subscriptionID SubscriptionID,
+	at time.Time,
+) (SubscriptionVersion, error) {
+	slog.DebugContext(ctx, "Service: reading subscription version.", log.RequestID(ctx))
+	var version SubscriptionVersion
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var dbErr error
+		version, dbErr = s.subscriptionRepo.GetVersionAt(ctx, c, subscriptionID, at)
+		return dbErr
+	})
+	if err != nil {
+		return version, errors.Join(ErrServiceSubscriptionVersions, err)
+	}
+	return version, nil
+}
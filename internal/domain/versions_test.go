@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/database"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePVZ_VersionAt(t *testing.T) {
+	t.Parallel()
+
+	subscriptionID := uuid.New()
+	at := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	version := domain.SubscriptionVersion{
+		Subscription: domain.Subscription{ID: subscriptionID, Name: "Netflix", Cost: 700},
+		ValidFrom:    at.Add(-time.Hour),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+		repoSunbscriptions.EXPECT().GetVersionAt(mock.Anything, mock.Anything, subscriptionID, at).
+			Return(version, nil).Once()
+
+		got, err := domain.NewSubscriptionService(
+			database.NewDummyProvider(mocks.NewMockConnection(t)),
+			repoSunbscriptions,
+			mocks.NewMockCatalogRepository(t),
+		).VersionAt(t.Context(), subscriptionID, at)
+
+		require.NoError(t, err)
+		require.Equal(t, version, got)
+	})
+
+	t.Run("Before First Version", func(t *testing.T) {
+		t.Parallel()
+
+		repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+		repoSunbscriptions.EXPECT().GetVersionAt(mock.Anything, mock.Anything, subscriptionID, at).
+			Return(domain.SubscriptionVersion{}, domain.ErrNotFound).Once()
+
+		_, err := domain.NewSubscriptionService(
+			database.NewDummyProvider(mocks.NewMockConnection(t)),
+			repoSunbscriptions,
+			mocks.NewMockCatalogRepository(t),
+		).VersionAt(t.Context(), subscriptionID, at)
+
+		require.ErrorIs(t, err, domain.ErrServiceSubscriptionVersions)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		t.Parallel()
+
+		repoSunbscriptions := mocks.NewMockSubscriptionsRepository(t)
+		repoSunbscriptions.EXPECT().GetVersionAt(mock.Anything, mock.Anything, subscriptionID, at).
+			Return(domain.SubscriptionVersion{}, errors.New("some error")).Once()
+
+		_, err := domain.NewSubscriptionService(
+			database.NewDummyProvider(mocks.NewMockConnection(t)),
+			repoSunbscriptions,
+			mocks.NewMockCatalogRepository(t),
+		).VersionAt(t.Context(), subscriptionID, at)
+
+		require.ErrorIs(t, err, domain.ErrServiceSubscriptionVersions)
+		require.ErrorContains(t, err, "some error")
+	})
+}
@@ -39,51 +39,41 @@ func (_m *MockSubscriptionsRepository) EXPECT() *MockSubscriptionsRepository_Exp
 	return &MockSubscriptionsRepository_Expecter{mock: &_m.Mock}
 }
 
-// AllMatchingSubscriptionsForPeriod provides a mock function for the type MockSubscriptionsRepository
-func (_mock *MockSubscriptionsRepository) AllMatchingSubscriptionsForPeriod(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName, time1 time.Time, time11 *time.Time) ([]int, error) {
-	ret := _mock.Called(context1, connection, v, v1, time1, time11)
+// Cancel provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) Cancel(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName, time1 time.Time, cancellationCode domain.CancellationCode, s string) error {
+	ret := _mock.Called(context1, connection, v, v1, time1, cancellationCode, s)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AllMatchingSubscriptionsForPeriod")
+		panic("no return value specified for Cancel")
 	}
 
-	var r0 []int
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName, time.Time, *time.Time) ([]int, error)); ok {
-		return returnFunc(context1, connection, v, v1, time1, time11)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName, time.Time, *time.Time) []int); ok {
-		r0 = returnFunc(context1, connection, v, v1, time1, time11)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]int)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName, time.Time, *time.Time) error); ok {
-		r1 = returnFunc(context1, connection, v, v1, time1, time11)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName, time.Time, domain.CancellationCode, string) error); ok {
+		r0 = returnFunc(context1, connection, v, v1, time1, cancellationCode, s)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockSubscriptionsRepository_AllMatchingSubscriptionsForPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllMatchingSubscriptionsForPeriod'
-type MockSubscriptionsRepository_AllMatchingSubscriptionsForPeriod_Call struct {
+// MockSubscriptionsRepository_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type MockSubscriptionsRepository_Cancel_Call struct {
 	*mock.Call
 }
 
-// AllMatchingSubscriptionsForPeriod is a helper method to define mock.On call
+// Cancel is a helper method to define mock.On call
 //   - context1 context.Context
 //   - connection domain.Connection
 //   - v domain.UserID
 //   - v1 domain.ServiceName
 //   - time1 time.Time
-//   - time11 *time.Time
-func (_e *MockSubscriptionsRepository_Expecter) AllMatchingSubscriptionsForPeriod(context1 interface{}, connection interface{}, v interface{}, v1 interface{}, time1 interface{}, time11 interface{}) *MockSubscriptionsRepository_AllMatchingSubscriptionsForPeriod_Call {
-	return &MockSubscriptionsRepository_AllMatchingSubscriptionsForPeriod_Call{Call: _e.mock.On("AllMatchingSubscriptionsForPeriod", context1, connection, v, v1, time1, time11)}
+//   - cancellationCode domain.CancellationCode
+//   - s string
+func (_e *MockSubscriptionsRepository_Expecter) Cancel(context1 interface{}, connection interface{}, v interface{}, v1 interface{}, time1 interface{}, cancellationCode interface{}, s interface{}) *MockSubscriptionsRepository_Cancel_Call {
+	return &MockSubscriptionsRepository_Cancel_Call{Call: _e.mock.On("Cancel", context1, connection, v, v1, time1, cancellationCode, s)}
 }
 
-func (_c *MockSubscriptionsRepository_AllMatchingSubscriptionsForPeriod_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName, time1 time.Time, time11 *time.Time)) *MockSubscriptionsRepository_AllMatchingSubscriptionsForPeriod_Call {
+func (_c *MockSubscriptionsRepository_Cancel_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName, time1 time.Time, cancellationCode domain.CancellationCode, s string)) *MockSubscriptionsRepository_Cancel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -105,9 +95,13 @@ func (_c *MockSubscriptionsRepository_AllMatchingSubscriptionsForPeriod_Call) Ru
 		if args[4] != nil {
 			arg4 = args[4].(time.Time)
 		}
-		var arg5 *time.Time
+		var arg5 domain.CancellationCode
 		if args[5] != nil {
-			arg5 = args[5].(*time.Time)
+			arg5 = args[5].(domain.CancellationCode)
+		}
+		var arg6 string
+		if args[6] != nil {
+			arg6 = args[6].(string)
 		}
 		run(
 			arg0,
@@ -116,52 +110,62 @@ func (_c *MockSubscriptionsRepository_AllMatchingSubscriptionsForPeriod_Call) Ru
 			arg3,
 			arg4,
 			arg5,
+			arg6,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_AllMatchingSubscriptionsForPeriod_Call) Return(ints []int, err error) *MockSubscriptionsRepository_AllMatchingSubscriptionsForPeriod_Call {
-	_c.Call.Return(ints, err)
+func (_c *MockSubscriptionsRepository_Cancel_Call) Return(err error) *MockSubscriptionsRepository_Cancel_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_AllMatchingSubscriptionsForPeriod_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName, time1 time.Time, time11 *time.Time) ([]int, error)) *MockSubscriptionsRepository_AllMatchingSubscriptionsForPeriod_Call {
+func (_c *MockSubscriptionsRepository_Cancel_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName, time1 time.Time, cancellationCode domain.CancellationCode, s string) error) *MockSubscriptionsRepository_Cancel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function for the type MockSubscriptionsRepository
-func (_mock *MockSubscriptionsRepository) Create(context1 context.Context, connection domain.Connection, subscription domain.Subscription) error {
+// CountOverlapping provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) CountOverlapping(context1 context.Context, connection domain.Connection, subscription domain.Subscription) (int, error) {
 	ret := _mock.Called(context1, connection, subscription)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for CountOverlapping")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Subscription) error); ok {
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Subscription) (int, error)); ok {
+		return returnFunc(context1, connection, subscription)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Subscription) int); ok {
 		r0 = returnFunc(context1, connection, subscription)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.Subscription) error); ok {
+		r1 = returnFunc(context1, connection, subscription)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockSubscriptionsRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockSubscriptionsRepository_Create_Call struct {
+// MockSubscriptionsRepository_CountOverlapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOverlapping'
+type MockSubscriptionsRepository_CountOverlapping_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// CountOverlapping is a helper method to define mock.On call
 //   - context1 context.Context
 //   - connection domain.Connection
 //   - subscription domain.Subscription
-func (_e *MockSubscriptionsRepository_Expecter) Create(context1 interface{}, connection interface{}, subscription interface{}) *MockSubscriptionsRepository_Create_Call {
-	return &MockSubscriptionsRepository_Create_Call{Call: _e.mock.On("Create", context1, connection, subscription)}
+func (_e *MockSubscriptionsRepository_Expecter) CountOverlapping(context1 interface{}, connection interface{}, subscription interface{}) *MockSubscriptionsRepository_CountOverlapping_Call {
+	return &MockSubscriptionsRepository_CountOverlapping_Call{Call: _e.mock.On("CountOverlapping", context1, connection, subscription)}
 }
 
-func (_c *MockSubscriptionsRepository_Create_Call) Run(run func(context1 context.Context, connection domain.Connection, subscription domain.Subscription)) *MockSubscriptionsRepository_Create_Call {
+func (_c *MockSubscriptionsRepository_CountOverlapping_Call) Run(run func(context1 context.Context, connection domain.Connection, subscription domain.Subscription)) *MockSubscriptionsRepository_CountOverlapping_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -184,48 +188,47 @@ func (_c *MockSubscriptionsRepository_Create_Call) Run(run func(context1 context
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_Create_Call) Return(err error) *MockSubscriptionsRepository_Create_Call {
-	_c.Call.Return(err)
+func (_c *MockSubscriptionsRepository_CountOverlapping_Call) Return(n int, err error) *MockSubscriptionsRepository_CountOverlapping_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_Create_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, subscription domain.Subscription) error) *MockSubscriptionsRepository_Create_Call {
+func (_c *MockSubscriptionsRepository_CountOverlapping_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, subscription domain.Subscription) (int, error)) *MockSubscriptionsRepository_CountOverlapping_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockSubscriptionsRepository
-func (_mock *MockSubscriptionsRepository) Delete(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName) error {
-	ret := _mock.Called(context1, connection, v, v1)
+// Create provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) Create(context1 context.Context, connection domain.Connection, subscription domain.Subscription) error {
+	ret := _mock.Called(context1, connection, subscription)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName) error); ok {
-		r0 = returnFunc(context1, connection, v, v1)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Subscription) error); ok {
+		r0 = returnFunc(context1, connection, subscription)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSubscriptionsRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockSubscriptionsRepository_Delete_Call struct {
+// MockSubscriptionsRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSubscriptionsRepository_Create_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - context1 context.Context
 //   - connection domain.Connection
-//   - v domain.UserID
-//   - v1 domain.ServiceName
-func (_e *MockSubscriptionsRepository_Expecter) Delete(context1 interface{}, connection interface{}, v interface{}, v1 interface{}) *MockSubscriptionsRepository_Delete_Call {
-	return &MockSubscriptionsRepository_Delete_Call{Call: _e.mock.On("Delete", context1, connection, v, v1)}
+//   - subscription domain.Subscription
+func (_e *MockSubscriptionsRepository_Expecter) Create(context1 interface{}, connection interface{}, subscription interface{}) *MockSubscriptionsRepository_Create_Call {
+	return &MockSubscriptionsRepository_Create_Call{Call: _e.mock.On("Create", context1, connection, subscription)}
 }
 
-func (_c *MockSubscriptionsRepository_Delete_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName)) *MockSubscriptionsRepository_Delete_Call {
+func (_c *MockSubscriptionsRepository_Create_Call) Run(run func(context1 context.Context, connection domain.Connection, subscription domain.Subscription)) *MockSubscriptionsRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -235,74 +238,60 @@ func (_c *MockSubscriptionsRepository_Delete_Call) Run(run func(context1 context
 		if args[1] != nil {
 			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 domain.UserID
+		var arg2 domain.Subscription
 		if args[2] != nil {
-			arg2 = args[2].(domain.UserID)
-		}
-		var arg3 domain.ServiceName
-		if args[3] != nil {
-			arg3 = args[3].(domain.ServiceName)
+			arg2 = args[2].(domain.Subscription)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_Delete_Call) Return(err error) *MockSubscriptionsRepository_Delete_Call {
+func (_c *MockSubscriptionsRepository_Create_Call) Return(err error) *MockSubscriptionsRepository_Create_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_Delete_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName) error) *MockSubscriptionsRepository_Delete_Call {
+func (_c *MockSubscriptionsRepository_Create_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, subscription domain.Subscription) error) *MockSubscriptionsRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLatest provides a mock function for the type MockSubscriptionsRepository
-func (_mock *MockSubscriptionsRepository) GetLatest(context1 context.Context, connection domain.Connection, v domain.UserID) (domain.Subscription, error) {
-	ret := _mock.Called(context1, connection, v)
+// CreateDiscount provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) CreateDiscount(context1 context.Context, connection domain.Connection, discount domain.Discount) error {
+	ret := _mock.Called(context1, connection, discount)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLatest")
+		panic("no return value specified for CreateDiscount")
 	}
 
-	var r0 domain.Subscription
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) (domain.Subscription, error)); ok {
-		return returnFunc(context1, connection, v)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) domain.Subscription); ok {
-		r0 = returnFunc(context1, connection, v)
-	} else {
-		r0 = ret.Get(0).(domain.Subscription)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID) error); ok {
-		r1 = returnFunc(context1, connection, v)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Discount) error); ok {
+		r0 = returnFunc(context1, connection, discount)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockSubscriptionsRepository_GetLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatest'
-type MockSubscriptionsRepository_GetLatest_Call struct {
+// MockSubscriptionsRepository_CreateDiscount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDiscount'
+type MockSubscriptionsRepository_CreateDiscount_Call struct {
 	*mock.Call
 }
 
-// GetLatest is a helper method to define mock.On call
+// CreateDiscount is a helper method to define mock.On call
 //   - context1 context.Context
 //   - connection domain.Connection
-//   - v domain.UserID
-func (_e *MockSubscriptionsRepository_Expecter) GetLatest(context1 interface{}, connection interface{}, v interface{}) *MockSubscriptionsRepository_GetLatest_Call {
-	return &MockSubscriptionsRepository_GetLatest_Call{Call: _e.mock.On("GetLatest", context1, connection, v)}
+//   - discount domain.Discount
+func (_e *MockSubscriptionsRepository_Expecter) CreateDiscount(context1 interface{}, connection interface{}, discount interface{}) *MockSubscriptionsRepository_CreateDiscount_Call {
+	return &MockSubscriptionsRepository_CreateDiscount_Call{Call: _e.mock.On("CreateDiscount", context1, connection, discount)}
 }
 
-func (_c *MockSubscriptionsRepository_GetLatest_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID)) *MockSubscriptionsRepository_GetLatest_Call {
+func (_c *MockSubscriptionsRepository_CreateDiscount_Call) Run(run func(context1 context.Context, connection domain.Connection, discount domain.Discount)) *MockSubscriptionsRepository_CreateDiscount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -312,9 +301,9 @@ func (_c *MockSubscriptionsRepository_GetLatest_Call) Run(run func(context1 cont
 		if args[1] != nil {
 			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 domain.UserID
+		var arg2 domain.Discount
 		if args[2] != nil {
-			arg2 = args[2].(domain.UserID)
+			arg2 = args[2].(domain.Discount)
 		}
 		run(
 			arg0,
@@ -325,59 +314,48 @@ func (_c *MockSubscriptionsRepository_GetLatest_Call) Run(run func(context1 cont
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_GetLatest_Call) Return(subscription domain.Subscription, err error) *MockSubscriptionsRepository_GetLatest_Call {
-	_c.Call.Return(subscription, err)
+func (_c *MockSubscriptionsRepository_CreateDiscount_Call) Return(err error) *MockSubscriptionsRepository_CreateDiscount_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_GetLatest_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID) (domain.Subscription, error)) *MockSubscriptionsRepository_GetLatest_Call {
+func (_c *MockSubscriptionsRepository_CreateDiscount_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, discount domain.Discount) error) *MockSubscriptionsRepository_CreateDiscount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLatestSubscriptionDate provides a mock function for the type MockSubscriptionsRepository
-func (_mock *MockSubscriptionsRepository) GetLatestSubscriptionDate(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName) (*time.Time, error) {
+// Delete provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) Delete(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName) error {
 	ret := _mock.Called(context1, connection, v, v1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLatestSubscriptionDate")
+		panic("no return value specified for Delete")
 	}
 
-	var r0 *time.Time
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName) (*time.Time, error)); ok {
-		return returnFunc(context1, connection, v, v1)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName) *time.Time); ok {
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName) error); ok {
 		r0 = returnFunc(context1, connection, v, v1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*time.Time)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName) error); ok {
-		r1 = returnFunc(context1, connection, v, v1)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockSubscriptionsRepository_GetLatestSubscriptionDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestSubscriptionDate'
-type MockSubscriptionsRepository_GetLatestSubscriptionDate_Call struct {
+// MockSubscriptionsRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockSubscriptionsRepository_Delete_Call struct {
 	*mock.Call
 }
 
-// GetLatestSubscriptionDate is a helper method to define mock.On call
+// Delete is a helper method to define mock.On call
 //   - context1 context.Context
 //   - connection domain.Connection
 //   - v domain.UserID
 //   - v1 domain.ServiceName
-func (_e *MockSubscriptionsRepository_Expecter) GetLatestSubscriptionDate(context1 interface{}, connection interface{}, v interface{}, v1 interface{}) *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call {
-	return &MockSubscriptionsRepository_GetLatestSubscriptionDate_Call{Call: _e.mock.On("GetLatestSubscriptionDate", context1, connection, v, v1)}
+func (_e *MockSubscriptionsRepository_Expecter) Delete(context1 interface{}, connection interface{}, v interface{}, v1 interface{}) *MockSubscriptionsRepository_Delete_Call {
+	return &MockSubscriptionsRepository_Delete_Call{Call: _e.mock.On("Delete", context1, connection, v, v1)}
 }
 
-func (_c *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName)) *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call {
+func (_c *MockSubscriptionsRepository_Delete_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName)) *MockSubscriptionsRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -405,58 +383,48 @@ func (_c *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call) Run(run fu
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call) Return(time1 *time.Time, err error) *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call {
-	_c.Call.Return(time1, err)
+func (_c *MockSubscriptionsRepository_Delete_Call) Return(err error) *MockSubscriptionsRepository_Delete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName) (*time.Time, error)) *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call {
+func (_c *MockSubscriptionsRepository_Delete_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName) error) *MockSubscriptionsRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadAllByUserID provides a mock function for the type MockSubscriptionsRepository
-func (_mock *MockSubscriptionsRepository) ReadAllByUserID(context1 context.Context, connection domain.Connection, v domain.UserID) ([]domain.Subscription, error) {
-	ret := _mock.Called(context1, connection, v)
+// DeleteActual provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) DeleteActual(context1 context.Context, connection domain.Connection, v domain.SubscriptionID, time1 time.Time) error {
+	ret := _mock.Called(context1, connection, v, time1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadAllByUserID")
+		panic("no return value specified for DeleteActual")
 	}
 
-	var r0 []domain.Subscription
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) ([]domain.Subscription, error)); ok {
-		return returnFunc(context1, connection, v)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) []domain.Subscription); ok {
-		r0 = returnFunc(context1, connection, v)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Subscription)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID) error); ok {
-		r1 = returnFunc(context1, connection, v)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID, time.Time) error); ok {
+		r0 = returnFunc(context1, connection, v, time1)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockSubscriptionsRepository_ReadAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAllByUserID'
-type MockSubscriptionsRepository_ReadAllByUserID_Call struct {
+// MockSubscriptionsRepository_DeleteActual_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteActual'
+type MockSubscriptionsRepository_DeleteActual_Call struct {
 	*mock.Call
 }
 
-// ReadAllByUserID is a helper method to define mock.On call
+// DeleteActual is a helper method to define mock.On call
 //   - context1 context.Context
 //   - connection domain.Connection
-//   - v domain.UserID
-func (_e *MockSubscriptionsRepository_Expecter) ReadAllByUserID(context1 interface{}, connection interface{}, v interface{}) *MockSubscriptionsRepository_ReadAllByUserID_Call {
-	return &MockSubscriptionsRepository_ReadAllByUserID_Call{Call: _e.mock.On("ReadAllByUserID", context1, connection, v)}
+//   - v domain.SubscriptionID
+//   - time1 time.Time
+func (_e *MockSubscriptionsRepository_Expecter) DeleteActual(context1 interface{}, connection interface{}, v interface{}, time1 interface{}) *MockSubscriptionsRepository_DeleteActual_Call {
+	return &MockSubscriptionsRepository_DeleteActual_Call{Call: _e.mock.On("DeleteActual", context1, connection, v, time1)}
 }
 
-func (_c *MockSubscriptionsRepository_ReadAllByUserID_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID)) *MockSubscriptionsRepository_ReadAllByUserID_Call {
+func (_c *MockSubscriptionsRepository_DeleteActual_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID, time1 time.Time)) *MockSubscriptionsRepository_DeleteActual_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -466,60 +434,66 @@ func (_c *MockSubscriptionsRepository_ReadAllByUserID_Call) Run(run func(context
 		if args[1] != nil {
 			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 domain.UserID
+		var arg2 domain.SubscriptionID
 		if args[2] != nil {
-			arg2 = args[2].(domain.UserID)
+			arg2 = args[2].(domain.SubscriptionID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_ReadAllByUserID_Call) Return(subscriptions []domain.Subscription, err error) *MockSubscriptionsRepository_ReadAllByUserID_Call {
-	_c.Call.Return(subscriptions, err)
+func (_c *MockSubscriptionsRepository_DeleteActual_Call) Return(err error) *MockSubscriptionsRepository_DeleteActual_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_ReadAllByUserID_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID) ([]domain.Subscription, error)) *MockSubscriptionsRepository_ReadAllByUserID_Call {
+func (_c *MockSubscriptionsRepository_DeleteActual_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID, time1 time.Time) error) *MockSubscriptionsRepository_DeleteActual_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockSubscriptionsRepository
-func (_mock *MockSubscriptionsRepository) Update(context1 context.Context, connection domain.Connection, subscription domain.Subscription) error {
-	ret := _mock.Called(context1, connection, subscription)
+// DeleteDiscount provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) DeleteDiscount(context1 context.Context, connection domain.Connection, v domain.SubscriptionID, v1 domain.DiscountID) error {
+	ret := _mock.Called(context1, connection, v, v1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for DeleteDiscount")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Subscription) error); ok {
-		r0 = returnFunc(context1, connection, subscription)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID, domain.DiscountID) error); ok {
+		r0 = returnFunc(context1, connection, v, v1)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSubscriptionsRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockSubscriptionsRepository_Update_Call struct {
+// MockSubscriptionsRepository_DeleteDiscount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDiscount'
+type MockSubscriptionsRepository_DeleteDiscount_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// DeleteDiscount is a helper method to define mock.On call
 //   - context1 context.Context
 //   - connection domain.Connection
-//   - subscription domain.Subscription
-func (_e *MockSubscriptionsRepository_Expecter) Update(context1 interface{}, connection interface{}, subscription interface{}) *MockSubscriptionsRepository_Update_Call {
-	return &MockSubscriptionsRepository_Update_Call{Call: _e.mock.On("Update", context1, connection, subscription)}
+//   - v domain.SubscriptionID
+//   - v1 domain.DiscountID
+func (_e *MockSubscriptionsRepository_Expecter) DeleteDiscount(context1 interface{}, connection interface{}, v interface{}, v1 interface{}) *MockSubscriptionsRepository_DeleteDiscount_Call {
+	return &MockSubscriptionsRepository_DeleteDiscount_Call{Call: _e.mock.On("DeleteDiscount", context1, connection, v, v1)}
 }
 
-func (_c *MockSubscriptionsRepository_Update_Call) Run(run func(context1 context.Context, connection domain.Connection, subscription domain.Subscription)) *MockSubscriptionsRepository_Update_Call {
+func (_c *MockSubscriptionsRepository_DeleteDiscount_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID, v1 domain.DiscountID)) *MockSubscriptionsRepository_DeleteDiscount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -529,740 +503,8802 @@ func (_c *MockSubscriptionsRepository_Update_Call) Run(run func(context1 context
 		if args[1] != nil {
 			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 domain.Subscription
+		var arg2 domain.SubscriptionID
 		if args[2] != nil {
-			arg2 = args[2].(domain.Subscription)
+			arg2 = args[2].(domain.SubscriptionID)
+		}
+		var arg3 domain.DiscountID
+		if args[3] != nil {
+			arg3 = args[3].(domain.DiscountID)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_Update_Call) Return(err error) *MockSubscriptionsRepository_Update_Call {
+func (_c *MockSubscriptionsRepository_DeleteDiscount_Call) Return(err error) *MockSubscriptionsRepository_DeleteDiscount_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSubscriptionsRepository_Update_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, subscription domain.Subscription) error) *MockSubscriptionsRepository_Update_Call {
+func (_c *MockSubscriptionsRepository_DeleteDiscount_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID, v1 domain.DiscountID) error) *MockSubscriptionsRepository_DeleteDiscount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockConnection creates a new instance of MockConnection. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockConnection(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockConnection {
-	mock := &MockConnection{}
-	mock.Mock.Test(t)
+// ExpireDue provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) ExpireDue(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time) (int64, error) {
+	ret := _mock.Called(context1, connection, time1, time11)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireDue")
+	}
 
-	return mock
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time) (int64, error)); ok {
+		return returnFunc(context1, connection, time1, time11)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time) int64); ok {
+		r0 = returnFunc(context1, connection, time1, time11)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time, time.Time) error); ok {
+		r1 = returnFunc(context1, connection, time1, time11)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockConnection is an autogenerated mock type for the Connection type
-type MockConnection struct {
-	mock.Mock
+// MockSubscriptionsRepository_ExpireDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireDue'
+type MockSubscriptionsRepository_ExpireDue_Call struct {
+	*mock.Call
 }
 
-type MockConnection_Expecter struct {
-	mock *mock.Mock
+// ExpireDue is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - time1 time.Time
+//   - time11 time.Time
+func (_e *MockSubscriptionsRepository_Expecter) ExpireDue(context1 interface{}, connection interface{}, time1 interface{}, time11 interface{}) *MockSubscriptionsRepository_ExpireDue_Call {
+	return &MockSubscriptionsRepository_ExpireDue_Call{Call: _e.mock.On("ExpireDue", context1, connection, time1, time11)}
 }
 
-func (_m *MockConnection) EXPECT() *MockConnection_Expecter {
+func (_c *MockSubscriptionsRepository_ExpireDue_Call) Run(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time)) *MockSubscriptionsRepository_ExpireDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ExpireDue_Call) Return(n int64, err error) *MockSubscriptionsRepository_ExpireDue_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ExpireDue_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time) (int64, error)) *MockSubscriptionsRepository_ExpireDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindServiceConflicts provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) FindServiceConflicts(context1 context.Context, connection domain.Connection, v domain.ServiceName, v1 domain.ServiceName) ([]domain.ServiceConflict, error) {
+	ret := _mock.Called(context1, connection, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindServiceConflicts")
+	}
+
+	var r0 []domain.ServiceConflict
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ServiceName, domain.ServiceName) ([]domain.ServiceConflict, error)); ok {
+		return returnFunc(context1, connection, v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ServiceName, domain.ServiceName) []domain.ServiceConflict); ok {
+		r0 = returnFunc(context1, connection, v, v1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ServiceConflict)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.ServiceName, domain.ServiceName) error); ok {
+		r1 = returnFunc(context1, connection, v, v1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_FindServiceConflicts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindServiceConflicts'
+type MockSubscriptionsRepository_FindServiceConflicts_Call struct {
+	*mock.Call
+}
+
+// FindServiceConflicts is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.ServiceName
+//   - v1 domain.ServiceName
+func (_e *MockSubscriptionsRepository_Expecter) FindServiceConflicts(context1 interface{}, connection interface{}, v interface{}, v1 interface{}) *MockSubscriptionsRepository_FindServiceConflicts_Call {
+	return &MockSubscriptionsRepository_FindServiceConflicts_Call{Call: _e.mock.On("FindServiceConflicts", context1, connection, v, v1)}
+}
+
+func (_c *MockSubscriptionsRepository_FindServiceConflicts_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ServiceName, v1 domain.ServiceName)) *MockSubscriptionsRepository_FindServiceConflicts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ServiceName
+		if args[2] != nil {
+			arg2 = args[2].(domain.ServiceName)
+		}
+		var arg3 domain.ServiceName
+		if args[3] != nil {
+			arg3 = args[3].(domain.ServiceName)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_FindServiceConflicts_Call) Return(serviceConflicts []domain.ServiceConflict, err error) *MockSubscriptionsRepository_FindServiceConflicts_Call {
+	_c.Call.Return(serviceConflicts, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_FindServiceConflicts_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ServiceName, v1 domain.ServiceName) ([]domain.ServiceConflict, error)) *MockSubscriptionsRepository_FindServiceConflicts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserConflicts provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) FindUserConflicts(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.UserID) ([]domain.ServiceConflict, error) {
+	ret := _mock.Called(context1, connection, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserConflicts")
+	}
+
+	var r0 []domain.ServiceConflict
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.UserID) ([]domain.ServiceConflict, error)); ok {
+		return returnFunc(context1, connection, v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.UserID) []domain.ServiceConflict); ok {
+		r0 = returnFunc(context1, connection, v, v1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ServiceConflict)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.UserID) error); ok {
+		r1 = returnFunc(context1, connection, v, v1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_FindUserConflicts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserConflicts'
+type MockSubscriptionsRepository_FindUserConflicts_Call struct {
+	*mock.Call
+}
+
+// FindUserConflicts is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.UserID
+//   - v1 domain.UserID
+func (_e *MockSubscriptionsRepository_Expecter) FindUserConflicts(context1 interface{}, connection interface{}, v interface{}, v1 interface{}) *MockSubscriptionsRepository_FindUserConflicts_Call {
+	return &MockSubscriptionsRepository_FindUserConflicts_Call{Call: _e.mock.On("FindUserConflicts", context1, connection, v, v1)}
+}
+
+func (_c *MockSubscriptionsRepository_FindUserConflicts_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.UserID)) *MockSubscriptionsRepository_FindUserConflicts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserID)
+		}
+		var arg3 domain.UserID
+		if args[3] != nil {
+			arg3 = args[3].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_FindUserConflicts_Call) Return(serviceConflicts []domain.ServiceConflict, err error) *MockSubscriptionsRepository_FindUserConflicts_Call {
+	_c.Call.Return(serviceConflicts, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_FindUserConflicts_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.UserID) ([]domain.ServiceConflict, error)) *MockSubscriptionsRepository_FindUserConflicts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) GetByID(context1 context.Context, connection domain.Connection, v domain.SubscriptionID) (domain.Subscription, error) {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID) (domain.Subscription, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID) domain.Subscription); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Get(0).(domain.Subscription)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.SubscriptionID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockSubscriptionsRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.SubscriptionID
+func (_e *MockSubscriptionsRepository_Expecter) GetByID(context1 interface{}, connection interface{}, v interface{}) *MockSubscriptionsRepository_GetByID_Call {
+	return &MockSubscriptionsRepository_GetByID_Call{Call: _e.mock.On("GetByID", context1, connection, v)}
+}
+
+func (_c *MockSubscriptionsRepository_GetByID_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID)) *MockSubscriptionsRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.SubscriptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_GetByID_Call) Return(subscription domain.Subscription, err error) *MockSubscriptionsRepository_GetByID_Call {
+	_c.Call.Return(subscription, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_GetByID_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID) (domain.Subscription, error)) *MockSubscriptionsRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatest provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) GetLatest(context1 context.Context, connection domain.Connection, v domain.UserID) (domain.Subscription, error) {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatest")
+	}
+
+	var r0 domain.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) (domain.Subscription, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) domain.Subscription); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Get(0).(domain.Subscription)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_GetLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatest'
+type MockSubscriptionsRepository_GetLatest_Call struct {
+	*mock.Call
+}
+
+// GetLatest is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.UserID
+func (_e *MockSubscriptionsRepository_Expecter) GetLatest(context1 interface{}, connection interface{}, v interface{}) *MockSubscriptionsRepository_GetLatest_Call {
+	return &MockSubscriptionsRepository_GetLatest_Call{Call: _e.mock.On("GetLatest", context1, connection, v)}
+}
+
+func (_c *MockSubscriptionsRepository_GetLatest_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID)) *MockSubscriptionsRepository_GetLatest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_GetLatest_Call) Return(subscription domain.Subscription, err error) *MockSubscriptionsRepository_GetLatest_Call {
+	_c.Call.Return(subscription, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_GetLatest_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID) (domain.Subscription, error)) *MockSubscriptionsRepository_GetLatest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestByName provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) GetLatestByName(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName) (domain.Subscription, error) {
+	ret := _mock.Called(context1, connection, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestByName")
+	}
+
+	var r0 domain.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName) (domain.Subscription, error)); ok {
+		return returnFunc(context1, connection, v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName) domain.Subscription); ok {
+		r0 = returnFunc(context1, connection, v, v1)
+	} else {
+		r0 = ret.Get(0).(domain.Subscription)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName) error); ok {
+		r1 = returnFunc(context1, connection, v, v1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_GetLatestByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestByName'
+type MockSubscriptionsRepository_GetLatestByName_Call struct {
+	*mock.Call
+}
+
+// GetLatestByName is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.UserID
+//   - v1 domain.ServiceName
+func (_e *MockSubscriptionsRepository_Expecter) GetLatestByName(context1 interface{}, connection interface{}, v interface{}, v1 interface{}) *MockSubscriptionsRepository_GetLatestByName_Call {
+	return &MockSubscriptionsRepository_GetLatestByName_Call{Call: _e.mock.On("GetLatestByName", context1, connection, v, v1)}
+}
+
+func (_c *MockSubscriptionsRepository_GetLatestByName_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName)) *MockSubscriptionsRepository_GetLatestByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserID)
+		}
+		var arg3 domain.ServiceName
+		if args[3] != nil {
+			arg3 = args[3].(domain.ServiceName)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_GetLatestByName_Call) Return(subscription domain.Subscription, err error) *MockSubscriptionsRepository_GetLatestByName_Call {
+	_c.Call.Return(subscription, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_GetLatestByName_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName) (domain.Subscription, error)) *MockSubscriptionsRepository_GetLatestByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestSubscriptionDate provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) GetLatestSubscriptionDate(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName) (*time.Time, error) {
+	ret := _mock.Called(context1, connection, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestSubscriptionDate")
+	}
+
+	var r0 *time.Time
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName) (*time.Time, error)); ok {
+		return returnFunc(context1, connection, v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName) *time.Time); ok {
+		r0 = returnFunc(context1, connection, v, v1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Time)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.ServiceName) error); ok {
+		r1 = returnFunc(context1, connection, v, v1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_GetLatestSubscriptionDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestSubscriptionDate'
+type MockSubscriptionsRepository_GetLatestSubscriptionDate_Call struct {
+	*mock.Call
+}
+
+// GetLatestSubscriptionDate is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.UserID
+//   - v1 domain.ServiceName
+func (_e *MockSubscriptionsRepository_Expecter) GetLatestSubscriptionDate(context1 interface{}, connection interface{}, v interface{}, v1 interface{}) *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call {
+	return &MockSubscriptionsRepository_GetLatestSubscriptionDate_Call{Call: _e.mock.On("GetLatestSubscriptionDate", context1, connection, v, v1)}
+}
+
+func (_c *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName)) *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserID)
+		}
+		var arg3 domain.ServiceName
+		if args[3] != nil {
+			arg3 = args[3].(domain.ServiceName)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call) Return(time1 *time.Time, err error) *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call {
+	_c.Call.Return(time1, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.ServiceName) (*time.Time, error)) *MockSubscriptionsRepository_GetLatestSubscriptionDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVersionAt provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) GetVersionAt(context1 context.Context, connection domain.Connection, v domain.SubscriptionID, time1 time.Time) (domain.SubscriptionVersion, error) {
+	ret := _mock.Called(context1, connection, v, time1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVersionAt")
+	}
+
+	var r0 domain.SubscriptionVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID, time.Time) (domain.SubscriptionVersion, error)); ok {
+		return returnFunc(context1, connection, v, time1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID, time.Time) domain.SubscriptionVersion); ok {
+		r0 = returnFunc(context1, connection, v, time1)
+	} else {
+		r0 = ret.Get(0).(domain.SubscriptionVersion)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.SubscriptionID, time.Time) error); ok {
+		r1 = returnFunc(context1, connection, v, time1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_GetVersionAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVersionAt'
+type MockSubscriptionsRepository_GetVersionAt_Call struct {
+	*mock.Call
+}
+
+// GetVersionAt is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.SubscriptionID
+//   - time1 time.Time
+func (_e *MockSubscriptionsRepository_Expecter) GetVersionAt(context1 interface{}, connection interface{}, v interface{}, time1 interface{}) *MockSubscriptionsRepository_GetVersionAt_Call {
+	return &MockSubscriptionsRepository_GetVersionAt_Call{Call: _e.mock.On("GetVersionAt", context1, connection, v, time1)}
+}
+
+func (_c *MockSubscriptionsRepository_GetVersionAt_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID, time1 time.Time)) *MockSubscriptionsRepository_GetVersionAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.SubscriptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.SubscriptionID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_GetVersionAt_Call) Return(subscriptionVersion domain.SubscriptionVersion, err error) *MockSubscriptionsRepository_GetVersionAt_Call {
+	_c.Call.Return(subscriptionVersion, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_GetVersionAt_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID, time1 time.Time) (domain.SubscriptionVersion, error)) *MockSubscriptionsRepository_GetVersionAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeleted provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) PurgeDeleted(context1 context.Context, connection domain.Connection, time1 time.Time) (int64, error) {
+	ret := _mock.Called(context1, connection, time1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeleted")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time) (int64, error)); ok {
+		return returnFunc(context1, connection, time1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time) int64); ok {
+		r0 = returnFunc(context1, connection, time1)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time) error); ok {
+		r1 = returnFunc(context1, connection, time1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_PurgeDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeleted'
+type MockSubscriptionsRepository_PurgeDeleted_Call struct {
+	*mock.Call
+}
+
+// PurgeDeleted is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - time1 time.Time
+func (_e *MockSubscriptionsRepository_Expecter) PurgeDeleted(context1 interface{}, connection interface{}, time1 interface{}) *MockSubscriptionsRepository_PurgeDeleted_Call {
+	return &MockSubscriptionsRepository_PurgeDeleted_Call{Call: _e.mock.On("PurgeDeleted", context1, connection, time1)}
+}
+
+func (_c *MockSubscriptionsRepository_PurgeDeleted_Call) Run(run func(context1 context.Context, connection domain.Connection, time1 time.Time)) *MockSubscriptionsRepository_PurgeDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_PurgeDeleted_Call) Return(n int64, err error) *MockSubscriptionsRepository_PurgeDeleted_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_PurgeDeleted_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, time1 time.Time) (int64, error)) *MockSubscriptionsRepository_PurgeDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadActiveInPeriod provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) ReadActiveInPeriod(context1 context.Context, connection domain.Connection, v *domain.UserID, v1 *domain.ServiceName, time1 time.Time, time11 time.Time) ([]domain.Subscription, error) {
+	ret := _mock.Called(context1, connection, v, v1, time1, time11)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadActiveInPeriod")
+	}
+
+	var r0 []domain.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, *domain.UserID, *domain.ServiceName, time.Time, time.Time) ([]domain.Subscription, error)); ok {
+		return returnFunc(context1, connection, v, v1, time1, time11)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, *domain.UserID, *domain.ServiceName, time.Time, time.Time) []domain.Subscription); ok {
+		r0 = returnFunc(context1, connection, v, v1, time1, time11)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, *domain.UserID, *domain.ServiceName, time.Time, time.Time) error); ok {
+		r1 = returnFunc(context1, connection, v, v1, time1, time11)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_ReadActiveInPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadActiveInPeriod'
+type MockSubscriptionsRepository_ReadActiveInPeriod_Call struct {
+	*mock.Call
+}
+
+// ReadActiveInPeriod is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v *domain.UserID
+//   - v1 *domain.ServiceName
+//   - time1 time.Time
+//   - time11 time.Time
+func (_e *MockSubscriptionsRepository_Expecter) ReadActiveInPeriod(context1 interface{}, connection interface{}, v interface{}, v1 interface{}, time1 interface{}, time11 interface{}) *MockSubscriptionsRepository_ReadActiveInPeriod_Call {
+	return &MockSubscriptionsRepository_ReadActiveInPeriod_Call{Call: _e.mock.On("ReadActiveInPeriod", context1, connection, v, v1, time1, time11)}
+}
+
+func (_c *MockSubscriptionsRepository_ReadActiveInPeriod_Call) Run(run func(context1 context.Context, connection domain.Connection, v *domain.UserID, v1 *domain.ServiceName, time1 time.Time, time11 time.Time)) *MockSubscriptionsRepository_ReadActiveInPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 *domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(*domain.UserID)
+		}
+		var arg3 *domain.ServiceName
+		if args[3] != nil {
+			arg3 = args[3].(*domain.ServiceName)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		var arg5 time.Time
+		if args[5] != nil {
+			arg5 = args[5].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadActiveInPeriod_Call) Return(subscriptions []domain.Subscription, err error) *MockSubscriptionsRepository_ReadActiveInPeriod_Call {
+	_c.Call.Return(subscriptions, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadActiveInPeriod_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v *domain.UserID, v1 *domain.ServiceName, time1 time.Time, time11 time.Time) ([]domain.Subscription, error)) *MockSubscriptionsRepository_ReadActiveInPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadActuals provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) ReadActuals(context1 context.Context, connection domain.Connection, vs []domain.SubscriptionID) ([]domain.ActualCharge, error) {
+	ret := _mock.Called(context1, connection, vs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadActuals")
+	}
+
+	var r0 []domain.ActualCharge
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.SubscriptionID) ([]domain.ActualCharge, error)); ok {
+		return returnFunc(context1, connection, vs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.SubscriptionID) []domain.ActualCharge); ok {
+		r0 = returnFunc(context1, connection, vs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ActualCharge)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []domain.SubscriptionID) error); ok {
+		r1 = returnFunc(context1, connection, vs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_ReadActuals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadActuals'
+type MockSubscriptionsRepository_ReadActuals_Call struct {
+	*mock.Call
+}
+
+// ReadActuals is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - vs []domain.SubscriptionID
+func (_e *MockSubscriptionsRepository_Expecter) ReadActuals(context1 interface{}, connection interface{}, vs interface{}) *MockSubscriptionsRepository_ReadActuals_Call {
+	return &MockSubscriptionsRepository_ReadActuals_Call{Call: _e.mock.On("ReadActuals", context1, connection, vs)}
+}
+
+func (_c *MockSubscriptionsRepository_ReadActuals_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.SubscriptionID)) *MockSubscriptionsRepository_ReadActuals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.SubscriptionID
+		if args[2] != nil {
+			arg2 = args[2].([]domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadActuals_Call) Return(actualCharges []domain.ActualCharge, err error) *MockSubscriptionsRepository_ReadActuals_Call {
+	_c.Call.Return(actualCharges, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadActuals_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.SubscriptionID) ([]domain.ActualCharge, error)) *MockSubscriptionsRepository_ReadActuals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadAllByUserID provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) ReadAllByUserID(context1 context.Context, connection domain.Connection, v domain.UserID) ([]domain.Subscription, error) {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadAllByUserID")
+	}
+
+	var r0 []domain.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) ([]domain.Subscription, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) []domain.Subscription); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_ReadAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAllByUserID'
+type MockSubscriptionsRepository_ReadAllByUserID_Call struct {
+	*mock.Call
+}
+
+// ReadAllByUserID is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.UserID
+func (_e *MockSubscriptionsRepository_Expecter) ReadAllByUserID(context1 interface{}, connection interface{}, v interface{}) *MockSubscriptionsRepository_ReadAllByUserID_Call {
+	return &MockSubscriptionsRepository_ReadAllByUserID_Call{Call: _e.mock.On("ReadAllByUserID", context1, connection, v)}
+}
+
+func (_c *MockSubscriptionsRepository_ReadAllByUserID_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID)) *MockSubscriptionsRepository_ReadAllByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadAllByUserID_Call) Return(subscriptions []domain.Subscription, err error) *MockSubscriptionsRepository_ReadAllByUserID_Call {
+	_c.Call.Return(subscriptions, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadAllByUserID_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID) ([]domain.Subscription, error)) *MockSubscriptionsRepository_ReadAllByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadByUsers provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) ReadByUsers(context1 context.Context, connection domain.Connection, vs []domain.UserID) ([]domain.Subscription, error) {
+	ret := _mock.Called(context1, connection, vs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadByUsers")
+	}
+
+	var r0 []domain.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.UserID) ([]domain.Subscription, error)); ok {
+		return returnFunc(context1, connection, vs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.UserID) []domain.Subscription); ok {
+		r0 = returnFunc(context1, connection, vs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []domain.UserID) error); ok {
+		r1 = returnFunc(context1, connection, vs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_ReadByUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadByUsers'
+type MockSubscriptionsRepository_ReadByUsers_Call struct {
+	*mock.Call
+}
+
+// ReadByUsers is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - vs []domain.UserID
+func (_e *MockSubscriptionsRepository_Expecter) ReadByUsers(context1 interface{}, connection interface{}, vs interface{}) *MockSubscriptionsRepository_ReadByUsers_Call {
+	return &MockSubscriptionsRepository_ReadByUsers_Call{Call: _e.mock.On("ReadByUsers", context1, connection, vs)}
+}
+
+func (_c *MockSubscriptionsRepository_ReadByUsers_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.UserID)) *MockSubscriptionsRepository_ReadByUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].([]domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadByUsers_Call) Return(subscriptions []domain.Subscription, err error) *MockSubscriptionsRepository_ReadByUsers_Call {
+	_c.Call.Return(subscriptions, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadByUsers_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.UserID) ([]domain.Subscription, error)) *MockSubscriptionsRepository_ReadByUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadDeletedByUserID provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) ReadDeletedByUserID(context1 context.Context, connection domain.Connection, v domain.UserID) ([]domain.Subscription, error) {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDeletedByUserID")
+	}
+
+	var r0 []domain.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) ([]domain.Subscription, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) []domain.Subscription); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_ReadDeletedByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDeletedByUserID'
+type MockSubscriptionsRepository_ReadDeletedByUserID_Call struct {
+	*mock.Call
+}
+
+// ReadDeletedByUserID is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.UserID
+func (_e *MockSubscriptionsRepository_Expecter) ReadDeletedByUserID(context1 interface{}, connection interface{}, v interface{}) *MockSubscriptionsRepository_ReadDeletedByUserID_Call {
+	return &MockSubscriptionsRepository_ReadDeletedByUserID_Call{Call: _e.mock.On("ReadDeletedByUserID", context1, connection, v)}
+}
+
+func (_c *MockSubscriptionsRepository_ReadDeletedByUserID_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID)) *MockSubscriptionsRepository_ReadDeletedByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadDeletedByUserID_Call) Return(subscriptions []domain.Subscription, err error) *MockSubscriptionsRepository_ReadDeletedByUserID_Call {
+	_c.Call.Return(subscriptions, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadDeletedByUserID_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID) ([]domain.Subscription, error)) *MockSubscriptionsRepository_ReadDeletedByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadDiscounts provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) ReadDiscounts(context1 context.Context, connection domain.Connection, vs []domain.SubscriptionID) ([]domain.Discount, error) {
+	ret := _mock.Called(context1, connection, vs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadDiscounts")
+	}
+
+	var r0 []domain.Discount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.SubscriptionID) ([]domain.Discount, error)); ok {
+		return returnFunc(context1, connection, vs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.SubscriptionID) []domain.Discount); ok {
+		r0 = returnFunc(context1, connection, vs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Discount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []domain.SubscriptionID) error); ok {
+		r1 = returnFunc(context1, connection, vs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_ReadDiscounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadDiscounts'
+type MockSubscriptionsRepository_ReadDiscounts_Call struct {
+	*mock.Call
+}
+
+// ReadDiscounts is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - vs []domain.SubscriptionID
+func (_e *MockSubscriptionsRepository_Expecter) ReadDiscounts(context1 interface{}, connection interface{}, vs interface{}) *MockSubscriptionsRepository_ReadDiscounts_Call {
+	return &MockSubscriptionsRepository_ReadDiscounts_Call{Call: _e.mock.On("ReadDiscounts", context1, connection, vs)}
+}
+
+func (_c *MockSubscriptionsRepository_ReadDiscounts_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.SubscriptionID)) *MockSubscriptionsRepository_ReadDiscounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.SubscriptionID
+		if args[2] != nil {
+			arg2 = args[2].([]domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadDiscounts_Call) Return(discounts []domain.Discount, err error) *MockSubscriptionsRepository_ReadDiscounts_Call {
+	_c.Call.Return(discounts, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadDiscounts_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.SubscriptionID) ([]domain.Discount, error)) *MockSubscriptionsRepository_ReadDiscounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadMembers provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) ReadMembers(context1 context.Context, connection domain.Connection, vs []domain.SubscriptionID) ([]domain.SubscriptionMember, error) {
+	ret := _mock.Called(context1, connection, vs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadMembers")
+	}
+
+	var r0 []domain.SubscriptionMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.SubscriptionID) ([]domain.SubscriptionMember, error)); ok {
+		return returnFunc(context1, connection, vs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.SubscriptionID) []domain.SubscriptionMember); ok {
+		r0 = returnFunc(context1, connection, vs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SubscriptionMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []domain.SubscriptionID) error); ok {
+		r1 = returnFunc(context1, connection, vs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_ReadMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadMembers'
+type MockSubscriptionsRepository_ReadMembers_Call struct {
+	*mock.Call
+}
+
+// ReadMembers is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - vs []domain.SubscriptionID
+func (_e *MockSubscriptionsRepository_Expecter) ReadMembers(context1 interface{}, connection interface{}, vs interface{}) *MockSubscriptionsRepository_ReadMembers_Call {
+	return &MockSubscriptionsRepository_ReadMembers_Call{Call: _e.mock.On("ReadMembers", context1, connection, vs)}
+}
+
+func (_c *MockSubscriptionsRepository_ReadMembers_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.SubscriptionID)) *MockSubscriptionsRepository_ReadMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.SubscriptionID
+		if args[2] != nil {
+			arg2 = args[2].([]domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadMembers_Call) Return(subscriptionMembers []domain.SubscriptionMember, err error) *MockSubscriptionsRepository_ReadMembers_Call {
+	_c.Call.Return(subscriptionMembers, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadMembers_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.SubscriptionID) ([]domain.SubscriptionMember, error)) *MockSubscriptionsRepository_ReadMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadVersions provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) ReadVersions(context1 context.Context, connection domain.Connection, v domain.SubscriptionID) ([]domain.SubscriptionVersion, error) {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadVersions")
+	}
+
+	var r0 []domain.SubscriptionVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID) ([]domain.SubscriptionVersion, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID) []domain.SubscriptionVersion); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SubscriptionVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.SubscriptionID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_ReadVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadVersions'
+type MockSubscriptionsRepository_ReadVersions_Call struct {
+	*mock.Call
+}
+
+// ReadVersions is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.SubscriptionID
+func (_e *MockSubscriptionsRepository_Expecter) ReadVersions(context1 interface{}, connection interface{}, v interface{}) *MockSubscriptionsRepository_ReadVersions_Call {
+	return &MockSubscriptionsRepository_ReadVersions_Call{Call: _e.mock.On("ReadVersions", context1, connection, v)}
+}
+
+func (_c *MockSubscriptionsRepository_ReadVersions_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID)) *MockSubscriptionsRepository_ReadVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.SubscriptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadVersions_Call) Return(subscriptionVersions []domain.SubscriptionVersion, err error) *MockSubscriptionsRepository_ReadVersions_Call {
+	_c.Call.Return(subscriptionVersions, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReadVersions_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID) ([]domain.SubscriptionVersion, error)) *MockSubscriptionsRepository_ReadVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameService provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) RenameService(context1 context.Context, connection domain.Connection, v domain.ServiceName, v1 domain.ServiceName, v2 *domain.CatalogEntryID) (int64, error) {
+	ret := _mock.Called(context1, connection, v, v1, v2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameService")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ServiceName, domain.ServiceName, *domain.CatalogEntryID) (int64, error)); ok {
+		return returnFunc(context1, connection, v, v1, v2)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ServiceName, domain.ServiceName, *domain.CatalogEntryID) int64); ok {
+		r0 = returnFunc(context1, connection, v, v1, v2)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.ServiceName, domain.ServiceName, *domain.CatalogEntryID) error); ok {
+		r1 = returnFunc(context1, connection, v, v1, v2)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_RenameService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameService'
+type MockSubscriptionsRepository_RenameService_Call struct {
+	*mock.Call
+}
+
+// RenameService is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.ServiceName
+//   - v1 domain.ServiceName
+//   - v2 *domain.CatalogEntryID
+func (_e *MockSubscriptionsRepository_Expecter) RenameService(context1 interface{}, connection interface{}, v interface{}, v1 interface{}, v2 interface{}) *MockSubscriptionsRepository_RenameService_Call {
+	return &MockSubscriptionsRepository_RenameService_Call{Call: _e.mock.On("RenameService", context1, connection, v, v1, v2)}
+}
+
+func (_c *MockSubscriptionsRepository_RenameService_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ServiceName, v1 domain.ServiceName, v2 *domain.CatalogEntryID)) *MockSubscriptionsRepository_RenameService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ServiceName
+		if args[2] != nil {
+			arg2 = args[2].(domain.ServiceName)
+		}
+		var arg3 domain.ServiceName
+		if args[3] != nil {
+			arg3 = args[3].(domain.ServiceName)
+		}
+		var arg4 *domain.CatalogEntryID
+		if args[4] != nil {
+			arg4 = args[4].(*domain.CatalogEntryID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_RenameService_Call) Return(n int64, err error) *MockSubscriptionsRepository_RenameService_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_RenameService_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ServiceName, v1 domain.ServiceName, v2 *domain.CatalogEntryID) (int64, error)) *MockSubscriptionsRepository_RenameService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenewDue provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) RenewDue(context1 context.Context, connection domain.Connection, time1 time.Time) ([]domain.SubscriptionID, error) {
+	ret := _mock.Called(context1, connection, time1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewDue")
+	}
+
+	var r0 []domain.SubscriptionID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time) ([]domain.SubscriptionID, error)); ok {
+		return returnFunc(context1, connection, time1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time) []domain.SubscriptionID); ok {
+		r0 = returnFunc(context1, connection, time1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SubscriptionID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time) error); ok {
+		r1 = returnFunc(context1, connection, time1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_RenewDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewDue'
+type MockSubscriptionsRepository_RenewDue_Call struct {
+	*mock.Call
+}
+
+// RenewDue is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - time1 time.Time
+func (_e *MockSubscriptionsRepository_Expecter) RenewDue(context1 interface{}, connection interface{}, time1 interface{}) *MockSubscriptionsRepository_RenewDue_Call {
+	return &MockSubscriptionsRepository_RenewDue_Call{Call: _e.mock.On("RenewDue", context1, connection, time1)}
+}
+
+func (_c *MockSubscriptionsRepository_RenewDue_Call) Run(run func(context1 context.Context, connection domain.Connection, time1 time.Time)) *MockSubscriptionsRepository_RenewDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_RenewDue_Call) Return(vs []domain.SubscriptionID, err error) *MockSubscriptionsRepository_RenewDue_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_RenewDue_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, time1 time.Time) ([]domain.SubscriptionID, error)) *MockSubscriptionsRepository_RenewDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceMembers provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) ReplaceMembers(context1 context.Context, connection domain.Connection, v domain.SubscriptionID, subscriptionMembers []domain.SubscriptionMember) error {
+	ret := _mock.Called(context1, connection, v, subscriptionMembers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceMembers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID, []domain.SubscriptionMember) error); ok {
+		r0 = returnFunc(context1, connection, v, subscriptionMembers)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionsRepository_ReplaceMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceMembers'
+type MockSubscriptionsRepository_ReplaceMembers_Call struct {
+	*mock.Call
+}
+
+// ReplaceMembers is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.SubscriptionID
+//   - subscriptionMembers []domain.SubscriptionMember
+func (_e *MockSubscriptionsRepository_Expecter) ReplaceMembers(context1 interface{}, connection interface{}, v interface{}, subscriptionMembers interface{}) *MockSubscriptionsRepository_ReplaceMembers_Call {
+	return &MockSubscriptionsRepository_ReplaceMembers_Call{Call: _e.mock.On("ReplaceMembers", context1, connection, v, subscriptionMembers)}
+}
+
+func (_c *MockSubscriptionsRepository_ReplaceMembers_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID, subscriptionMembers []domain.SubscriptionMember)) *MockSubscriptionsRepository_ReplaceMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.SubscriptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.SubscriptionID)
+		}
+		var arg3 []domain.SubscriptionMember
+		if args[3] != nil {
+			arg3 = args[3].([]domain.SubscriptionMember)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReplaceMembers_Call) Return(err error) *MockSubscriptionsRepository_ReplaceMembers_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_ReplaceMembers_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID, subscriptionMembers []domain.SubscriptionMember) error) *MockSubscriptionsRepository_ReplaceMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) Restore(context1 context.Context, connection domain.Connection, v domain.SubscriptionID) error {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID) error); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionsRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockSubscriptionsRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.SubscriptionID
+func (_e *MockSubscriptionsRepository_Expecter) Restore(context1 interface{}, connection interface{}, v interface{}) *MockSubscriptionsRepository_Restore_Call {
+	return &MockSubscriptionsRepository_Restore_Call{Call: _e.mock.On("Restore", context1, connection, v)}
+}
+
+func (_c *MockSubscriptionsRepository_Restore_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID)) *MockSubscriptionsRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.SubscriptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_Restore_Call) Return(err error) *MockSubscriptionsRepository_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_Restore_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID) error) *MockSubscriptionsRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revert provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) Revert(context1 context.Context, connection domain.Connection, subscription domain.Subscription) error {
+	ret := _mock.Called(context1, connection, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Subscription) error); ok {
+		r0 = returnFunc(context1, connection, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionsRepository_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
+type MockSubscriptionsRepository_Revert_Call struct {
+	*mock.Call
+}
+
+// Revert is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - subscription domain.Subscription
+func (_e *MockSubscriptionsRepository_Expecter) Revert(context1 interface{}, connection interface{}, subscription interface{}) *MockSubscriptionsRepository_Revert_Call {
+	return &MockSubscriptionsRepository_Revert_Call{Call: _e.mock.On("Revert", context1, connection, subscription)}
+}
+
+func (_c *MockSubscriptionsRepository_Revert_Call) Run(run func(context1 context.Context, connection domain.Connection, subscription domain.Subscription)) *MockSubscriptionsRepository_Revert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.Subscription
+		if args[2] != nil {
+			arg2 = args[2].(domain.Subscription)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_Revert_Call) Return(err error) *MockSubscriptionsRepository_Revert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_Revert_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, subscription domain.Subscription) error) *MockSubscriptionsRepository_Revert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferMembers provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) TransferMembers(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.UserID) ([]domain.SubscriptionID, error) {
+	ret := _mock.Called(context1, connection, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferMembers")
+	}
+
+	var r0 []domain.SubscriptionID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.UserID) ([]domain.SubscriptionID, error)); ok {
+		return returnFunc(context1, connection, v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.UserID) []domain.SubscriptionID); ok {
+		r0 = returnFunc(context1, connection, v, v1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SubscriptionID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.UserID) error); ok {
+		r1 = returnFunc(context1, connection, v, v1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_TransferMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferMembers'
+type MockSubscriptionsRepository_TransferMembers_Call struct {
+	*mock.Call
+}
+
+// TransferMembers is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.UserID
+//   - v1 domain.UserID
+func (_e *MockSubscriptionsRepository_Expecter) TransferMembers(context1 interface{}, connection interface{}, v interface{}, v1 interface{}) *MockSubscriptionsRepository_TransferMembers_Call {
+	return &MockSubscriptionsRepository_TransferMembers_Call{Call: _e.mock.On("TransferMembers", context1, connection, v, v1)}
+}
+
+func (_c *MockSubscriptionsRepository_TransferMembers_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.UserID)) *MockSubscriptionsRepository_TransferMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserID)
+		}
+		var arg3 domain.UserID
+		if args[3] != nil {
+			arg3 = args[3].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_TransferMembers_Call) Return(vs []domain.SubscriptionID, err error) *MockSubscriptionsRepository_TransferMembers_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_TransferMembers_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.UserID) ([]domain.SubscriptionID, error)) *MockSubscriptionsRepository_TransferMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferOwner provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) TransferOwner(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.UserID) ([]domain.SubscriptionID, error) {
+	ret := _mock.Called(context1, connection, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferOwner")
+	}
+
+	var r0 []domain.SubscriptionID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.UserID) ([]domain.SubscriptionID, error)); ok {
+		return returnFunc(context1, connection, v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.UserID) []domain.SubscriptionID); ok {
+		r0 = returnFunc(context1, connection, v, v1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SubscriptionID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, domain.UserID) error); ok {
+		r1 = returnFunc(context1, connection, v, v1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionsRepository_TransferOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferOwner'
+type MockSubscriptionsRepository_TransferOwner_Call struct {
+	*mock.Call
+}
+
+// TransferOwner is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.UserID
+//   - v1 domain.UserID
+func (_e *MockSubscriptionsRepository_Expecter) TransferOwner(context1 interface{}, connection interface{}, v interface{}, v1 interface{}) *MockSubscriptionsRepository_TransferOwner_Call {
+	return &MockSubscriptionsRepository_TransferOwner_Call{Call: _e.mock.On("TransferOwner", context1, connection, v, v1)}
+}
+
+func (_c *MockSubscriptionsRepository_TransferOwner_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.UserID)) *MockSubscriptionsRepository_TransferOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserID)
+		}
+		var arg3 domain.UserID
+		if args[3] != nil {
+			arg3 = args[3].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_TransferOwner_Call) Return(vs []domain.SubscriptionID, err error) *MockSubscriptionsRepository_TransferOwner_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_TransferOwner_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.UserID) ([]domain.SubscriptionID, error)) *MockSubscriptionsRepository_TransferOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Trash provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) Trash(context1 context.Context, connection domain.Connection, vs []domain.SubscriptionID) error {
+	ret := _mock.Called(context1, connection, vs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trash")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.SubscriptionID) error); ok {
+		r0 = returnFunc(context1, connection, vs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionsRepository_Trash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Trash'
+type MockSubscriptionsRepository_Trash_Call struct {
+	*mock.Call
+}
+
+// Trash is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - vs []domain.SubscriptionID
+func (_e *MockSubscriptionsRepository_Expecter) Trash(context1 interface{}, connection interface{}, vs interface{}) *MockSubscriptionsRepository_Trash_Call {
+	return &MockSubscriptionsRepository_Trash_Call{Call: _e.mock.On("Trash", context1, connection, vs)}
+}
+
+func (_c *MockSubscriptionsRepository_Trash_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.SubscriptionID)) *MockSubscriptionsRepository_Trash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.SubscriptionID
+		if args[2] != nil {
+			arg2 = args[2].([]domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_Trash_Call) Return(err error) *MockSubscriptionsRepository_Trash_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_Trash_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.SubscriptionID) error) *MockSubscriptionsRepository_Trash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) Update(context1 context.Context, connection domain.Connection, subscription domain.Subscription) error {
+	ret := _mock.Called(context1, connection, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Subscription) error); ok {
+		r0 = returnFunc(context1, connection, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionsRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockSubscriptionsRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - subscription domain.Subscription
+func (_e *MockSubscriptionsRepository_Expecter) Update(context1 interface{}, connection interface{}, subscription interface{}) *MockSubscriptionsRepository_Update_Call {
+	return &MockSubscriptionsRepository_Update_Call{Call: _e.mock.On("Update", context1, connection, subscription)}
+}
+
+func (_c *MockSubscriptionsRepository_Update_Call) Run(run func(context1 context.Context, connection domain.Connection, subscription domain.Subscription)) *MockSubscriptionsRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.Subscription
+		if args[2] != nil {
+			arg2 = args[2].(domain.Subscription)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_Update_Call) Return(err error) *MockSubscriptionsRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_Update_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, subscription domain.Subscription) error) *MockSubscriptionsRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertActual provides a mock function for the type MockSubscriptionsRepository
+func (_mock *MockSubscriptionsRepository) UpsertActual(context1 context.Context, connection domain.Connection, actualCharge domain.ActualCharge) error {
+	ret := _mock.Called(context1, connection, actualCharge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertActual")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ActualCharge) error); ok {
+		r0 = returnFunc(context1, connection, actualCharge)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionsRepository_UpsertActual_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertActual'
+type MockSubscriptionsRepository_UpsertActual_Call struct {
+	*mock.Call
+}
+
+// UpsertActual is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - actualCharge domain.ActualCharge
+func (_e *MockSubscriptionsRepository_Expecter) UpsertActual(context1 interface{}, connection interface{}, actualCharge interface{}) *MockSubscriptionsRepository_UpsertActual_Call {
+	return &MockSubscriptionsRepository_UpsertActual_Call{Call: _e.mock.On("UpsertActual", context1, connection, actualCharge)}
+}
+
+func (_c *MockSubscriptionsRepository_UpsertActual_Call) Run(run func(context1 context.Context, connection domain.Connection, actualCharge domain.ActualCharge)) *MockSubscriptionsRepository_UpsertActual_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ActualCharge
+		if args[2] != nil {
+			arg2 = args[2].(domain.ActualCharge)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_UpsertActual_Call) Return(err error) *MockSubscriptionsRepository_UpsertActual_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionsRepository_UpsertActual_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, actualCharge domain.ActualCharge) error) *MockSubscriptionsRepository_UpsertActual_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCatalogRepository creates a new instance of MockCatalogRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCatalogRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCatalogRepository {
+	mock := &MockCatalogRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCatalogRepository is an autogenerated mock type for the CatalogRepository type
+type MockCatalogRepository struct {
+	mock.Mock
+}
+
+type MockCatalogRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCatalogRepository) EXPECT() *MockCatalogRepository_Expecter {
+	return &MockCatalogRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockCatalogRepository
+func (_mock *MockCatalogRepository) Create(context1 context.Context, connection domain.Connection, catalogEntry domain.CatalogEntry) error {
+	ret := _mock.Called(context1, connection, catalogEntry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.CatalogEntry) error); ok {
+		r0 = returnFunc(context1, connection, catalogEntry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCatalogRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCatalogRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - catalogEntry domain.CatalogEntry
+func (_e *MockCatalogRepository_Expecter) Create(context1 interface{}, connection interface{}, catalogEntry interface{}) *MockCatalogRepository_Create_Call {
+	return &MockCatalogRepository_Create_Call{Call: _e.mock.On("Create", context1, connection, catalogEntry)}
+}
+
+func (_c *MockCatalogRepository_Create_Call) Run(run func(context1 context.Context, connection domain.Connection, catalogEntry domain.CatalogEntry)) *MockCatalogRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.CatalogEntry
+		if args[2] != nil {
+			arg2 = args[2].(domain.CatalogEntry)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogRepository_Create_Call) Return(err error) *MockCatalogRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCatalogRepository_Create_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, catalogEntry domain.CatalogEntry) error) *MockCatalogRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockCatalogRepository
+func (_mock *MockCatalogRepository) Delete(context1 context.Context, connection domain.Connection, v domain.CatalogEntryID) error {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.CatalogEntryID) error); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCatalogRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCatalogRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.CatalogEntryID
+func (_e *MockCatalogRepository_Expecter) Delete(context1 interface{}, connection interface{}, v interface{}) *MockCatalogRepository_Delete_Call {
+	return &MockCatalogRepository_Delete_Call{Call: _e.mock.On("Delete", context1, connection, v)}
+}
+
+func (_c *MockCatalogRepository_Delete_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.CatalogEntryID)) *MockCatalogRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.CatalogEntryID
+		if args[2] != nil {
+			arg2 = args[2].(domain.CatalogEntryID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogRepository_Delete_Call) Return(err error) *MockCatalogRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCatalogRepository_Delete_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.CatalogEntryID) error) *MockCatalogRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByNameOrAlias provides a mock function for the type MockCatalogRepository
+func (_mock *MockCatalogRepository) FindByNameOrAlias(context1 context.Context, connection domain.Connection, s string) ([]domain.CatalogEntry, error) {
+	ret := _mock.Called(context1, connection, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByNameOrAlias")
+	}
+
+	var r0 []domain.CatalogEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, string) ([]domain.CatalogEntry, error)); ok {
+		return returnFunc(context1, connection, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, string) []domain.CatalogEntry); ok {
+		r0 = returnFunc(context1, connection, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CatalogEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, string) error); ok {
+		r1 = returnFunc(context1, connection, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCatalogRepository_FindByNameOrAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByNameOrAlias'
+type MockCatalogRepository_FindByNameOrAlias_Call struct {
+	*mock.Call
+}
+
+// FindByNameOrAlias is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - s string
+func (_e *MockCatalogRepository_Expecter) FindByNameOrAlias(context1 interface{}, connection interface{}, s interface{}) *MockCatalogRepository_FindByNameOrAlias_Call {
+	return &MockCatalogRepository_FindByNameOrAlias_Call{Call: _e.mock.On("FindByNameOrAlias", context1, connection, s)}
+}
+
+func (_c *MockCatalogRepository_FindByNameOrAlias_Call) Run(run func(context1 context.Context, connection domain.Connection, s string)) *MockCatalogRepository_FindByNameOrAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogRepository_FindByNameOrAlias_Call) Return(catalogEntrys []domain.CatalogEntry, err error) *MockCatalogRepository_FindByNameOrAlias_Call {
+	_c.Call.Return(catalogEntrys, err)
+	return _c
+}
+
+func (_c *MockCatalogRepository_FindByNameOrAlias_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, s string) ([]domain.CatalogEntry, error)) *MockCatalogRepository_FindByNameOrAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockCatalogRepository
+func (_mock *MockCatalogRepository) GetByID(context1 context.Context, connection domain.Connection, v domain.CatalogEntryID) (domain.CatalogEntry, error) {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.CatalogEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.CatalogEntryID) (domain.CatalogEntry, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.CatalogEntryID) domain.CatalogEntry); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Get(0).(domain.CatalogEntry)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.CatalogEntryID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCatalogRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockCatalogRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.CatalogEntryID
+func (_e *MockCatalogRepository_Expecter) GetByID(context1 interface{}, connection interface{}, v interface{}) *MockCatalogRepository_GetByID_Call {
+	return &MockCatalogRepository_GetByID_Call{Call: _e.mock.On("GetByID", context1, connection, v)}
+}
+
+func (_c *MockCatalogRepository_GetByID_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.CatalogEntryID)) *MockCatalogRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.CatalogEntryID
+		if args[2] != nil {
+			arg2 = args[2].(domain.CatalogEntryID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogRepository_GetByID_Call) Return(catalogEntry domain.CatalogEntry, err error) *MockCatalogRepository_GetByID_Call {
+	_c.Call.Return(catalogEntry, err)
+	return _c
+}
+
+func (_c *MockCatalogRepository_GetByID_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.CatalogEntryID) (domain.CatalogEntry, error)) *MockCatalogRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockCatalogRepository
+func (_mock *MockCatalogRepository) List(context1 context.Context, connection domain.Connection) ([]domain.CatalogEntry, error) {
+	ret := _mock.Called(context1, connection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.CatalogEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection) ([]domain.CatalogEntry, error)); ok {
+		return returnFunc(context1, connection)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection) []domain.CatalogEntry); ok {
+		r0 = returnFunc(context1, connection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CatalogEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection) error); ok {
+		r1 = returnFunc(context1, connection)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCatalogRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockCatalogRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+func (_e *MockCatalogRepository_Expecter) List(context1 interface{}, connection interface{}) *MockCatalogRepository_List_Call {
+	return &MockCatalogRepository_List_Call{Call: _e.mock.On("List", context1, connection)}
+}
+
+func (_c *MockCatalogRepository_List_Call) Run(run func(context1 context.Context, connection domain.Connection)) *MockCatalogRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogRepository_List_Call) Return(catalogEntrys []domain.CatalogEntry, err error) *MockCatalogRepository_List_Call {
+	_c.Call.Return(catalogEntrys, err)
+	return _c
+}
+
+func (_c *MockCatalogRepository_List_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection) ([]domain.CatalogEntry, error)) *MockCatalogRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockCatalogRepository
+func (_mock *MockCatalogRepository) Search(context1 context.Context, connection domain.Connection, strings []string, f float64, n int) ([]domain.CatalogMatch, error) {
+	ret := _mock.Called(context1, connection, strings, f, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []domain.CatalogMatch
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []string, float64, int) ([]domain.CatalogMatch, error)); ok {
+		return returnFunc(context1, connection, strings, f, n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []string, float64, int) []domain.CatalogMatch); ok {
+		r0 = returnFunc(context1, connection, strings, f, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CatalogMatch)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []string, float64, int) error); ok {
+		r1 = returnFunc(context1, connection, strings, f, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCatalogRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockCatalogRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - strings []string
+//   - f float64
+//   - n int
+func (_e *MockCatalogRepository_Expecter) Search(context1 interface{}, connection interface{}, strings interface{}, f interface{}, n interface{}) *MockCatalogRepository_Search_Call {
+	return &MockCatalogRepository_Search_Call{Call: _e.mock.On("Search", context1, connection, strings, f, n)}
+}
+
+func (_c *MockCatalogRepository_Search_Call) Run(run func(context1 context.Context, connection domain.Connection, strings []string, f float64, n int)) *MockCatalogRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 float64
+		if args[3] != nil {
+			arg3 = args[3].(float64)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogRepository_Search_Call) Return(catalogMatchs []domain.CatalogMatch, err error) *MockCatalogRepository_Search_Call {
+	_c.Call.Return(catalogMatchs, err)
+	return _c
+}
+
+func (_c *MockCatalogRepository_Search_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, strings []string, f float64, n int) ([]domain.CatalogMatch, error)) *MockCatalogRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockCatalogRepository
+func (_mock *MockCatalogRepository) Update(context1 context.Context, connection domain.Connection, catalogEntry domain.CatalogEntry) error {
+	ret := _mock.Called(context1, connection, catalogEntry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.CatalogEntry) error); ok {
+		r0 = returnFunc(context1, connection, catalogEntry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCatalogRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCatalogRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - catalogEntry domain.CatalogEntry
+func (_e *MockCatalogRepository_Expecter) Update(context1 interface{}, connection interface{}, catalogEntry interface{}) *MockCatalogRepository_Update_Call {
+	return &MockCatalogRepository_Update_Call{Call: _e.mock.On("Update", context1, connection, catalogEntry)}
+}
+
+func (_c *MockCatalogRepository_Update_Call) Run(run func(context1 context.Context, connection domain.Connection, catalogEntry domain.CatalogEntry)) *MockCatalogRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.CatalogEntry
+		if args[2] != nil {
+			arg2 = args[2].(domain.CatalogEntry)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogRepository_Update_Call) Return(err error) *MockCatalogRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCatalogRepository_Update_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, catalogEntry domain.CatalogEntry) error) *MockCatalogRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuditRepository creates a new instance of MockAuditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuditRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuditRepository {
+	mock := &MockAuditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuditRepository is an autogenerated mock type for the AuditRepository type
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+type MockAuditRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditRepository) EXPECT() *MockAuditRepository_Expecter {
+	return &MockAuditRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListBySubscriptionID provides a mock function for the type MockAuditRepository
+func (_mock *MockAuditRepository) ListBySubscriptionID(context1 context.Context, connection domain.Connection, v domain.SubscriptionID) ([]domain.AuditEntry, error) {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBySubscriptionID")
+	}
+
+	var r0 []domain.AuditEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID) ([]domain.AuditEntry, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID) []domain.AuditEntry); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.SubscriptionID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditRepository_ListBySubscriptionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBySubscriptionID'
+type MockAuditRepository_ListBySubscriptionID_Call struct {
+	*mock.Call
+}
+
+// ListBySubscriptionID is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.SubscriptionID
+func (_e *MockAuditRepository_Expecter) ListBySubscriptionID(context1 interface{}, connection interface{}, v interface{}) *MockAuditRepository_ListBySubscriptionID_Call {
+	return &MockAuditRepository_ListBySubscriptionID_Call{Call: _e.mock.On("ListBySubscriptionID", context1, connection, v)}
+}
+
+func (_c *MockAuditRepository_ListBySubscriptionID_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID)) *MockAuditRepository_ListBySubscriptionID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.SubscriptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuditRepository_ListBySubscriptionID_Call) Return(auditEntrys []domain.AuditEntry, err error) *MockAuditRepository_ListBySubscriptionID_Call {
+	_c.Call.Return(auditEntrys, err)
+	return _c
+}
+
+func (_c *MockAuditRepository_ListBySubscriptionID_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID) ([]domain.AuditEntry, error)) *MockAuditRepository_ListBySubscriptionID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUndoable provides a mock function for the type MockAuditRepository
+func (_mock *MockAuditRepository) ReadUndoable(context1 context.Context, connection domain.Connection, v domain.UserID, time1 time.Time) ([]domain.AuditEntry, error) {
+	ret := _mock.Called(context1, connection, v, time1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUndoable")
+	}
+
+	var r0 []domain.AuditEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, time.Time) ([]domain.AuditEntry, error)); ok {
+		return returnFunc(context1, connection, v, time1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, time.Time) []domain.AuditEntry); ok {
+		r0 = returnFunc(context1, connection, v, time1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, time.Time) error); ok {
+		r1 = returnFunc(context1, connection, v, time1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditRepository_ReadUndoable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUndoable'
+type MockAuditRepository_ReadUndoable_Call struct {
+	*mock.Call
+}
+
+// ReadUndoable is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.UserID
+//   - time1 time.Time
+func (_e *MockAuditRepository_Expecter) ReadUndoable(context1 interface{}, connection interface{}, v interface{}, time1 interface{}) *MockAuditRepository_ReadUndoable_Call {
+	return &MockAuditRepository_ReadUndoable_Call{Call: _e.mock.On("ReadUndoable", context1, connection, v, time1)}
+}
+
+func (_c *MockAuditRepository_ReadUndoable_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, time1 time.Time)) *MockAuditRepository_ReadUndoable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuditRepository_ReadUndoable_Call) Return(auditEntrys []domain.AuditEntry, err error) *MockAuditRepository_ReadUndoable_Call {
+	_c.Call.Return(auditEntrys, err)
+	return _c
+}
+
+func (_c *MockAuditRepository_ReadUndoable_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, time1 time.Time) ([]domain.AuditEntry, error)) *MockAuditRepository_ReadUndoable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function for the type MockAuditRepository
+func (_mock *MockAuditRepository) Record(context1 context.Context, connection domain.Connection, auditEntry domain.AuditEntry) error {
+	ret := _mock.Called(context1, connection, auditEntry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.AuditEntry) error); ok {
+		r0 = returnFunc(context1, connection, auditEntry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuditRepository_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockAuditRepository_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - auditEntry domain.AuditEntry
+func (_e *MockAuditRepository_Expecter) Record(context1 interface{}, connection interface{}, auditEntry interface{}) *MockAuditRepository_Record_Call {
+	return &MockAuditRepository_Record_Call{Call: _e.mock.On("Record", context1, connection, auditEntry)}
+}
+
+func (_c *MockAuditRepository_Record_Call) Run(run func(context1 context.Context, connection domain.Connection, auditEntry domain.AuditEntry)) *MockAuditRepository_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.AuditEntry
+		if args[2] != nil {
+			arg2 = args[2].(domain.AuditEntry)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuditRepository_Record_Call) Return(err error) *MockAuditRepository_Record_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuditRepository_Record_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, auditEntry domain.AuditEntry) error) *MockAuditRepository_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockAuditRepository
+func (_mock *MockAuditRepository) Search(context1 context.Context, connection domain.Connection, auditFilter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	ret := _mock.Called(context1, connection, auditFilter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []domain.AuditEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.AuditFilter) ([]domain.AuditEntry, error)); ok {
+		return returnFunc(context1, connection, auditFilter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.AuditFilter) []domain.AuditEntry); ok {
+		r0 = returnFunc(context1, connection, auditFilter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.AuditFilter) error); ok {
+		r1 = returnFunc(context1, connection, auditFilter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockAuditRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - auditFilter domain.AuditFilter
+func (_e *MockAuditRepository_Expecter) Search(context1 interface{}, connection interface{}, auditFilter interface{}) *MockAuditRepository_Search_Call {
+	return &MockAuditRepository_Search_Call{Call: _e.mock.On("Search", context1, connection, auditFilter)}
+}
+
+func (_c *MockAuditRepository_Search_Call) Run(run func(context1 context.Context, connection domain.Connection, auditFilter domain.AuditFilter)) *MockAuditRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.AuditFilter
+		if args[2] != nil {
+			arg2 = args[2].(domain.AuditFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuditRepository_Search_Call) Return(auditEntrys []domain.AuditEntry, err error) *MockAuditRepository_Search_Call {
+	_c.Call.Return(auditEntrys, err)
+	return _c
+}
+
+func (_c *MockAuditRepository_Search_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, auditFilter domain.AuditFilter) ([]domain.AuditEntry, error)) *MockAuditRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBudgetRepository creates a new instance of MockBudgetRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBudgetRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBudgetRepository {
+	mock := &MockBudgetRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBudgetRepository is an autogenerated mock type for the BudgetRepository type
+type MockBudgetRepository struct {
+	mock.Mock
+}
+
+type MockBudgetRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBudgetRepository) EXPECT() *MockBudgetRepository_Expecter {
+	return &MockBudgetRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockBudgetRepository
+func (_mock *MockBudgetRepository) Create(context1 context.Context, connection domain.Connection, budget domain.Budget) error {
+	ret := _mock.Called(context1, connection, budget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Budget) error); ok {
+		r0 = returnFunc(context1, connection, budget)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockBudgetRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - budget domain.Budget
+func (_e *MockBudgetRepository_Expecter) Create(context1 interface{}, connection interface{}, budget interface{}) *MockBudgetRepository_Create_Call {
+	return &MockBudgetRepository_Create_Call{Call: _e.mock.On("Create", context1, connection, budget)}
+}
+
+func (_c *MockBudgetRepository_Create_Call) Run(run func(context1 context.Context, connection domain.Connection, budget domain.Budget)) *MockBudgetRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.Budget
+		if args[2] != nil {
+			arg2 = args[2].(domain.Budget)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetRepository_Create_Call) Return(err error) *MockBudgetRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetRepository_Create_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, budget domain.Budget) error) *MockBudgetRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockBudgetRepository
+func (_mock *MockBudgetRepository) Delete(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.BudgetID) error {
+	ret := _mock.Called(context1, connection, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, domain.BudgetID) error); ok {
+		r0 = returnFunc(context1, connection, v, v1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockBudgetRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.UserID
+//   - v1 domain.BudgetID
+func (_e *MockBudgetRepository_Expecter) Delete(context1 interface{}, connection interface{}, v interface{}, v1 interface{}) *MockBudgetRepository_Delete_Call {
+	return &MockBudgetRepository_Delete_Call{Call: _e.mock.On("Delete", context1, connection, v, v1)}
+}
+
+func (_c *MockBudgetRepository_Delete_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.BudgetID)) *MockBudgetRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserID)
+		}
+		var arg3 domain.BudgetID
+		if args[3] != nil {
+			arg3 = args[3].(domain.BudgetID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetRepository_Delete_Call) Return(err error) *MockBudgetRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetRepository_Delete_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, v1 domain.BudgetID) error) *MockBudgetRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUserID provides a mock function for the type MockBudgetRepository
+func (_mock *MockBudgetRepository) ListByUserID(context1 context.Context, connection domain.Connection, v domain.UserID) ([]domain.Budget, error) {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserID")
+	}
+
+	var r0 []domain.Budget
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) ([]domain.Budget, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID) []domain.Budget); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Budget)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetRepository_ListByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUserID'
+type MockBudgetRepository_ListByUserID_Call struct {
+	*mock.Call
+}
+
+// ListByUserID is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.UserID
+func (_e *MockBudgetRepository_Expecter) ListByUserID(context1 interface{}, connection interface{}, v interface{}) *MockBudgetRepository_ListByUserID_Call {
+	return &MockBudgetRepository_ListByUserID_Call{Call: _e.mock.On("ListByUserID", context1, connection, v)}
+}
+
+func (_c *MockBudgetRepository_ListByUserID_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID)) *MockBudgetRepository_ListByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetRepository_ListByUserID_Call) Return(budgets []domain.Budget, err error) *MockBudgetRepository_ListByUserID_Call {
+	_c.Call.Return(budgets, err)
+	return _c
+}
+
+func (_c *MockBudgetRepository_ListByUserID_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID) ([]domain.Budget, error)) *MockBudgetRepository_ListByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBudgetEventPublisher creates a new instance of MockBudgetEventPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBudgetEventPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBudgetEventPublisher {
+	mock := &MockBudgetEventPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBudgetEventPublisher is an autogenerated mock type for the BudgetEventPublisher type
+type MockBudgetEventPublisher struct {
+	mock.Mock
+}
+
+type MockBudgetEventPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBudgetEventPublisher) EXPECT() *MockBudgetEventPublisher_Expecter {
+	return &MockBudgetEventPublisher_Expecter{mock: &_m.Mock}
+}
+
+// PublishBudgetBreach provides a mock function for the type MockBudgetEventPublisher
+func (_mock *MockBudgetEventPublisher) PublishBudgetBreach(context1 context.Context, budgetBreach domain.BudgetBreach) error {
+	ret := _mock.Called(context1, budgetBreach)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishBudgetBreach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.BudgetBreach) error); ok {
+		r0 = returnFunc(context1, budgetBreach)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetEventPublisher_PublishBudgetBreach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishBudgetBreach'
+type MockBudgetEventPublisher_PublishBudgetBreach_Call struct {
+	*mock.Call
+}
+
+// PublishBudgetBreach is a helper method to define mock.On call
+//   - context1 context.Context
+//   - budgetBreach domain.BudgetBreach
+func (_e *MockBudgetEventPublisher_Expecter) PublishBudgetBreach(context1 interface{}, budgetBreach interface{}) *MockBudgetEventPublisher_PublishBudgetBreach_Call {
+	return &MockBudgetEventPublisher_PublishBudgetBreach_Call{Call: _e.mock.On("PublishBudgetBreach", context1, budgetBreach)}
+}
+
+func (_c *MockBudgetEventPublisher_PublishBudgetBreach_Call) Run(run func(context1 context.Context, budgetBreach domain.BudgetBreach)) *MockBudgetEventPublisher_PublishBudgetBreach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.BudgetBreach
+		if args[1] != nil {
+			arg1 = args[1].(domain.BudgetBreach)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetEventPublisher_PublishBudgetBreach_Call) Return(err error) *MockBudgetEventPublisher_PublishBudgetBreach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetEventPublisher_PublishBudgetBreach_Call) RunAndReturn(run func(context1 context.Context, budgetBreach domain.BudgetBreach) error) *MockBudgetEventPublisher_PublishBudgetBreach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAnalyticsRepository creates a new instance of MockAnalyticsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnalyticsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnalyticsRepository {
+	mock := &MockAnalyticsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAnalyticsRepository is an autogenerated mock type for the AnalyticsRepository type
+type MockAnalyticsRepository struct {
+	mock.Mock
+}
+
+type MockAnalyticsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnalyticsRepository) EXPECT() *MockAnalyticsRepository_Expecter {
+	return &MockAnalyticsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Churn provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) Churn(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time, v *domain.ServiceName) ([]domain.ServiceChurn, error) {
+	ret := _mock.Called(context1, connection, time1, time11, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Churn")
+	}
+
+	var r0 []domain.ServiceChurn
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time, *domain.ServiceName) ([]domain.ServiceChurn, error)); ok {
+		return returnFunc(context1, connection, time1, time11, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time, *domain.ServiceName) []domain.ServiceChurn); ok {
+		r0 = returnFunc(context1, connection, time1, time11, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ServiceChurn)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time, time.Time, *domain.ServiceName) error); ok {
+		r1 = returnFunc(context1, connection, time1, time11, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_Churn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Churn'
+type MockAnalyticsRepository_Churn_Call struct {
+	*mock.Call
+}
+
+// Churn is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - time1 time.Time
+//   - time11 time.Time
+//   - v *domain.ServiceName
+func (_e *MockAnalyticsRepository_Expecter) Churn(context1 interface{}, connection interface{}, time1 interface{}, time11 interface{}, v interface{}) *MockAnalyticsRepository_Churn_Call {
+	return &MockAnalyticsRepository_Churn_Call{Call: _e.mock.On("Churn", context1, connection, time1, time11, v)}
+}
+
+func (_c *MockAnalyticsRepository_Churn_Call) Run(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time, v *domain.ServiceName)) *MockAnalyticsRepository_Churn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 *domain.ServiceName
+		if args[4] != nil {
+			arg4 = args[4].(*domain.ServiceName)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Churn_Call) Return(serviceChurns []domain.ServiceChurn, err error) *MockAnalyticsRepository_Churn_Call {
+	_c.Call.Return(serviceChurns, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Churn_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time, v *domain.ServiceName) ([]domain.ServiceChurn, error)) *MockAnalyticsRepository_Churn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChurnReasons provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) ChurnReasons(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time, v *domain.ServiceName) ([]domain.ChurnReason, error) {
+	ret := _mock.Called(context1, connection, time1, time11, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChurnReasons")
+	}
+
+	var r0 []domain.ChurnReason
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time, *domain.ServiceName) ([]domain.ChurnReason, error)); ok {
+		return returnFunc(context1, connection, time1, time11, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time, *domain.ServiceName) []domain.ChurnReason); ok {
+		r0 = returnFunc(context1, connection, time1, time11, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ChurnReason)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time, time.Time, *domain.ServiceName) error); ok {
+		r1 = returnFunc(context1, connection, time1, time11, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_ChurnReasons_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChurnReasons'
+type MockAnalyticsRepository_ChurnReasons_Call struct {
+	*mock.Call
+}
+
+// ChurnReasons is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - time1 time.Time
+//   - time11 time.Time
+//   - v *domain.ServiceName
+func (_e *MockAnalyticsRepository_Expecter) ChurnReasons(context1 interface{}, connection interface{}, time1 interface{}, time11 interface{}, v interface{}) *MockAnalyticsRepository_ChurnReasons_Call {
+	return &MockAnalyticsRepository_ChurnReasons_Call{Call: _e.mock.On("ChurnReasons", context1, connection, time1, time11, v)}
+}
+
+func (_c *MockAnalyticsRepository_ChurnReasons_Call) Run(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time, v *domain.ServiceName)) *MockAnalyticsRepository_ChurnReasons_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 *domain.ServiceName
+		if args[4] != nil {
+			arg4 = args[4].(*domain.ServiceName)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_ChurnReasons_Call) Return(churnReasons []domain.ChurnReason, err error) *MockAnalyticsRepository_ChurnReasons_Call {
+	_c.Call.Return(churnReasons, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_ChurnReasons_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time, v *domain.ServiceName) ([]domain.ChurnReason, error)) *MockAnalyticsRepository_ChurnReasons_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Flow provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) Flow(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time) ([]domain.SubscriptionFlow, error) {
+	ret := _mock.Called(context1, connection, time1, time11)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Flow")
+	}
+
+	var r0 []domain.SubscriptionFlow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time) ([]domain.SubscriptionFlow, error)); ok {
+		return returnFunc(context1, connection, time1, time11)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time) []domain.SubscriptionFlow); ok {
+		r0 = returnFunc(context1, connection, time1, time11)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SubscriptionFlow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time, time.Time) error); ok {
+		r1 = returnFunc(context1, connection, time1, time11)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_Flow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flow'
+type MockAnalyticsRepository_Flow_Call struct {
+	*mock.Call
+}
+
+// Flow is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - time1 time.Time
+//   - time11 time.Time
+func (_e *MockAnalyticsRepository_Expecter) Flow(context1 interface{}, connection interface{}, time1 interface{}, time11 interface{}) *MockAnalyticsRepository_Flow_Call {
+	return &MockAnalyticsRepository_Flow_Call{Call: _e.mock.On("Flow", context1, connection, time1, time11)}
+}
+
+func (_c *MockAnalyticsRepository_Flow_Call) Run(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time)) *MockAnalyticsRepository_Flow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Flow_Call) Return(subscriptionFlows []domain.SubscriptionFlow, err error) *MockAnalyticsRepository_Flow_Call {
+	_c.Call.Return(subscriptionFlows, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Flow_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time) ([]domain.SubscriptionFlow, error)) *MockAnalyticsRepository_Flow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MedianJumps provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) MedianJumps(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time, f float64, n int) ([]domain.PriceAnomaly, error) {
+	ret := _mock.Called(context1, connection, time1, time11, f, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MedianJumps")
+	}
+
+	var r0 []domain.PriceAnomaly
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time, float64, int) ([]domain.PriceAnomaly, error)); ok {
+		return returnFunc(context1, connection, time1, time11, f, n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time, float64, int) []domain.PriceAnomaly); ok {
+		r0 = returnFunc(context1, connection, time1, time11, f, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PriceAnomaly)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time, time.Time, float64, int) error); ok {
+		r1 = returnFunc(context1, connection, time1, time11, f, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_MedianJumps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MedianJumps'
+type MockAnalyticsRepository_MedianJumps_Call struct {
+	*mock.Call
+}
+
+// MedianJumps is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - time1 time.Time
+//   - time11 time.Time
+//   - f float64
+//   - n int
+func (_e *MockAnalyticsRepository_Expecter) MedianJumps(context1 interface{}, connection interface{}, time1 interface{}, time11 interface{}, f interface{}, n interface{}) *MockAnalyticsRepository_MedianJumps_Call {
+	return &MockAnalyticsRepository_MedianJumps_Call{Call: _e.mock.On("MedianJumps", context1, connection, time1, time11, f, n)}
+}
+
+func (_c *MockAnalyticsRepository_MedianJumps_Call) Run(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time, f float64, n int)) *MockAnalyticsRepository_MedianJumps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 float64
+		if args[4] != nil {
+			arg4 = args[4].(float64)
+		}
+		var arg5 int
+		if args[5] != nil {
+			arg5 = args[5].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_MedianJumps_Call) Return(priceAnomalys []domain.PriceAnomaly, err error) *MockAnalyticsRepository_MedianJumps_Call {
+	_c.Call.Return(priceAnomalys, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_MedianJumps_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time, f float64, n int) ([]domain.PriceAnomaly, error)) *MockAnalyticsRepository_MedianJumps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PriceOutliers provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) PriceOutliers(context1 context.Context, connection domain.Connection, v *domain.UserID, time1 time.Time, f float64, n int) ([]domain.PriceAnomaly, error) {
+	ret := _mock.Called(context1, connection, v, time1, f, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceOutliers")
+	}
+
+	var r0 []domain.PriceAnomaly
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, *domain.UserID, time.Time, float64, int) ([]domain.PriceAnomaly, error)); ok {
+		return returnFunc(context1, connection, v, time1, f, n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, *domain.UserID, time.Time, float64, int) []domain.PriceAnomaly); ok {
+		r0 = returnFunc(context1, connection, v, time1, f, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PriceAnomaly)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, *domain.UserID, time.Time, float64, int) error); ok {
+		r1 = returnFunc(context1, connection, v, time1, f, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_PriceOutliers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PriceOutliers'
+type MockAnalyticsRepository_PriceOutliers_Call struct {
+	*mock.Call
+}
+
+// PriceOutliers is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v *domain.UserID
+//   - time1 time.Time
+//   - f float64
+//   - n int
+func (_e *MockAnalyticsRepository_Expecter) PriceOutliers(context1 interface{}, connection interface{}, v interface{}, time1 interface{}, f interface{}, n interface{}) *MockAnalyticsRepository_PriceOutliers_Call {
+	return &MockAnalyticsRepository_PriceOutliers_Call{Call: _e.mock.On("PriceOutliers", context1, connection, v, time1, f, n)}
+}
+
+func (_c *MockAnalyticsRepository_PriceOutliers_Call) Run(run func(context1 context.Context, connection domain.Connection, v *domain.UserID, time1 time.Time, f float64, n int)) *MockAnalyticsRepository_PriceOutliers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 *domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(*domain.UserID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 float64
+		if args[4] != nil {
+			arg4 = args[4].(float64)
+		}
+		var arg5 int
+		if args[5] != nil {
+			arg5 = args[5].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_PriceOutliers_Call) Return(priceAnomalys []domain.PriceAnomaly, err error) *MockAnalyticsRepository_PriceOutliers_Call {
+	_c.Call.Return(priceAnomalys, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_PriceOutliers_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v *domain.UserID, time1 time.Time, f float64, n int) ([]domain.PriceAnomaly, error)) *MockAnalyticsRepository_PriceOutliers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Prices provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) Prices(context1 context.Context, connection domain.Connection) ([]domain.ServicePrice, error) {
+	ret := _mock.Called(context1, connection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Prices")
+	}
+
+	var r0 []domain.ServicePrice
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection) ([]domain.ServicePrice, error)); ok {
+		return returnFunc(context1, connection)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection) []domain.ServicePrice); ok {
+		r0 = returnFunc(context1, connection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ServicePrice)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection) error); ok {
+		r1 = returnFunc(context1, connection)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_Prices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prices'
+type MockAnalyticsRepository_Prices_Call struct {
+	*mock.Call
+}
+
+// Prices is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+func (_e *MockAnalyticsRepository_Expecter) Prices(context1 interface{}, connection interface{}) *MockAnalyticsRepository_Prices_Call {
+	return &MockAnalyticsRepository_Prices_Call{Call: _e.mock.On("Prices", context1, connection)}
+}
+
+func (_c *MockAnalyticsRepository_Prices_Call) Run(run func(context1 context.Context, connection domain.Connection)) *MockAnalyticsRepository_Prices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Prices_Call) Return(servicePrices []domain.ServicePrice, err error) *MockAnalyticsRepository_Prices_Call {
+	_c.Call.Return(servicePrices, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Prices_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection) ([]domain.ServicePrice, error)) *MockAnalyticsRepository_Prices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retention provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) Retention(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time, v *domain.ServiceName) ([]domain.CohortMonth, error) {
+	ret := _mock.Called(context1, connection, time1, time11, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retention")
+	}
+
+	var r0 []domain.CohortMonth
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time, *domain.ServiceName) ([]domain.CohortMonth, error)); ok {
+		return returnFunc(context1, connection, time1, time11, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time, *domain.ServiceName) []domain.CohortMonth); ok {
+		r0 = returnFunc(context1, connection, time1, time11, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CohortMonth)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time, time.Time, *domain.ServiceName) error); ok {
+		r1 = returnFunc(context1, connection, time1, time11, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_Retention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retention'
+type MockAnalyticsRepository_Retention_Call struct {
+	*mock.Call
+}
+
+// Retention is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - time1 time.Time
+//   - time11 time.Time
+//   - v *domain.ServiceName
+func (_e *MockAnalyticsRepository_Expecter) Retention(context1 interface{}, connection interface{}, time1 interface{}, time11 interface{}, v interface{}) *MockAnalyticsRepository_Retention_Call {
+	return &MockAnalyticsRepository_Retention_Call{Call: _e.mock.On("Retention", context1, connection, time1, time11, v)}
+}
+
+func (_c *MockAnalyticsRepository_Retention_Call) Run(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time, v *domain.ServiceName)) *MockAnalyticsRepository_Retention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 *domain.ServiceName
+		if args[4] != nil {
+			arg4 = args[4].(*domain.ServiceName)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Retention_Call) Return(cohortMonths []domain.CohortMonth, err error) *MockAnalyticsRepository_Retention_Call {
+	_c.Call.Return(cohortMonths, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Retention_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time, v *domain.ServiceName) ([]domain.CohortMonth, error)) *MockAnalyticsRepository_Retention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revenue provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) Revenue(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time) ([]domain.ServiceRevenue, error) {
+	ret := _mock.Called(context1, connection, time1, time11)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revenue")
+	}
+
+	var r0 []domain.ServiceRevenue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time) ([]domain.ServiceRevenue, error)); ok {
+		return returnFunc(context1, connection, time1, time11)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time) []domain.ServiceRevenue); ok {
+		r0 = returnFunc(context1, connection, time1, time11)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ServiceRevenue)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time, time.Time) error); ok {
+		r1 = returnFunc(context1, connection, time1, time11)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_Revenue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revenue'
+type MockAnalyticsRepository_Revenue_Call struct {
+	*mock.Call
+}
+
+// Revenue is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - time1 time.Time
+//   - time11 time.Time
+func (_e *MockAnalyticsRepository_Expecter) Revenue(context1 interface{}, connection interface{}, time1 interface{}, time11 interface{}) *MockAnalyticsRepository_Revenue_Call {
+	return &MockAnalyticsRepository_Revenue_Call{Call: _e.mock.On("Revenue", context1, connection, time1, time11)}
+}
+
+func (_c *MockAnalyticsRepository_Revenue_Call) Run(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time)) *MockAnalyticsRepository_Revenue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Revenue_Call) Return(serviceRevenues []domain.ServiceRevenue, err error) *MockAnalyticsRepository_Revenue_Call {
+	_c.Call.Return(serviceRevenues, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Revenue_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time) ([]domain.ServiceRevenue, error)) *MockAnalyticsRepository_Revenue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TopServices provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) TopServices(context1 context.Context, connection domain.Connection, time1 time.Time, n int) ([]domain.ServiceSubscribers, error) {
+	ret := _mock.Called(context1, connection, time1, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TopServices")
+	}
+
+	var r0 []domain.ServiceSubscribers
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, int) ([]domain.ServiceSubscribers, error)); ok {
+		return returnFunc(context1, connection, time1, n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, int) []domain.ServiceSubscribers); ok {
+		r0 = returnFunc(context1, connection, time1, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ServiceSubscribers)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time, int) error); ok {
+		r1 = returnFunc(context1, connection, time1, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_TopServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TopServices'
+type MockAnalyticsRepository_TopServices_Call struct {
+	*mock.Call
+}
+
+// TopServices is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - time1 time.Time
+//   - n int
+func (_e *MockAnalyticsRepository_Expecter) TopServices(context1 interface{}, connection interface{}, time1 interface{}, n interface{}) *MockAnalyticsRepository_TopServices_Call {
+	return &MockAnalyticsRepository_TopServices_Call{Call: _e.mock.On("TopServices", context1, connection, time1, n)}
+}
+
+func (_c *MockAnalyticsRepository_TopServices_Call) Run(run func(context1 context.Context, connection domain.Connection, time1 time.Time, n int)) *MockAnalyticsRepository_TopServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_TopServices_Call) Return(serviceSubscriberss []domain.ServiceSubscribers, err error) *MockAnalyticsRepository_TopServices_Call {
+	_c.Call.Return(serviceSubscriberss, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_TopServices_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, time1 time.Time, n int) ([]domain.ServiceSubscribers, error)) *MockAnalyticsRepository_TopServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockQualityRepository creates a new instance of MockQualityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockQualityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockQualityRepository {
+	mock := &MockQualityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockQualityRepository is an autogenerated mock type for the QualityRepository type
+type MockQualityRepository struct {
+	mock.Mock
+}
+
+type MockQualityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockQualityRepository) EXPECT() *MockQualityRepository_Expecter {
+	return &MockQualityRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindAnomalies provides a mock function for the type MockQualityRepository
+func (_mock *MockQualityRepository) FindAnomalies(context1 context.Context, connection domain.Connection) ([]domain.Anomaly, error) {
+	ret := _mock.Called(context1, connection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAnomalies")
+	}
+
+	var r0 []domain.Anomaly
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection) ([]domain.Anomaly, error)); ok {
+		return returnFunc(context1, connection)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection) []domain.Anomaly); ok {
+		r0 = returnFunc(context1, connection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Anomaly)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection) error); ok {
+		r1 = returnFunc(context1, connection)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQualityRepository_FindAnomalies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAnomalies'
+type MockQualityRepository_FindAnomalies_Call struct {
+	*mock.Call
+}
+
+// FindAnomalies is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+func (_e *MockQualityRepository_Expecter) FindAnomalies(context1 interface{}, connection interface{}) *MockQualityRepository_FindAnomalies_Call {
+	return &MockQualityRepository_FindAnomalies_Call{Call: _e.mock.On("FindAnomalies", context1, connection)}
+}
+
+func (_c *MockQualityRepository_FindAnomalies_Call) Run(run func(context1 context.Context, connection domain.Connection)) *MockQualityRepository_FindAnomalies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQualityRepository_FindAnomalies_Call) Return(anomalys []domain.Anomaly, err error) *MockQualityRepository_FindAnomalies_Call {
+	_c.Call.Return(anomalys, err)
+	return _c
+}
+
+func (_c *MockQualityRepository_FindAnomalies_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection) ([]domain.Anomaly, error)) *MockQualityRepository_FindAnomalies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fix provides a mock function for the type MockQualityRepository
+func (_mock *MockQualityRepository) Fix(context1 context.Context, connection domain.Connection, anomalyType domain.AnomalyType) ([]domain.SubscriptionID, error) {
+	ret := _mock.Called(context1, connection, anomalyType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fix")
+	}
+
+	var r0 []domain.SubscriptionID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.AnomalyType) ([]domain.SubscriptionID, error)); ok {
+		return returnFunc(context1, connection, anomalyType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.AnomalyType) []domain.SubscriptionID); ok {
+		r0 = returnFunc(context1, connection, anomalyType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SubscriptionID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.AnomalyType) error); ok {
+		r1 = returnFunc(context1, connection, anomalyType)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQualityRepository_Fix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fix'
+type MockQualityRepository_Fix_Call struct {
+	*mock.Call
+}
+
+// Fix is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - anomalyType domain.AnomalyType
+func (_e *MockQualityRepository_Expecter) Fix(context1 interface{}, connection interface{}, anomalyType interface{}) *MockQualityRepository_Fix_Call {
+	return &MockQualityRepository_Fix_Call{Call: _e.mock.On("Fix", context1, connection, anomalyType)}
+}
+
+func (_c *MockQualityRepository_Fix_Call) Run(run func(context1 context.Context, connection domain.Connection, anomalyType domain.AnomalyType)) *MockQualityRepository_Fix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.AnomalyType
+		if args[2] != nil {
+			arg2 = args[2].(domain.AnomalyType)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQualityRepository_Fix_Call) Return(vs []domain.SubscriptionID, err error) *MockQualityRepository_Fix_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockQualityRepository_Fix_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, anomalyType domain.AnomalyType) ([]domain.SubscriptionID, error)) *MockQualityRepository_Fix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLedgerRepository creates a new instance of MockLedgerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLedgerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLedgerRepository {
+	mock := &MockLedgerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLedgerRepository is an autogenerated mock type for the LedgerRepository type
+type MockLedgerRepository struct {
+	mock.Mock
+}
+
+type MockLedgerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLedgerRepository) EXPECT() *MockLedgerRepository_Expecter {
+	return &MockLedgerRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteBySubscriptionID provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) DeleteBySubscriptionID(context1 context.Context, connection domain.Connection, v domain.SubscriptionID) error {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBySubscriptionID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID) error); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLedgerRepository_DeleteBySubscriptionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBySubscriptionID'
+type MockLedgerRepository_DeleteBySubscriptionID_Call struct {
+	*mock.Call
+}
+
+// DeleteBySubscriptionID is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.SubscriptionID
+func (_e *MockLedgerRepository_Expecter) DeleteBySubscriptionID(context1 interface{}, connection interface{}, v interface{}) *MockLedgerRepository_DeleteBySubscriptionID_Call {
+	return &MockLedgerRepository_DeleteBySubscriptionID_Call{Call: _e.mock.On("DeleteBySubscriptionID", context1, connection, v)}
+}
+
+func (_c *MockLedgerRepository_DeleteBySubscriptionID_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID)) *MockLedgerRepository_DeleteBySubscriptionID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.SubscriptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_DeleteBySubscriptionID_Call) Return(err error) *MockLedgerRepository_DeleteBySubscriptionID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_DeleteBySubscriptionID_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID) error) *MockLedgerRepository_DeleteBySubscriptionID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInPeriod provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) DeleteInPeriod(context1 context.Context, connection domain.Connection, v *domain.UserID, time1 time.Time, time11 time.Time) error {
+	ret := _mock.Called(context1, connection, v, time1, time11)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInPeriod")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, *domain.UserID, time.Time, time.Time) error); ok {
+		r0 = returnFunc(context1, connection, v, time1, time11)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLedgerRepository_DeleteInPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInPeriod'
+type MockLedgerRepository_DeleteInPeriod_Call struct {
+	*mock.Call
+}
+
+// DeleteInPeriod is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v *domain.UserID
+//   - time1 time.Time
+//   - time11 time.Time
+func (_e *MockLedgerRepository_Expecter) DeleteInPeriod(context1 interface{}, connection interface{}, v interface{}, time1 interface{}, time11 interface{}) *MockLedgerRepository_DeleteInPeriod_Call {
+	return &MockLedgerRepository_DeleteInPeriod_Call{Call: _e.mock.On("DeleteInPeriod", context1, connection, v, time1, time11)}
+}
+
+func (_c *MockLedgerRepository_DeleteInPeriod_Call) Run(run func(context1 context.Context, connection domain.Connection, v *domain.UserID, time1 time.Time, time11 time.Time)) *MockLedgerRepository_DeleteInPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 *domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(*domain.UserID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_DeleteInPeriod_Call) Return(err error) *MockLedgerRepository_DeleteInPeriod_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_DeleteInPeriod_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v *domain.UserID, time1 time.Time, time11 time.Time) error) *MockLedgerRepository_DeleteInPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) Insert(context1 context.Context, connection domain.Connection, charges []domain.Charge) error {
+	ret := _mock.Called(context1, connection, charges)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.Charge) error); ok {
+		r0 = returnFunc(context1, connection, charges)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLedgerRepository_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockLedgerRepository_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - charges []domain.Charge
+func (_e *MockLedgerRepository_Expecter) Insert(context1 interface{}, connection interface{}, charges interface{}) *MockLedgerRepository_Insert_Call {
+	return &MockLedgerRepository_Insert_Call{Call: _e.mock.On("Insert", context1, connection, charges)}
+}
+
+func (_c *MockLedgerRepository_Insert_Call) Run(run func(context1 context.Context, connection domain.Connection, charges []domain.Charge)) *MockLedgerRepository_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.Charge
+		if args[2] != nil {
+			arg2 = args[2].([]domain.Charge)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_Insert_Call) Return(err error) *MockLedgerRepository_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_Insert_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, charges []domain.Charge) error) *MockLedgerRepository_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Payers provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) Payers(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time) ([]domain.UserID, error) {
+	ret := _mock.Called(context1, connection, time1, time11)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Payers")
+	}
+
+	var r0 []domain.UserID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time) ([]domain.UserID, error)); ok {
+		return returnFunc(context1, connection, time1, time11)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time) []domain.UserID); ok {
+		r0 = returnFunc(context1, connection, time1, time11)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.UserID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time, time.Time) error); ok {
+		r1 = returnFunc(context1, connection, time1, time11)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLedgerRepository_Payers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Payers'
+type MockLedgerRepository_Payers_Call struct {
+	*mock.Call
+}
+
+// Payers is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - time1 time.Time
+//   - time11 time.Time
+func (_e *MockLedgerRepository_Expecter) Payers(context1 interface{}, connection interface{}, time1 interface{}, time11 interface{}) *MockLedgerRepository_Payers_Call {
+	return &MockLedgerRepository_Payers_Call{Call: _e.mock.On("Payers", context1, connection, time1, time11)}
+}
+
+func (_c *MockLedgerRepository_Payers_Call) Run(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time)) *MockLedgerRepository_Payers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_Payers_Call) Return(vs []domain.UserID, err error) *MockLedgerRepository_Payers_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_Payers_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, time1 time.Time, time11 time.Time) ([]domain.UserID, error)) *MockLedgerRepository_Payers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function for the type MockLedgerRepository
+func (_mock *MockLedgerRepository) Read(context1 context.Context, connection domain.Connection, chargeQuery domain.ChargeQuery) ([]domain.Charge, error) {
+	ret := _mock.Called(context1, connection, chargeQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 []domain.Charge
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ChargeQuery) ([]domain.Charge, error)); ok {
+		return returnFunc(context1, connection, chargeQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ChargeQuery) []domain.Charge); ok {
+		r0 = returnFunc(context1, connection, chargeQuery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Charge)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.ChargeQuery) error); ok {
+		r1 = returnFunc(context1, connection, chargeQuery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLedgerRepository_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockLedgerRepository_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - chargeQuery domain.ChargeQuery
+func (_e *MockLedgerRepository_Expecter) Read(context1 interface{}, connection interface{}, chargeQuery interface{}) *MockLedgerRepository_Read_Call {
+	return &MockLedgerRepository_Read_Call{Call: _e.mock.On("Read", context1, connection, chargeQuery)}
+}
+
+func (_c *MockLedgerRepository_Read_Call) Run(run func(context1 context.Context, connection domain.Connection, chargeQuery domain.ChargeQuery)) *MockLedgerRepository_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ChargeQuery
+		if args[2] != nil {
+			arg2 = args[2].(domain.ChargeQuery)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_Read_Call) Return(charges []domain.Charge, err error) *MockLedgerRepository_Read_Call {
+	_c.Call.Return(charges, err)
+	return _c
+}
+
+func (_c *MockLedgerRepository_Read_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, chargeQuery domain.ChargeQuery) ([]domain.Charge, error)) *MockLedgerRepository_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConnection creates a new instance of MockConnection. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConnection(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConnection {
+	mock := &MockConnection{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConnection is an autogenerated mock type for the Connection type
+type MockConnection struct {
+	mock.Mock
+}
+
+type MockConnection_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConnection) EXPECT() *MockConnection_Expecter {
 	return &MockConnection_Expecter{mock: &_m.Mock}
 }
 
-// ExecContext provides a mock function for the type MockConnection
-func (_mock *MockConnection) ExecContext(context1 context.Context, s string, vs ...any) (int64, error) {
-	var tmpRet mock.Arguments
-	if len(vs) > 0 {
-		tmpRet = _mock.Called(context1, s, vs)
+// ExecContext provides a mock function for the type MockConnection
+func (_mock *MockConnection) ExecContext(context1 context.Context, s string, vs ...any) (int64, error) {
+	var tmpRet mock.Arguments
+	if len(vs) > 0 {
+		tmpRet = _mock.Called(context1, s, vs)
+	} else {
+		tmpRet = _mock.Called(context1, s)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecContext")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ...any) (int64, error)); ok {
+		return returnFunc(context1, s, vs...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ...any) int64); ok {
+		r0 = returnFunc(context1, s, vs...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, ...any) error); ok {
+		r1 = returnFunc(context1, s, vs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConnection_ExecContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecContext'
+type MockConnection_ExecContext_Call struct {
+	*mock.Call
+}
+
+// ExecContext is a helper method to define mock.On call
+//   - context1 context.Context
+//   - s string
+//   - vs ...any
+func (_e *MockConnection_Expecter) ExecContext(context1 interface{}, s interface{}, vs ...interface{}) *MockConnection_ExecContext_Call {
+	return &MockConnection_ExecContext_Call{Call: _e.mock.On("ExecContext",
+		append([]interface{}{context1, s}, vs...)...)}
+}
+
+func (_c *MockConnection_ExecContext_Call) Run(run func(context1 context.Context, s string, vs ...any)) *MockConnection_ExecContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []any
+		var variadicArgs []any
+		if len(args) > 2 {
+			variadicArgs = args[2].([]any)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConnection_ExecContext_Call) Return(n int64, err error) *MockConnection_ExecContext_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockConnection_ExecContext_Call) RunAndReturn(run func(context1 context.Context, s string, vs ...any) (int64, error)) *MockConnection_ExecContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetContext provides a mock function for the type MockConnection
+func (_mock *MockConnection) GetContext(context1 context.Context, v any, s string, vs ...any) error {
+	var tmpRet mock.Arguments
+	if len(vs) > 0 {
+		tmpRet = _mock.Called(context1, v, s, vs)
+	} else {
+		tmpRet = _mock.Called(context1, v, s)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContext")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, any, string, ...any) error); ok {
+		r0 = returnFunc(context1, v, s, vs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConnection_GetContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContext'
+type MockConnection_GetContext_Call struct {
+	*mock.Call
+}
+
+// GetContext is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v any
+//   - s string
+//   - vs ...any
+func (_e *MockConnection_Expecter) GetContext(context1 interface{}, v interface{}, s interface{}, vs ...interface{}) *MockConnection_GetContext_Call {
+	return &MockConnection_GetContext_Call{Call: _e.mock.On("GetContext",
+		append([]interface{}{context1, v, s}, vs...)...)}
+}
+
+func (_c *MockConnection_GetContext_Call) Run(run func(context1 context.Context, v any, s string, vs ...any)) *MockConnection_GetContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 any
+		if args[1] != nil {
+			arg1 = args[1].(any)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []any
+		var variadicArgs []any
+		if len(args) > 3 {
+			variadicArgs = args[3].([]any)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConnection_GetContext_Call) Return(err error) *MockConnection_GetContext_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConnection_GetContext_Call) RunAndReturn(run func(context1 context.Context, v any, s string, vs ...any) error) *MockConnection_GetContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectContext provides a mock function for the type MockConnection
+func (_mock *MockConnection) SelectContext(context1 context.Context, v any, s string, vs ...any) error {
+	var tmpRet mock.Arguments
+	if len(vs) > 0 {
+		tmpRet = _mock.Called(context1, v, s, vs)
+	} else {
+		tmpRet = _mock.Called(context1, v, s)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectContext")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, any, string, ...any) error); ok {
+		r0 = returnFunc(context1, v, s, vs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConnection_SelectContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectContext'
+type MockConnection_SelectContext_Call struct {
+	*mock.Call
+}
+
+// SelectContext is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v any
+//   - s string
+//   - vs ...any
+func (_e *MockConnection_Expecter) SelectContext(context1 interface{}, v interface{}, s interface{}, vs ...interface{}) *MockConnection_SelectContext_Call {
+	return &MockConnection_SelectContext_Call{Call: _e.mock.On("SelectContext",
+		append([]interface{}{context1, v, s}, vs...)...)}
+}
+
+func (_c *MockConnection_SelectContext_Call) Run(run func(context1 context.Context, v any, s string, vs ...any)) *MockConnection_SelectContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 any
+		if args[1] != nil {
+			arg1 = args[1].(any)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []any
+		var variadicArgs []any
+		if len(args) > 3 {
+			variadicArgs = args[3].([]any)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConnection_SelectContext_Call) Return(err error) *MockConnection_SelectContext_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConnection_SelectContext_Call) RunAndReturn(run func(context1 context.Context, v any, s string, vs ...any) error) *MockConnection_SelectContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConnectionProvider creates a new instance of MockConnectionProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConnectionProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConnectionProvider {
+	mock := &MockConnectionProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConnectionProvider is an autogenerated mock type for the ConnectionProvider type
+type MockConnectionProvider struct {
+	mock.Mock
+}
+
+type MockConnectionProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConnectionProvider) EXPECT() *MockConnectionProvider_Expecter {
+	return &MockConnectionProvider_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function for the type MockConnectionProvider
+func (_mock *MockConnectionProvider) Close() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConnectionProvider_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockConnectionProvider_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockConnectionProvider_Expecter) Close() *MockConnectionProvider_Close_Call {
+	return &MockConnectionProvider_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockConnectionProvider_Close_Call) Run(run func()) *MockConnectionProvider_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConnectionProvider_Close_Call) Return(err error) *MockConnectionProvider_Close_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConnectionProvider_Close_Call) RunAndReturn(run func() error) *MockConnectionProvider_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Execute provides a mock function for the type MockConnectionProvider
+func (_mock *MockConnectionProvider) Execute(context1 context.Context, fn func(context.Context, domain.Connection) error) error {
+	ret := _mock.Called(context1, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(context.Context, domain.Connection) error) error); ok {
+		r0 = returnFunc(context1, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConnectionProvider_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockConnectionProvider_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - context1 context.Context
+//   - fn func(context.Context, domain.Connection) error
+func (_e *MockConnectionProvider_Expecter) Execute(context1 interface{}, fn interface{}) *MockConnectionProvider_Execute_Call {
+	return &MockConnectionProvider_Execute_Call{Call: _e.mock.On("Execute", context1, fn)}
+}
+
+func (_c *MockConnectionProvider_Execute_Call) Run(run func(context1 context.Context, fn func(context.Context, domain.Connection) error)) *MockConnectionProvider_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 func(context.Context, domain.Connection) error
+		if args[1] != nil {
+			arg1 = args[1].(func(context.Context, domain.Connection) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConnectionProvider_Execute_Call) Return(err error) *MockConnectionProvider_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConnectionProvider_Execute_Call) RunAndReturn(run func(context1 context.Context, fn func(context.Context, domain.Connection) error) error) *MockConnectionProvider_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExecuteTx provides a mock function for the type MockConnectionProvider
+func (_mock *MockConnectionProvider) ExecuteTx(context1 context.Context, fn func(context.Context, domain.Connection) error) error {
+	ret := _mock.Called(context1, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(context.Context, domain.Connection) error) error); ok {
+		r0 = returnFunc(context1, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConnectionProvider_ExecuteTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteTx'
+type MockConnectionProvider_ExecuteTx_Call struct {
+	*mock.Call
+}
+
+// ExecuteTx is a helper method to define mock.On call
+//   - context1 context.Context
+//   - fn func(context.Context, domain.Connection) error
+func (_e *MockConnectionProvider_Expecter) ExecuteTx(context1 interface{}, fn interface{}) *MockConnectionProvider_ExecuteTx_Call {
+	return &MockConnectionProvider_ExecuteTx_Call{Call: _e.mock.On("ExecuteTx", context1, fn)}
+}
+
+func (_c *MockConnectionProvider_ExecuteTx_Call) Run(run func(context1 context.Context, fn func(context.Context, domain.Connection) error)) *MockConnectionProvider_ExecuteTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 func(context.Context, domain.Connection) error
+		if args[1] != nil {
+			arg1 = args[1].(func(context.Context, domain.Connection) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConnectionProvider_ExecuteTx_Call) Return(err error) *MockConnectionProvider_ExecuteTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConnectionProvider_ExecuteTx_Call) RunAndReturn(run func(context1 context.Context, fn func(context.Context, domain.Connection) error) error) *MockConnectionProvider_ExecuteTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSubscriptionInterface creates a new instance of MockSubscriptionInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSubscriptionInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSubscriptionInterface {
+	mock := &MockSubscriptionInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSubscriptionInterface is an autogenerated mock type for the SubscriptionInterface type
+type MockSubscriptionInterface struct {
+	mock.Mock
+}
+
+type MockSubscriptionInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSubscriptionInterface) EXPECT() *MockSubscriptionInterface_Expecter {
+	return &MockSubscriptionInterface_Expecter{mock: &_m.Mock}
+}
+
+// ActiveSet provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) ActiveSet(context1 context.Context, v domain.UserID, time1 time.Time) (domain.ActiveSet, error) {
+	ret := _mock.Called(context1, v, time1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActiveSet")
+	}
+
+	var r0 domain.ActiveSet
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, time.Time) (domain.ActiveSet, error)); ok {
+		return returnFunc(context1, v, time1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, time.Time) domain.ActiveSet); ok {
+		r0 = returnFunc(context1, v, time1)
+	} else {
+		r0 = ret.Get(0).(domain.ActiveSet)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID, time.Time) error); ok {
+		r1 = returnFunc(context1, v, time1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_ActiveSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActiveSet'
+type MockSubscriptionInterface_ActiveSet_Call struct {
+	*mock.Call
+}
+
+// ActiveSet is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.UserID
+//   - time1 time.Time
+func (_e *MockSubscriptionInterface_Expecter) ActiveSet(context1 interface{}, v interface{}, time1 interface{}) *MockSubscriptionInterface_ActiveSet_Call {
+	return &MockSubscriptionInterface_ActiveSet_Call{Call: _e.mock.On("ActiveSet", context1, v, time1)}
+}
+
+func (_c *MockSubscriptionInterface_ActiveSet_Call) Run(run func(context1 context.Context, v domain.UserID, time1 time.Time)) *MockSubscriptionInterface_ActiveSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserID
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ActiveSet_Call) Return(activeSet domain.ActiveSet, err error) *MockSubscriptionInterface_ActiveSet_Call {
+	_c.Call.Return(activeSet, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ActiveSet_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID, time1 time.Time) (domain.ActiveSet, error)) *MockSubscriptionInterface_ActiveSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddDiscount provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) AddDiscount(context1 context.Context, discount domain.Discount) (domain.Discount, error) {
+	ret := _mock.Called(context1, discount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDiscount")
+	}
+
+	var r0 domain.Discount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Discount) (domain.Discount, error)); ok {
+		return returnFunc(context1, discount)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Discount) domain.Discount); ok {
+		r0 = returnFunc(context1, discount)
+	} else {
+		r0 = ret.Get(0).(domain.Discount)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Discount) error); ok {
+		r1 = returnFunc(context1, discount)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_AddDiscount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDiscount'
+type MockSubscriptionInterface_AddDiscount_Call struct {
+	*mock.Call
+}
+
+// AddDiscount is a helper method to define mock.On call
+//   - context1 context.Context
+//   - discount domain.Discount
+func (_e *MockSubscriptionInterface_Expecter) AddDiscount(context1 interface{}, discount interface{}) *MockSubscriptionInterface_AddDiscount_Call {
+	return &MockSubscriptionInterface_AddDiscount_Call{Call: _e.mock.On("AddDiscount", context1, discount)}
+}
+
+func (_c *MockSubscriptionInterface_AddDiscount_Call) Run(run func(context1 context.Context, discount domain.Discount)) *MockSubscriptionInterface_AddDiscount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Discount
+		if args[1] != nil {
+			arg1 = args[1].(domain.Discount)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_AddDiscount_Call) Return(discount1 domain.Discount, err error) *MockSubscriptionInterface_AddDiscount_Call {
+	_c.Call.Return(discount1, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_AddDiscount_Call) RunAndReturn(run func(context1 context.Context, discount domain.Discount) (domain.Discount, error)) *MockSubscriptionInterface_AddDiscount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Breakdown provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) Breakdown(context1 context.Context, costQuery domain.CostQuery) ([]domain.Charge, error) {
+	ret := _mock.Called(context1, costQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Breakdown")
+	}
+
+	var r0 []domain.Charge
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CostQuery) ([]domain.Charge, error)); ok {
+		return returnFunc(context1, costQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CostQuery) []domain.Charge); ok {
+		r0 = returnFunc(context1, costQuery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Charge)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CostQuery) error); ok {
+		r1 = returnFunc(context1, costQuery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_Breakdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Breakdown'
+type MockSubscriptionInterface_Breakdown_Call struct {
+	*mock.Call
+}
+
+// Breakdown is a helper method to define mock.On call
+//   - context1 context.Context
+//   - costQuery domain.CostQuery
+func (_e *MockSubscriptionInterface_Expecter) Breakdown(context1 interface{}, costQuery interface{}) *MockSubscriptionInterface_Breakdown_Call {
+	return &MockSubscriptionInterface_Breakdown_Call{Call: _e.mock.On("Breakdown", context1, costQuery)}
+}
+
+func (_c *MockSubscriptionInterface_Breakdown_Call) Run(run func(context1 context.Context, costQuery domain.CostQuery)) *MockSubscriptionInterface_Breakdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CostQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.CostQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Breakdown_Call) Return(charges []domain.Charge, err error) *MockSubscriptionInterface_Breakdown_Call {
+	_c.Call.Return(charges, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Breakdown_Call) RunAndReturn(run func(context1 context.Context, costQuery domain.CostQuery) ([]domain.Charge, error)) *MockSubscriptionInterface_Breakdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cancel provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) Cancel(context1 context.Context, cancellation domain.Cancellation) error {
+	ret := _mock.Called(context1, cancellation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Cancellation) error); ok {
+		r0 = returnFunc(context1, cancellation)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionInterface_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type MockSubscriptionInterface_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - context1 context.Context
+//   - cancellation domain.Cancellation
+func (_e *MockSubscriptionInterface_Expecter) Cancel(context1 interface{}, cancellation interface{}) *MockSubscriptionInterface_Cancel_Call {
+	return &MockSubscriptionInterface_Cancel_Call{Call: _e.mock.On("Cancel", context1, cancellation)}
+}
+
+func (_c *MockSubscriptionInterface_Cancel_Call) Run(run func(context1 context.Context, cancellation domain.Cancellation)) *MockSubscriptionInterface_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Cancellation
+		if args[1] != nil {
+			arg1 = args[1].(domain.Cancellation)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Cancel_Call) Return(err error) *MockSubscriptionInterface_Cancel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Cancel_Call) RunAndReturn(run func(context1 context.Context, cancellation domain.Cancellation) error) *MockSubscriptionInterface_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ComparePeriods provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) ComparePeriods(context1 context.Context, comparisonQuery domain.ComparisonQuery) (domain.PeriodComparison, error) {
+	ret := _mock.Called(context1, comparisonQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComparePeriods")
+	}
+
+	var r0 domain.PeriodComparison
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ComparisonQuery) (domain.PeriodComparison, error)); ok {
+		return returnFunc(context1, comparisonQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ComparisonQuery) domain.PeriodComparison); ok {
+		r0 = returnFunc(context1, comparisonQuery)
+	} else {
+		r0 = ret.Get(0).(domain.PeriodComparison)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ComparisonQuery) error); ok {
+		r1 = returnFunc(context1, comparisonQuery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_ComparePeriods_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ComparePeriods'
+type MockSubscriptionInterface_ComparePeriods_Call struct {
+	*mock.Call
+}
+
+// ComparePeriods is a helper method to define mock.On call
+//   - context1 context.Context
+//   - comparisonQuery domain.ComparisonQuery
+func (_e *MockSubscriptionInterface_Expecter) ComparePeriods(context1 interface{}, comparisonQuery interface{}) *MockSubscriptionInterface_ComparePeriods_Call {
+	return &MockSubscriptionInterface_ComparePeriods_Call{Call: _e.mock.On("ComparePeriods", context1, comparisonQuery)}
+}
+
+func (_c *MockSubscriptionInterface_ComparePeriods_Call) Run(run func(context1 context.Context, comparisonQuery domain.ComparisonQuery)) *MockSubscriptionInterface_ComparePeriods_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ComparisonQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ComparisonQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ComparePeriods_Call) Return(periodComparison domain.PeriodComparison, err error) *MockSubscriptionInterface_ComparePeriods_Call {
+	_c.Call.Return(periodComparison, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ComparePeriods_Call) RunAndReturn(run func(context1 context.Context, comparisonQuery domain.ComparisonQuery) (domain.PeriodComparison, error)) *MockSubscriptionInterface_ComparePeriods_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) Create(context1 context.Context, subscription domain.Subscription) ([]domain.CatalogMatch, error) {
+	ret := _mock.Called(context1, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 []domain.CatalogMatch
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Subscription) ([]domain.CatalogMatch, error)); ok {
+		return returnFunc(context1, subscription)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Subscription) []domain.CatalogMatch); ok {
+		r0 = returnFunc(context1, subscription)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CatalogMatch)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Subscription) error); ok {
+		r1 = returnFunc(context1, subscription)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSubscriptionInterface_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - context1 context.Context
+//   - subscription domain.Subscription
+func (_e *MockSubscriptionInterface_Expecter) Create(context1 interface{}, subscription interface{}) *MockSubscriptionInterface_Create_Call {
+	return &MockSubscriptionInterface_Create_Call{Call: _e.mock.On("Create", context1, subscription)}
+}
+
+func (_c *MockSubscriptionInterface_Create_Call) Run(run func(context1 context.Context, subscription domain.Subscription)) *MockSubscriptionInterface_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Subscription
+		if args[1] != nil {
+			arg1 = args[1].(domain.Subscription)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Create_Call) Return(catalogMatchs []domain.CatalogMatch, err error) *MockSubscriptionInterface_Create_Call {
+	_c.Call.Return(catalogMatchs, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Create_Call) RunAndReturn(run func(context1 context.Context, subscription domain.Subscription) ([]domain.CatalogMatch, error)) *MockSubscriptionInterface_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) Delete(context1 context.Context, v domain.UserID, v1 domain.ServiceName) error {
+	ret := _mock.Called(context1, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.ServiceName) error); ok {
+		r0 = returnFunc(context1, v, v1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionInterface_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockSubscriptionInterface_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.UserID
+//   - v1 domain.ServiceName
+func (_e *MockSubscriptionInterface_Expecter) Delete(context1 interface{}, v interface{}, v1 interface{}) *MockSubscriptionInterface_Delete_Call {
+	return &MockSubscriptionInterface_Delete_Call{Call: _e.mock.On("Delete", context1, v, v1)}
+}
+
+func (_c *MockSubscriptionInterface_Delete_Call) Run(run func(context1 context.Context, v domain.UserID, v1 domain.ServiceName)) *MockSubscriptionInterface_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserID
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserID)
+		}
+		var arg2 domain.ServiceName
+		if args[2] != nil {
+			arg2 = args[2].(domain.ServiceName)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Delete_Call) Return(err error) *MockSubscriptionInterface_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Delete_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID, v1 domain.ServiceName) error) *MockSubscriptionInterface_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteActual provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) DeleteActual(context1 context.Context, v domain.SubscriptionID, time1 time.Time) error {
+	ret := _mock.Called(context1, v, time1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteActual")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID, time.Time) error); ok {
+		r0 = returnFunc(context1, v, time1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionInterface_DeleteActual_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteActual'
+type MockSubscriptionInterface_DeleteActual_Call struct {
+	*mock.Call
+}
+
+// DeleteActual is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.SubscriptionID
+//   - time1 time.Time
+func (_e *MockSubscriptionInterface_Expecter) DeleteActual(context1 interface{}, v interface{}, time1 interface{}) *MockSubscriptionInterface_DeleteActual_Call {
+	return &MockSubscriptionInterface_DeleteActual_Call{Call: _e.mock.On("DeleteActual", context1, v, time1)}
+}
+
+func (_c *MockSubscriptionInterface_DeleteActual_Call) Run(run func(context1 context.Context, v domain.SubscriptionID, time1 time.Time)) *MockSubscriptionInterface_DeleteActual_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SubscriptionID
+		if args[1] != nil {
+			arg1 = args[1].(domain.SubscriptionID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_DeleteActual_Call) Return(err error) *MockSubscriptionInterface_DeleteActual_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_DeleteActual_Call) RunAndReturn(run func(context1 context.Context, v domain.SubscriptionID, time1 time.Time) error) *MockSubscriptionInterface_DeleteActual_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDiscount provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) DeleteDiscount(context1 context.Context, v domain.SubscriptionID, v1 domain.DiscountID) error {
+	ret := _mock.Called(context1, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDiscount")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID, domain.DiscountID) error); ok {
+		r0 = returnFunc(context1, v, v1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionInterface_DeleteDiscount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDiscount'
+type MockSubscriptionInterface_DeleteDiscount_Call struct {
+	*mock.Call
+}
+
+// DeleteDiscount is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.SubscriptionID
+//   - v1 domain.DiscountID
+func (_e *MockSubscriptionInterface_Expecter) DeleteDiscount(context1 interface{}, v interface{}, v1 interface{}) *MockSubscriptionInterface_DeleteDiscount_Call {
+	return &MockSubscriptionInterface_DeleteDiscount_Call{Call: _e.mock.On("DeleteDiscount", context1, v, v1)}
+}
+
+func (_c *MockSubscriptionInterface_DeleteDiscount_Call) Run(run func(context1 context.Context, v domain.SubscriptionID, v1 domain.DiscountID)) *MockSubscriptionInterface_DeleteDiscount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SubscriptionID
+		if args[1] != nil {
+			arg1 = args[1].(domain.SubscriptionID)
+		}
+		var arg2 domain.DiscountID
+		if args[2] != nil {
+			arg2 = args[2].(domain.DiscountID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_DeleteDiscount_Call) Return(err error) *MockSubscriptionInterface_DeleteDiscount_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_DeleteDiscount_Call) RunAndReturn(run func(context1 context.Context, v domain.SubscriptionID, v1 domain.DiscountID) error) *MockSubscriptionInterface_DeleteDiscount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatest provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) GetLatest(context1 context.Context, v domain.UserID) (domain.Subscription, error) {
+	ret := _mock.Called(context1, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatest")
+	}
+
+	var r0 domain.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) (domain.Subscription, error)); ok {
+		return returnFunc(context1, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) domain.Subscription); ok {
+		r0 = returnFunc(context1, v)
+	} else {
+		r0 = ret.Get(0).(domain.Subscription)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID) error); ok {
+		r1 = returnFunc(context1, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_GetLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatest'
+type MockSubscriptionInterface_GetLatest_Call struct {
+	*mock.Call
+}
+
+// GetLatest is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.UserID
+func (_e *MockSubscriptionInterface_Expecter) GetLatest(context1 interface{}, v interface{}) *MockSubscriptionInterface_GetLatest_Call {
+	return &MockSubscriptionInterface_GetLatest_Call{Call: _e.mock.On("GetLatest", context1, v)}
+}
+
+func (_c *MockSubscriptionInterface_GetLatest_Call) Run(run func(context1 context.Context, v domain.UserID)) *MockSubscriptionInterface_GetLatest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserID
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_GetLatest_Call) Return(subscription domain.Subscription, err error) *MockSubscriptionInterface_GetLatest_Call {
+	_c.Call.Return(subscription, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_GetLatest_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID) (domain.Subscription, error)) *MockSubscriptionInterface_GetLatest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActuals provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) ListActuals(context1 context.Context, v domain.SubscriptionID) ([]domain.ActualCharge, error) {
+	ret := _mock.Called(context1, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActuals")
+	}
+
+	var r0 []domain.ActualCharge
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID) ([]domain.ActualCharge, error)); ok {
+		return returnFunc(context1, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID) []domain.ActualCharge); ok {
+		r0 = returnFunc(context1, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ActualCharge)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.SubscriptionID) error); ok {
+		r1 = returnFunc(context1, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_ListActuals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActuals'
+type MockSubscriptionInterface_ListActuals_Call struct {
+	*mock.Call
+}
+
+// ListActuals is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.SubscriptionID
+func (_e *MockSubscriptionInterface_Expecter) ListActuals(context1 interface{}, v interface{}) *MockSubscriptionInterface_ListActuals_Call {
+	return &MockSubscriptionInterface_ListActuals_Call{Call: _e.mock.On("ListActuals", context1, v)}
+}
+
+func (_c *MockSubscriptionInterface_ListActuals_Call) Run(run func(context1 context.Context, v domain.SubscriptionID)) *MockSubscriptionInterface_ListActuals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SubscriptionID
+		if args[1] != nil {
+			arg1 = args[1].(domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ListActuals_Call) Return(actualCharges []domain.ActualCharge, err error) *MockSubscriptionInterface_ListActuals_Call {
+	_c.Call.Return(actualCharges, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ListActuals_Call) RunAndReturn(run func(context1 context.Context, v domain.SubscriptionID) ([]domain.ActualCharge, error)) *MockSubscriptionInterface_ListActuals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDiscounts provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) ListDiscounts(context1 context.Context, v domain.SubscriptionID) ([]domain.Discount, error) {
+	ret := _mock.Called(context1, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDiscounts")
+	}
+
+	var r0 []domain.Discount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID) ([]domain.Discount, error)); ok {
+		return returnFunc(context1, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID) []domain.Discount); ok {
+		r0 = returnFunc(context1, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Discount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.SubscriptionID) error); ok {
+		r1 = returnFunc(context1, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_ListDiscounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDiscounts'
+type MockSubscriptionInterface_ListDiscounts_Call struct {
+	*mock.Call
+}
+
+// ListDiscounts is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.SubscriptionID
+func (_e *MockSubscriptionInterface_Expecter) ListDiscounts(context1 interface{}, v interface{}) *MockSubscriptionInterface_ListDiscounts_Call {
+	return &MockSubscriptionInterface_ListDiscounts_Call{Call: _e.mock.On("ListDiscounts", context1, v)}
+}
+
+func (_c *MockSubscriptionInterface_ListDiscounts_Call) Run(run func(context1 context.Context, v domain.SubscriptionID)) *MockSubscriptionInterface_ListDiscounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SubscriptionID
+		if args[1] != nil {
+			arg1 = args[1].(domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ListDiscounts_Call) Return(discounts []domain.Discount, err error) *MockSubscriptionInterface_ListDiscounts_Call {
+	_c.Call.Return(discounts, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ListDiscounts_Call) RunAndReturn(run func(context1 context.Context, v domain.SubscriptionID) ([]domain.Discount, error)) *MockSubscriptionInterface_ListDiscounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Owed provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) Owed(context1 context.Context, v domain.UserID, time1 time.Time, time11 time.Time) ([]domain.MemberDebt, error) {
+	ret := _mock.Called(context1, v, time1, time11)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Owed")
+	}
+
+	var r0 []domain.MemberDebt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, time.Time, time.Time) ([]domain.MemberDebt, error)); ok {
+		return returnFunc(context1, v, time1, time11)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, time.Time, time.Time) []domain.MemberDebt); ok {
+		r0 = returnFunc(context1, v, time1, time11)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.MemberDebt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID, time.Time, time.Time) error); ok {
+		r1 = returnFunc(context1, v, time1, time11)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_Owed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Owed'
+type MockSubscriptionInterface_Owed_Call struct {
+	*mock.Call
+}
+
+// Owed is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.UserID
+//   - time1 time.Time
+//   - time11 time.Time
+func (_e *MockSubscriptionInterface_Expecter) Owed(context1 interface{}, v interface{}, time1 interface{}, time11 interface{}) *MockSubscriptionInterface_Owed_Call {
+	return &MockSubscriptionInterface_Owed_Call{Call: _e.mock.On("Owed", context1, v, time1, time11)}
+}
+
+func (_c *MockSubscriptionInterface_Owed_Call) Run(run func(context1 context.Context, v domain.UserID, time1 time.Time, time11 time.Time)) *MockSubscriptionInterface_Owed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserID
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Owed_Call) Return(memberDebts []domain.MemberDebt, err error) *MockSubscriptionInterface_Owed_Call {
+	_c.Call.Return(memberDebts, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Owed_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID, time1 time.Time, time11 time.Time) ([]domain.MemberDebt, error)) *MockSubscriptionInterface_Owed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadActiveAt provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) ReadActiveAt(context1 context.Context, v domain.UserID, time1 time.Time) ([]domain.Subscription, error) {
+	ret := _mock.Called(context1, v, time1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadActiveAt")
+	}
+
+	var r0 []domain.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, time.Time) ([]domain.Subscription, error)); ok {
+		return returnFunc(context1, v, time1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, time.Time) []domain.Subscription); ok {
+		r0 = returnFunc(context1, v, time1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID, time.Time) error); ok {
+		r1 = returnFunc(context1, v, time1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_ReadActiveAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadActiveAt'
+type MockSubscriptionInterface_ReadActiveAt_Call struct {
+	*mock.Call
+}
+
+// ReadActiveAt is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.UserID
+//   - time1 time.Time
+func (_e *MockSubscriptionInterface_Expecter) ReadActiveAt(context1 interface{}, v interface{}, time1 interface{}) *MockSubscriptionInterface_ReadActiveAt_Call {
+	return &MockSubscriptionInterface_ReadActiveAt_Call{Call: _e.mock.On("ReadActiveAt", context1, v, time1)}
+}
+
+func (_c *MockSubscriptionInterface_ReadActiveAt_Call) Run(run func(context1 context.Context, v domain.UserID, time1 time.Time)) *MockSubscriptionInterface_ReadActiveAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserID
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ReadActiveAt_Call) Return(subscriptions []domain.Subscription, err error) *MockSubscriptionInterface_ReadActiveAt_Call {
+	_c.Call.Return(subscriptions, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ReadActiveAt_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID, time1 time.Time) ([]domain.Subscription, error)) *MockSubscriptionInterface_ReadActiveAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadAllByUserID provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) ReadAllByUserID(context1 context.Context, v domain.UserID) ([]domain.Subscription, error) {
+	ret := _mock.Called(context1, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadAllByUserID")
+	}
+
+	var r0 []domain.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) ([]domain.Subscription, error)); ok {
+		return returnFunc(context1, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) []domain.Subscription); ok {
+		r0 = returnFunc(context1, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID) error); ok {
+		r1 = returnFunc(context1, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_ReadAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAllByUserID'
+type MockSubscriptionInterface_ReadAllByUserID_Call struct {
+	*mock.Call
+}
+
+// ReadAllByUserID is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.UserID
+func (_e *MockSubscriptionInterface_Expecter) ReadAllByUserID(context1 interface{}, v interface{}) *MockSubscriptionInterface_ReadAllByUserID_Call {
+	return &MockSubscriptionInterface_ReadAllByUserID_Call{Call: _e.mock.On("ReadAllByUserID", context1, v)}
+}
+
+func (_c *MockSubscriptionInterface_ReadAllByUserID_Call) Run(run func(context1 context.Context, v domain.UserID)) *MockSubscriptionInterface_ReadAllByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserID
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ReadAllByUserID_Call) Return(subscriptions []domain.Subscription, err error) *MockSubscriptionInterface_ReadAllByUserID_Call {
+	_c.Call.Return(subscriptions, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ReadAllByUserID_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID) ([]domain.Subscription, error)) *MockSubscriptionInterface_ReadAllByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTrash provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) ReadTrash(context1 context.Context, v domain.UserID) ([]domain.Subscription, error) {
+	ret := _mock.Called(context1, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTrash")
+	}
+
+	var r0 []domain.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) ([]domain.Subscription, error)); ok {
+		return returnFunc(context1, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) []domain.Subscription); ok {
+		r0 = returnFunc(context1, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID) error); ok {
+		r1 = returnFunc(context1, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_ReadTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTrash'
+type MockSubscriptionInterface_ReadTrash_Call struct {
+	*mock.Call
+}
+
+// ReadTrash is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.UserID
+func (_e *MockSubscriptionInterface_Expecter) ReadTrash(context1 interface{}, v interface{}) *MockSubscriptionInterface_ReadTrash_Call {
+	return &MockSubscriptionInterface_ReadTrash_Call{Call: _e.mock.On("ReadTrash", context1, v)}
+}
+
+func (_c *MockSubscriptionInterface_ReadTrash_Call) Run(run func(context1 context.Context, v domain.UserID)) *MockSubscriptionInterface_ReadTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserID
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ReadTrash_Call) Return(subscriptions []domain.Subscription, err error) *MockSubscriptionInterface_ReadTrash_Call {
+	_c.Call.Return(subscriptions, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_ReadTrash_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID) ([]domain.Subscription, error)) *MockSubscriptionInterface_ReadTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) Restore(context1 context.Context, v domain.SubscriptionID) error {
+	ret := _mock.Called(context1, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID) error); ok {
+		r0 = returnFunc(context1, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionInterface_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockSubscriptionInterface_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.SubscriptionID
+func (_e *MockSubscriptionInterface_Expecter) Restore(context1 interface{}, v interface{}) *MockSubscriptionInterface_Restore_Call {
+	return &MockSubscriptionInterface_Restore_Call{Call: _e.mock.On("Restore", context1, v)}
+}
+
+func (_c *MockSubscriptionInterface_Restore_Call) Run(run func(context1 context.Context, v domain.SubscriptionID)) *MockSubscriptionInterface_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SubscriptionID
+		if args[1] != nil {
+			arg1 = args[1].(domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Restore_Call) Return(err error) *MockSubscriptionInterface_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Restore_Call) RunAndReturn(run func(context1 context.Context, v domain.SubscriptionID) error) *MockSubscriptionInterface_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetActual provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) SetActual(context1 context.Context, actualCharge domain.ActualCharge) error {
+	ret := _mock.Called(context1, actualCharge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetActual")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ActualCharge) error); ok {
+		r0 = returnFunc(context1, actualCharge)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionInterface_SetActual_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetActual'
+type MockSubscriptionInterface_SetActual_Call struct {
+	*mock.Call
+}
+
+// SetActual is a helper method to define mock.On call
+//   - context1 context.Context
+//   - actualCharge domain.ActualCharge
+func (_e *MockSubscriptionInterface_Expecter) SetActual(context1 interface{}, actualCharge interface{}) *MockSubscriptionInterface_SetActual_Call {
+	return &MockSubscriptionInterface_SetActual_Call{Call: _e.mock.On("SetActual", context1, actualCharge)}
+}
+
+func (_c *MockSubscriptionInterface_SetActual_Call) Run(run func(context1 context.Context, actualCharge domain.ActualCharge)) *MockSubscriptionInterface_SetActual_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ActualCharge
+		if args[1] != nil {
+			arg1 = args[1].(domain.ActualCharge)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_SetActual_Call) Return(err error) *MockSubscriptionInterface_SetActual_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_SetActual_Call) RunAndReturn(run func(context1 context.Context, actualCharge domain.ActualCharge) error) *MockSubscriptionInterface_SetActual_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SpendSeries provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) SpendSeries(context1 context.Context, costQuery domain.CostQuery) ([]domain.SeriesPoint, error) {
+	ret := _mock.Called(context1, costQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpendSeries")
+	}
+
+	var r0 []domain.SeriesPoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CostQuery) ([]domain.SeriesPoint, error)); ok {
+		return returnFunc(context1, costQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CostQuery) []domain.SeriesPoint); ok {
+		r0 = returnFunc(context1, costQuery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SeriesPoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CostQuery) error); ok {
+		r1 = returnFunc(context1, costQuery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_SpendSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpendSeries'
+type MockSubscriptionInterface_SpendSeries_Call struct {
+	*mock.Call
+}
+
+// SpendSeries is a helper method to define mock.On call
+//   - context1 context.Context
+//   - costQuery domain.CostQuery
+func (_e *MockSubscriptionInterface_Expecter) SpendSeries(context1 interface{}, costQuery interface{}) *MockSubscriptionInterface_SpendSeries_Call {
+	return &MockSubscriptionInterface_SpendSeries_Call{Call: _e.mock.On("SpendSeries", context1, costQuery)}
+}
+
+func (_c *MockSubscriptionInterface_SpendSeries_Call) Run(run func(context1 context.Context, costQuery domain.CostQuery)) *MockSubscriptionInterface_SpendSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CostQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.CostQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_SpendSeries_Call) Return(seriesPoints []domain.SeriesPoint, err error) *MockSubscriptionInterface_SpendSeries_Call {
+	_c.Call.Return(seriesPoints, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_SpendSeries_Call) RunAndReturn(run func(context1 context.Context, costQuery domain.CostQuery) ([]domain.SeriesPoint, error)) *MockSubscriptionInterface_SpendSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Statement provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) Statement(context1 context.Context, v domain.UserID, time1 time.Time) (domain.Statement, error) {
+	ret := _mock.Called(context1, v, time1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Statement")
+	}
+
+	var r0 domain.Statement
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, time.Time) (domain.Statement, error)); ok {
+		return returnFunc(context1, v, time1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, time.Time) domain.Statement); ok {
+		r0 = returnFunc(context1, v, time1)
+	} else {
+		r0 = ret.Get(0).(domain.Statement)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID, time.Time) error); ok {
+		r1 = returnFunc(context1, v, time1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_Statement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Statement'
+type MockSubscriptionInterface_Statement_Call struct {
+	*mock.Call
+}
+
+// Statement is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.UserID
+//   - time1 time.Time
+func (_e *MockSubscriptionInterface_Expecter) Statement(context1 interface{}, v interface{}, time1 interface{}) *MockSubscriptionInterface_Statement_Call {
+	return &MockSubscriptionInterface_Statement_Call{Call: _e.mock.On("Statement", context1, v, time1)}
+}
+
+func (_c *MockSubscriptionInterface_Statement_Call) Run(run func(context1 context.Context, v domain.UserID, time1 time.Time)) *MockSubscriptionInterface_Statement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserID
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Statement_Call) Return(statement domain.Statement, err error) *MockSubscriptionInterface_Statement_Call {
+	_c.Call.Return(statement, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Statement_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID, time1 time.Time) (domain.Statement, error)) *MockSubscriptionInterface_Statement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TotalSubscriptionsCost provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) TotalSubscriptionsCost(context1 context.Context, costQuery domain.CostQuery) (domain.CostReport, error) {
+	ret := _mock.Called(context1, costQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TotalSubscriptionsCost")
+	}
+
+	var r0 domain.CostReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CostQuery) (domain.CostReport, error)); ok {
+		return returnFunc(context1, costQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CostQuery) domain.CostReport); ok {
+		r0 = returnFunc(context1, costQuery)
+	} else {
+		r0 = ret.Get(0).(domain.CostReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CostQuery) error); ok {
+		r1 = returnFunc(context1, costQuery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_TotalSubscriptionsCost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TotalSubscriptionsCost'
+type MockSubscriptionInterface_TotalSubscriptionsCost_Call struct {
+	*mock.Call
+}
+
+// TotalSubscriptionsCost is a helper method to define mock.On call
+//   - context1 context.Context
+//   - costQuery domain.CostQuery
+func (_e *MockSubscriptionInterface_Expecter) TotalSubscriptionsCost(context1 interface{}, costQuery interface{}) *MockSubscriptionInterface_TotalSubscriptionsCost_Call {
+	return &MockSubscriptionInterface_TotalSubscriptionsCost_Call{Call: _e.mock.On("TotalSubscriptionsCost", context1, costQuery)}
+}
+
+func (_c *MockSubscriptionInterface_TotalSubscriptionsCost_Call) Run(run func(context1 context.Context, costQuery domain.CostQuery)) *MockSubscriptionInterface_TotalSubscriptionsCost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CostQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.CostQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_TotalSubscriptionsCost_Call) Return(costReport domain.CostReport, err error) *MockSubscriptionInterface_TotalSubscriptionsCost_Call {
+	_c.Call.Return(costReport, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_TotalSubscriptionsCost_Call) RunAndReturn(run func(context1 context.Context, costQuery domain.CostQuery) (domain.CostReport, error)) *MockSubscriptionInterface_TotalSubscriptionsCost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Undo provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) Undo(context1 context.Context, v domain.UserID) (domain.AuditEntry, error) {
+	ret := _mock.Called(context1, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Undo")
+	}
+
+	var r0 domain.AuditEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) (domain.AuditEntry, error)); ok {
+		return returnFunc(context1, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) domain.AuditEntry); ok {
+		r0 = returnFunc(context1, v)
+	} else {
+		r0 = ret.Get(0).(domain.AuditEntry)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID) error); ok {
+		r1 = returnFunc(context1, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_Undo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Undo'
+type MockSubscriptionInterface_Undo_Call struct {
+	*mock.Call
+}
+
+// Undo is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.UserID
+func (_e *MockSubscriptionInterface_Expecter) Undo(context1 interface{}, v interface{}) *MockSubscriptionInterface_Undo_Call {
+	return &MockSubscriptionInterface_Undo_Call{Call: _e.mock.On("Undo", context1, v)}
+}
+
+func (_c *MockSubscriptionInterface_Undo_Call) Run(run func(context1 context.Context, v domain.UserID)) *MockSubscriptionInterface_Undo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserID
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Undo_Call) Return(auditEntry domain.AuditEntry, err error) *MockSubscriptionInterface_Undo_Call {
+	_c.Call.Return(auditEntry, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Undo_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID) (domain.AuditEntry, error)) *MockSubscriptionInterface_Undo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) Update(context1 context.Context, subscription domain.Subscription) error {
+	ret := _mock.Called(context1, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Subscription) error); ok {
+		r0 = returnFunc(context1, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptionInterface_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockSubscriptionInterface_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - context1 context.Context
+//   - subscription domain.Subscription
+func (_e *MockSubscriptionInterface_Expecter) Update(context1 interface{}, subscription interface{}) *MockSubscriptionInterface_Update_Call {
+	return &MockSubscriptionInterface_Update_Call{Call: _e.mock.On("Update", context1, subscription)}
+}
+
+func (_c *MockSubscriptionInterface_Update_Call) Run(run func(context1 context.Context, subscription domain.Subscription)) *MockSubscriptionInterface_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Subscription
+		if args[1] != nil {
+			arg1 = args[1].(domain.Subscription)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Update_Call) Return(err error) *MockSubscriptionInterface_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Update_Call) RunAndReturn(run func(context1 context.Context, subscription domain.Subscription) error) *MockSubscriptionInterface_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VersionAt provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) VersionAt(context1 context.Context, v domain.SubscriptionID, time1 time.Time) (domain.SubscriptionVersion, error) {
+	ret := _mock.Called(context1, v, time1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VersionAt")
+	}
+
+	var r0 domain.SubscriptionVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID, time.Time) (domain.SubscriptionVersion, error)); ok {
+		return returnFunc(context1, v, time1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID, time.Time) domain.SubscriptionVersion); ok {
+		r0 = returnFunc(context1, v, time1)
+	} else {
+		r0 = ret.Get(0).(domain.SubscriptionVersion)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.SubscriptionID, time.Time) error); ok {
+		r1 = returnFunc(context1, v, time1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_VersionAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VersionAt'
+type MockSubscriptionInterface_VersionAt_Call struct {
+	*mock.Call
+}
+
+// VersionAt is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.SubscriptionID
+//   - time1 time.Time
+func (_e *MockSubscriptionInterface_Expecter) VersionAt(context1 interface{}, v interface{}, time1 interface{}) *MockSubscriptionInterface_VersionAt_Call {
+	return &MockSubscriptionInterface_VersionAt_Call{Call: _e.mock.On("VersionAt", context1, v, time1)}
+}
+
+func (_c *MockSubscriptionInterface_VersionAt_Call) Run(run func(context1 context.Context, v domain.SubscriptionID, time1 time.Time)) *MockSubscriptionInterface_VersionAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SubscriptionID
+		if args[1] != nil {
+			arg1 = args[1].(domain.SubscriptionID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_VersionAt_Call) Return(subscriptionVersion domain.SubscriptionVersion, err error) *MockSubscriptionInterface_VersionAt_Call {
+	_c.Call.Return(subscriptionVersion, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_VersionAt_Call) RunAndReturn(run func(context1 context.Context, v domain.SubscriptionID, time1 time.Time) (domain.SubscriptionVersion, error)) *MockSubscriptionInterface_VersionAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Versions provides a mock function for the type MockSubscriptionInterface
+func (_mock *MockSubscriptionInterface) Versions(context1 context.Context, v domain.SubscriptionID) ([]domain.SubscriptionVersion, error) {
+	ret := _mock.Called(context1, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Versions")
+	}
+
+	var r0 []domain.SubscriptionVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID) ([]domain.SubscriptionVersion, error)); ok {
+		return returnFunc(context1, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID) []domain.SubscriptionVersion); ok {
+		r0 = returnFunc(context1, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SubscriptionVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.SubscriptionID) error); ok {
+		r1 = returnFunc(context1, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptionInterface_Versions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Versions'
+type MockSubscriptionInterface_Versions_Call struct {
+	*mock.Call
+}
+
+// Versions is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.SubscriptionID
+func (_e *MockSubscriptionInterface_Expecter) Versions(context1 interface{}, v interface{}) *MockSubscriptionInterface_Versions_Call {
+	return &MockSubscriptionInterface_Versions_Call{Call: _e.mock.On("Versions", context1, v)}
+}
+
+func (_c *MockSubscriptionInterface_Versions_Call) Run(run func(context1 context.Context, v domain.SubscriptionID)) *MockSubscriptionInterface_Versions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SubscriptionID
+		if args[1] != nil {
+			arg1 = args[1].(domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Versions_Call) Return(subscriptionVersions []domain.SubscriptionVersion, err error) *MockSubscriptionInterface_Versions_Call {
+	_c.Call.Return(subscriptionVersions, err)
+	return _c
+}
+
+func (_c *MockSubscriptionInterface_Versions_Call) RunAndReturn(run func(context1 context.Context, v domain.SubscriptionID) ([]domain.SubscriptionVersion, error)) *MockSubscriptionInterface_Versions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCatalogInterface creates a new instance of MockCatalogInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCatalogInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCatalogInterface {
+	mock := &MockCatalogInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCatalogInterface is an autogenerated mock type for the CatalogInterface type
+type MockCatalogInterface struct {
+	mock.Mock
+}
+
+type MockCatalogInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCatalogInterface) EXPECT() *MockCatalogInterface_Expecter {
+	return &MockCatalogInterface_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockCatalogInterface
+func (_mock *MockCatalogInterface) Create(context1 context.Context, catalogEntry domain.CatalogEntry) (domain.CatalogEntry, error) {
+	ret := _mock.Called(context1, catalogEntry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.CatalogEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CatalogEntry) (domain.CatalogEntry, error)); ok {
+		return returnFunc(context1, catalogEntry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CatalogEntry) domain.CatalogEntry); ok {
+		r0 = returnFunc(context1, catalogEntry)
+	} else {
+		r0 = ret.Get(0).(domain.CatalogEntry)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CatalogEntry) error); ok {
+		r1 = returnFunc(context1, catalogEntry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCatalogInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCatalogInterface_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - context1 context.Context
+//   - catalogEntry domain.CatalogEntry
+func (_e *MockCatalogInterface_Expecter) Create(context1 interface{}, catalogEntry interface{}) *MockCatalogInterface_Create_Call {
+	return &MockCatalogInterface_Create_Call{Call: _e.mock.On("Create", context1, catalogEntry)}
+}
+
+func (_c *MockCatalogInterface_Create_Call) Run(run func(context1 context.Context, catalogEntry domain.CatalogEntry)) *MockCatalogInterface_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CatalogEntry
+		if args[1] != nil {
+			arg1 = args[1].(domain.CatalogEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogInterface_Create_Call) Return(catalogEntry1 domain.CatalogEntry, err error) *MockCatalogInterface_Create_Call {
+	_c.Call.Return(catalogEntry1, err)
+	return _c
+}
+
+func (_c *MockCatalogInterface_Create_Call) RunAndReturn(run func(context1 context.Context, catalogEntry domain.CatalogEntry) (domain.CatalogEntry, error)) *MockCatalogInterface_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockCatalogInterface
+func (_mock *MockCatalogInterface) Delete(context1 context.Context, v domain.CatalogEntryID) error {
+	ret := _mock.Called(context1, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CatalogEntryID) error); ok {
+		r0 = returnFunc(context1, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCatalogInterface_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCatalogInterface_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.CatalogEntryID
+func (_e *MockCatalogInterface_Expecter) Delete(context1 interface{}, v interface{}) *MockCatalogInterface_Delete_Call {
+	return &MockCatalogInterface_Delete_Call{Call: _e.mock.On("Delete", context1, v)}
+}
+
+func (_c *MockCatalogInterface_Delete_Call) Run(run func(context1 context.Context, v domain.CatalogEntryID)) *MockCatalogInterface_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CatalogEntryID
+		if args[1] != nil {
+			arg1 = args[1].(domain.CatalogEntryID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogInterface_Delete_Call) Return(err error) *MockCatalogInterface_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCatalogInterface_Delete_Call) RunAndReturn(run func(context1 context.Context, v domain.CatalogEntryID) error) *MockCatalogInterface_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockCatalogInterface
+func (_mock *MockCatalogInterface) Get(context1 context.Context, v domain.CatalogEntryID) (domain.CatalogEntry, error) {
+	ret := _mock.Called(context1, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 domain.CatalogEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CatalogEntryID) (domain.CatalogEntry, error)); ok {
+		return returnFunc(context1, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CatalogEntryID) domain.CatalogEntry); ok {
+		r0 = returnFunc(context1, v)
+	} else {
+		r0 = ret.Get(0).(domain.CatalogEntry)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CatalogEntryID) error); ok {
+		r1 = returnFunc(context1, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCatalogInterface_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockCatalogInterface_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.CatalogEntryID
+func (_e *MockCatalogInterface_Expecter) Get(context1 interface{}, v interface{}) *MockCatalogInterface_Get_Call {
+	return &MockCatalogInterface_Get_Call{Call: _e.mock.On("Get", context1, v)}
+}
+
+func (_c *MockCatalogInterface_Get_Call) Run(run func(context1 context.Context, v domain.CatalogEntryID)) *MockCatalogInterface_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CatalogEntryID
+		if args[1] != nil {
+			arg1 = args[1].(domain.CatalogEntryID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogInterface_Get_Call) Return(catalogEntry domain.CatalogEntry, err error) *MockCatalogInterface_Get_Call {
+	_c.Call.Return(catalogEntry, err)
+	return _c
+}
+
+func (_c *MockCatalogInterface_Get_Call) RunAndReturn(run func(context1 context.Context, v domain.CatalogEntryID) (domain.CatalogEntry, error)) *MockCatalogInterface_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockCatalogInterface
+func (_mock *MockCatalogInterface) List(context1 context.Context) ([]domain.CatalogEntry, error) {
+	ret := _mock.Called(context1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.CatalogEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.CatalogEntry, error)); ok {
+		return returnFunc(context1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.CatalogEntry); ok {
+		r0 = returnFunc(context1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CatalogEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(context1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCatalogInterface_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockCatalogInterface_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - context1 context.Context
+func (_e *MockCatalogInterface_Expecter) List(context1 interface{}) *MockCatalogInterface_List_Call {
+	return &MockCatalogInterface_List_Call{Call: _e.mock.On("List", context1)}
+}
+
+func (_c *MockCatalogInterface_List_Call) Run(run func(context1 context.Context)) *MockCatalogInterface_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogInterface_List_Call) Return(catalogEntrys []domain.CatalogEntry, err error) *MockCatalogInterface_List_Call {
+	_c.Call.Return(catalogEntrys, err)
+	return _c
+}
+
+func (_c *MockCatalogInterface_List_Call) RunAndReturn(run func(context1 context.Context) ([]domain.CatalogEntry, error)) *MockCatalogInterface_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockCatalogInterface
+func (_mock *MockCatalogInterface) Search(context1 context.Context, s string, n int) ([]domain.CatalogMatch, error) {
+	ret := _mock.Called(context1, s, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []domain.CatalogMatch
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]domain.CatalogMatch, error)); ok {
+		return returnFunc(context1, s, n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []domain.CatalogMatch); ok {
+		r0 = returnFunc(context1, s, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CatalogMatch)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(context1, s, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCatalogInterface_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockCatalogInterface_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - context1 context.Context
+//   - s string
+//   - n int
+func (_e *MockCatalogInterface_Expecter) Search(context1 interface{}, s interface{}, n interface{}) *MockCatalogInterface_Search_Call {
+	return &MockCatalogInterface_Search_Call{Call: _e.mock.On("Search", context1, s, n)}
+}
+
+func (_c *MockCatalogInterface_Search_Call) Run(run func(context1 context.Context, s string, n int)) *MockCatalogInterface_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogInterface_Search_Call) Return(catalogMatchs []domain.CatalogMatch, err error) *MockCatalogInterface_Search_Call {
+	_c.Call.Return(catalogMatchs, err)
+	return _c
+}
+
+func (_c *MockCatalogInterface_Search_Call) RunAndReturn(run func(context1 context.Context, s string, n int) ([]domain.CatalogMatch, error)) *MockCatalogInterface_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockCatalogInterface
+func (_mock *MockCatalogInterface) Update(context1 context.Context, catalogEntry domain.CatalogEntry) error {
+	ret := _mock.Called(context1, catalogEntry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CatalogEntry) error); ok {
+		r0 = returnFunc(context1, catalogEntry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCatalogInterface_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCatalogInterface_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - context1 context.Context
+//   - catalogEntry domain.CatalogEntry
+func (_e *MockCatalogInterface_Expecter) Update(context1 interface{}, catalogEntry interface{}) *MockCatalogInterface_Update_Call {
+	return &MockCatalogInterface_Update_Call{Call: _e.mock.On("Update", context1, catalogEntry)}
+}
+
+func (_c *MockCatalogInterface_Update_Call) Run(run func(context1 context.Context, catalogEntry domain.CatalogEntry)) *MockCatalogInterface_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CatalogEntry
+		if args[1] != nil {
+			arg1 = args[1].(domain.CatalogEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCatalogInterface_Update_Call) Return(err error) *MockCatalogInterface_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCatalogInterface_Update_Call) RunAndReturn(run func(context1 context.Context, catalogEntry domain.CatalogEntry) error) *MockCatalogInterface_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBudgetInterface creates a new instance of MockBudgetInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBudgetInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBudgetInterface {
+	mock := &MockBudgetInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBudgetInterface is an autogenerated mock type for the BudgetInterface type
+type MockBudgetInterface struct {
+	mock.Mock
+}
+
+type MockBudgetInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBudgetInterface) EXPECT() *MockBudgetInterface_Expecter {
+	return &MockBudgetInterface_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockBudgetInterface
+func (_mock *MockBudgetInterface) Create(context1 context.Context, budget domain.Budget) (domain.Budget, error) {
+	ret := _mock.Called(context1, budget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.Budget
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Budget) (domain.Budget, error)); ok {
+		return returnFunc(context1, budget)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Budget) domain.Budget); ok {
+		r0 = returnFunc(context1, budget)
+	} else {
+		r0 = ret.Get(0).(domain.Budget)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Budget) error); ok {
+		r1 = returnFunc(context1, budget)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockBudgetInterface_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - context1 context.Context
+//   - budget domain.Budget
+func (_e *MockBudgetInterface_Expecter) Create(context1 interface{}, budget interface{}) *MockBudgetInterface_Create_Call {
+	return &MockBudgetInterface_Create_Call{Call: _e.mock.On("Create", context1, budget)}
+}
+
+func (_c *MockBudgetInterface_Create_Call) Run(run func(context1 context.Context, budget domain.Budget)) *MockBudgetInterface_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Budget
+		if args[1] != nil {
+			arg1 = args[1].(domain.Budget)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetInterface_Create_Call) Return(budget1 domain.Budget, err error) *MockBudgetInterface_Create_Call {
+	_c.Call.Return(budget1, err)
+	return _c
+}
+
+func (_c *MockBudgetInterface_Create_Call) RunAndReturn(run func(context1 context.Context, budget domain.Budget) (domain.Budget, error)) *MockBudgetInterface_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockBudgetInterface
+func (_mock *MockBudgetInterface) Delete(context1 context.Context, v domain.UserID, v1 domain.BudgetID) error {
+	ret := _mock.Called(context1, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.BudgetID) error); ok {
+		r0 = returnFunc(context1, v, v1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetInterface_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockBudgetInterface_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.UserID
+//   - v1 domain.BudgetID
+func (_e *MockBudgetInterface_Expecter) Delete(context1 interface{}, v interface{}, v1 interface{}) *MockBudgetInterface_Delete_Call {
+	return &MockBudgetInterface_Delete_Call{Call: _e.mock.On("Delete", context1, v, v1)}
+}
+
+func (_c *MockBudgetInterface_Delete_Call) Run(run func(context1 context.Context, v domain.UserID, v1 domain.BudgetID)) *MockBudgetInterface_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserID
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserID)
+		}
+		var arg2 domain.BudgetID
+		if args[2] != nil {
+			arg2 = args[2].(domain.BudgetID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetInterface_Delete_Call) Return(err error) *MockBudgetInterface_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetInterface_Delete_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID, v1 domain.BudgetID) error) *MockBudgetInterface_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockBudgetInterface
+func (_mock *MockBudgetInterface) List(context1 context.Context, v domain.UserID) ([]domain.Budget, error) {
+	ret := _mock.Called(context1, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.Budget
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) ([]domain.Budget, error)); ok {
+		return returnFunc(context1, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) []domain.Budget); ok {
+		r0 = returnFunc(context1, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Budget)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID) error); ok {
+		r1 = returnFunc(context1, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetInterface_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockBudgetInterface_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.UserID
+func (_e *MockBudgetInterface_Expecter) List(context1 interface{}, v interface{}) *MockBudgetInterface_List_Call {
+	return &MockBudgetInterface_List_Call{Call: _e.mock.On("List", context1, v)}
+}
+
+func (_c *MockBudgetInterface_List_Call) Run(run func(context1 context.Context, v domain.UserID)) *MockBudgetInterface_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserID
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetInterface_List_Call) Return(budgets []domain.Budget, err error) *MockBudgetInterface_List_Call {
+	_c.Call.Return(budgets, err)
+	return _c
+}
+
+func (_c *MockBudgetInterface_List_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID) ([]domain.Budget, error)) *MockBudgetInterface_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Status provides a mock function for the type MockBudgetInterface
+func (_mock *MockBudgetInterface) Status(context1 context.Context, v domain.UserID, time1 time.Time) ([]domain.BudgetStatus, error) {
+	ret := _mock.Called(context1, v, time1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 []domain.BudgetStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, time.Time) ([]domain.BudgetStatus, error)); ok {
+		return returnFunc(context1, v, time1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, time.Time) []domain.BudgetStatus); ok {
+		r0 = returnFunc(context1, v, time1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.BudgetStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID, time.Time) error); ok {
+		r1 = returnFunc(context1, v, time1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetInterface_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type MockBudgetInterface_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.UserID
+//   - time1 time.Time
+func (_e *MockBudgetInterface_Expecter) Status(context1 interface{}, v interface{}, time1 interface{}) *MockBudgetInterface_Status_Call {
+	return &MockBudgetInterface_Status_Call{Call: _e.mock.On("Status", context1, v, time1)}
+}
+
+func (_c *MockBudgetInterface_Status_Call) Run(run func(context1 context.Context, v domain.UserID, time1 time.Time)) *MockBudgetInterface_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserID
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetInterface_Status_Call) Return(budgetStatuss []domain.BudgetStatus, err error) *MockBudgetInterface_Status_Call {
+	_c.Call.Return(budgetStatuss, err)
+	return _c
+}
+
+func (_c *MockBudgetInterface_Status_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID, time1 time.Time) ([]domain.BudgetStatus, error)) *MockBudgetInterface_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBudgetEvaluator creates a new instance of MockBudgetEvaluator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBudgetEvaluator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBudgetEvaluator {
+	mock := &MockBudgetEvaluator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBudgetEvaluator is an autogenerated mock type for the BudgetEvaluator type
+type MockBudgetEvaluator struct {
+	mock.Mock
+}
+
+type MockBudgetEvaluator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBudgetEvaluator) EXPECT() *MockBudgetEvaluator_Expecter {
+	return &MockBudgetEvaluator_Expecter{mock: &_m.Mock}
+}
+
+// Breaches provides a mock function for the type MockBudgetEvaluator
+func (_mock *MockBudgetEvaluator) Breaches(context1 context.Context, connection domain.Connection, budgetSnapshot domain.BudgetSnapshot) ([]domain.BudgetBreach, error) {
+	ret := _mock.Called(context1, connection, budgetSnapshot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Breaches")
+	}
+
+	var r0 []domain.BudgetBreach
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.BudgetSnapshot) ([]domain.BudgetBreach, error)); ok {
+		return returnFunc(context1, connection, budgetSnapshot)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.BudgetSnapshot) []domain.BudgetBreach); ok {
+		r0 = returnFunc(context1, connection, budgetSnapshot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.BudgetBreach)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.BudgetSnapshot) error); ok {
+		r1 = returnFunc(context1, connection, budgetSnapshot)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetEvaluator_Breaches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Breaches'
+type MockBudgetEvaluator_Breaches_Call struct {
+	*mock.Call
+}
+
+// Breaches is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - budgetSnapshot domain.BudgetSnapshot
+func (_e *MockBudgetEvaluator_Expecter) Breaches(context1 interface{}, connection interface{}, budgetSnapshot interface{}) *MockBudgetEvaluator_Breaches_Call {
+	return &MockBudgetEvaluator_Breaches_Call{Call: _e.mock.On("Breaches", context1, connection, budgetSnapshot)}
+}
+
+func (_c *MockBudgetEvaluator_Breaches_Call) Run(run func(context1 context.Context, connection domain.Connection, budgetSnapshot domain.BudgetSnapshot)) *MockBudgetEvaluator_Breaches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.BudgetSnapshot
+		if args[2] != nil {
+			arg2 = args[2].(domain.BudgetSnapshot)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetEvaluator_Breaches_Call) Return(budgetBreachs []domain.BudgetBreach, err error) *MockBudgetEvaluator_Breaches_Call {
+	_c.Call.Return(budgetBreachs, err)
+	return _c
+}
+
+func (_c *MockBudgetEvaluator_Breaches_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, budgetSnapshot domain.BudgetSnapshot) ([]domain.BudgetBreach, error)) *MockBudgetEvaluator_Breaches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function for the type MockBudgetEvaluator
+func (_mock *MockBudgetEvaluator) Publish(context1 context.Context, budgetBreachs []domain.BudgetBreach) {
+	_mock.Called(context1, budgetBreachs)
+	return
+}
+
+// MockBudgetEvaluator_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockBudgetEvaluator_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - context1 context.Context
+//   - budgetBreachs []domain.BudgetBreach
+func (_e *MockBudgetEvaluator_Expecter) Publish(context1 interface{}, budgetBreachs interface{}) *MockBudgetEvaluator_Publish_Call {
+	return &MockBudgetEvaluator_Publish_Call{Call: _e.mock.On("Publish", context1, budgetBreachs)}
+}
+
+func (_c *MockBudgetEvaluator_Publish_Call) Run(run func(context1 context.Context, budgetBreachs []domain.BudgetBreach)) *MockBudgetEvaluator_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.BudgetBreach
+		if args[1] != nil {
+			arg1 = args[1].([]domain.BudgetBreach)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetEvaluator_Publish_Call) Return() *MockBudgetEvaluator_Publish_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockBudgetEvaluator_Publish_Call) RunAndReturn(run func(context1 context.Context, budgetBreachs []domain.BudgetBreach)) *MockBudgetEvaluator_Publish_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Snapshot provides a mock function for the type MockBudgetEvaluator
+func (_mock *MockBudgetEvaluator) Snapshot(context1 context.Context, connection domain.Connection, v domain.UserID, time1 time.Time) (domain.BudgetSnapshot, error) {
+	ret := _mock.Called(context1, connection, v, time1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 domain.BudgetSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, time.Time) (domain.BudgetSnapshot, error)); ok {
+		return returnFunc(context1, connection, v, time1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.UserID, time.Time) domain.BudgetSnapshot); ok {
+		r0 = returnFunc(context1, connection, v, time1)
+	} else {
+		r0 = ret.Get(0).(domain.BudgetSnapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.UserID, time.Time) error); ok {
+		r1 = returnFunc(context1, connection, v, time1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetEvaluator_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type MockBudgetEvaluator_Snapshot_Call struct {
+	*mock.Call
+}
+
+// Snapshot is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.UserID
+//   - time1 time.Time
+func (_e *MockBudgetEvaluator_Expecter) Snapshot(context1 interface{}, connection interface{}, v interface{}, time1 interface{}) *MockBudgetEvaluator_Snapshot_Call {
+	return &MockBudgetEvaluator_Snapshot_Call{Call: _e.mock.On("Snapshot", context1, connection, v, time1)}
+}
+
+func (_c *MockBudgetEvaluator_Snapshot_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.UserID, time1 time.Time)) *MockBudgetEvaluator_Snapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.UserID
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetEvaluator_Snapshot_Call) Return(budgetSnapshot domain.BudgetSnapshot, err error) *MockBudgetEvaluator_Snapshot_Call {
+	_c.Call.Return(budgetSnapshot, err)
+	return _c
+}
+
+func (_c *MockBudgetEvaluator_Snapshot_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.UserID, time1 time.Time) (domain.BudgetSnapshot, error)) *MockBudgetEvaluator_Snapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockQualityInterface creates a new instance of MockQualityInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockQualityInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockQualityInterface {
+	mock := &MockQualityInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockQualityInterface is an autogenerated mock type for the QualityInterface type
+type MockQualityInterface struct {
+	mock.Mock
+}
+
+type MockQualityInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockQualityInterface) EXPECT() *MockQualityInterface_Expecter {
+	return &MockQualityInterface_Expecter{mock: &_m.Mock}
+}
+
+// Fix provides a mock function for the type MockQualityInterface
+func (_mock *MockQualityInterface) Fix(context1 context.Context, b bool) (domain.QualityFix, error) {
+	ret := _mock.Called(context1, b)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fix")
+	}
+
+	var r0 domain.QualityFix
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) (domain.QualityFix, error)); ok {
+		return returnFunc(context1, b)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) domain.QualityFix); ok {
+		r0 = returnFunc(context1, b)
+	} else {
+		r0 = ret.Get(0).(domain.QualityFix)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = returnFunc(context1, b)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQualityInterface_Fix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fix'
+type MockQualityInterface_Fix_Call struct {
+	*mock.Call
+}
+
+// Fix is a helper method to define mock.On call
+//   - context1 context.Context
+//   - b bool
+func (_e *MockQualityInterface_Expecter) Fix(context1 interface{}, b interface{}) *MockQualityInterface_Fix_Call {
+	return &MockQualityInterface_Fix_Call{Call: _e.mock.On("Fix", context1, b)}
+}
+
+func (_c *MockQualityInterface_Fix_Call) Run(run func(context1 context.Context, b bool)) *MockQualityInterface_Fix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQualityInterface_Fix_Call) Return(qualityFix domain.QualityFix, err error) *MockQualityInterface_Fix_Call {
+	_c.Call.Return(qualityFix, err)
+	return _c
+}
+
+func (_c *MockQualityInterface_Fix_Call) RunAndReturn(run func(context1 context.Context, b bool) (domain.QualityFix, error)) *MockQualityInterface_Fix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Scan provides a mock function for the type MockQualityInterface
+func (_mock *MockQualityInterface) Scan(context1 context.Context) ([]domain.Anomaly, error) {
+	ret := _mock.Called(context1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Scan")
+	}
+
+	var r0 []domain.Anomaly
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.Anomaly, error)); ok {
+		return returnFunc(context1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.Anomaly); ok {
+		r0 = returnFunc(context1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Anomaly)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(context1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQualityInterface_Scan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Scan'
+type MockQualityInterface_Scan_Call struct {
+	*mock.Call
+}
+
+// Scan is a helper method to define mock.On call
+//   - context1 context.Context
+func (_e *MockQualityInterface_Expecter) Scan(context1 interface{}) *MockQualityInterface_Scan_Call {
+	return &MockQualityInterface_Scan_Call{Call: _e.mock.On("Scan", context1)}
+}
+
+func (_c *MockQualityInterface_Scan_Call) Run(run func(context1 context.Context)) *MockQualityInterface_Scan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQualityInterface_Scan_Call) Return(anomalys []domain.Anomaly, err error) *MockQualityInterface_Scan_Call {
+	_c.Call.Return(anomalys, err)
+	return _c
+}
+
+func (_c *MockQualityInterface_Scan_Call) RunAndReturn(run func(context1 context.Context) ([]domain.Anomaly, error)) *MockQualityInterface_Scan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuditInterface creates a new instance of MockAuditInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuditInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuditInterface {
+	mock := &MockAuditInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuditInterface is an autogenerated mock type for the AuditInterface type
+type MockAuditInterface struct {
+	mock.Mock
+}
+
+type MockAuditInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditInterface) EXPECT() *MockAuditInterface_Expecter {
+	return &MockAuditInterface_Expecter{mock: &_m.Mock}
+}
+
+// History provides a mock function for the type MockAuditInterface
+func (_mock *MockAuditInterface) History(context1 context.Context, v domain.SubscriptionID) ([]domain.AuditEntry, error) {
+	ret := _mock.Called(context1, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []domain.AuditEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID) ([]domain.AuditEntry, error)); ok {
+		return returnFunc(context1, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SubscriptionID) []domain.AuditEntry); ok {
+		r0 = returnFunc(context1, v)
 	} else {
-		tmpRet = _mock.Called(context1, s)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEntry)
+		}
 	}
-	ret := tmpRet
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.SubscriptionID) error); ok {
+		r1 = returnFunc(context1, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditInterface_History_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'History'
+type MockAuditInterface_History_Call struct {
+	*mock.Call
+}
+
+// History is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.SubscriptionID
+func (_e *MockAuditInterface_Expecter) History(context1 interface{}, v interface{}) *MockAuditInterface_History_Call {
+	return &MockAuditInterface_History_Call{Call: _e.mock.On("History", context1, v)}
+}
+
+func (_c *MockAuditInterface_History_Call) Run(run func(context1 context.Context, v domain.SubscriptionID)) *MockAuditInterface_History_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SubscriptionID
+		if args[1] != nil {
+			arg1 = args[1].(domain.SubscriptionID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuditInterface_History_Call) Return(auditEntrys []domain.AuditEntry, err error) *MockAuditInterface_History_Call {
+	_c.Call.Return(auditEntrys, err)
+	return _c
+}
+
+func (_c *MockAuditInterface_History_Call) RunAndReturn(run func(context1 context.Context, v domain.SubscriptionID) ([]domain.AuditEntry, error)) *MockAuditInterface_History_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockAuditInterface
+func (_mock *MockAuditInterface) Search(context1 context.Context, auditFilter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	ret := _mock.Called(context1, auditFilter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExecContext")
+		panic("no return value specified for Search")
 	}
 
-	var r0 int64
+	var r0 []domain.AuditEntry
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ...any) (int64, error)); ok {
-		return returnFunc(context1, s, vs...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuditFilter) ([]domain.AuditEntry, error)); ok {
+		return returnFunc(context1, auditFilter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ...any) int64); ok {
-		r0 = returnFunc(context1, s, vs...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuditFilter) []domain.AuditEntry); ok {
+		r0 = returnFunc(context1, auditFilter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuditFilter) error); ok {
+		r1 = returnFunc(context1, auditFilter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditInterface_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockAuditInterface_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - context1 context.Context
+//   - auditFilter domain.AuditFilter
+func (_e *MockAuditInterface_Expecter) Search(context1 interface{}, auditFilter interface{}) *MockAuditInterface_Search_Call {
+	return &MockAuditInterface_Search_Call{Call: _e.mock.On("Search", context1, auditFilter)}
+}
+
+func (_c *MockAuditInterface_Search_Call) Run(run func(context1 context.Context, auditFilter domain.AuditFilter)) *MockAuditInterface_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuditFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuditFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuditInterface_Search_Call) Return(auditEntrys []domain.AuditEntry, err error) *MockAuditInterface_Search_Call {
+	_c.Call.Return(auditEntrys, err)
+	return _c
+}
+
+func (_c *MockAuditInterface_Search_Call) RunAndReturn(run func(context1 context.Context, auditFilter domain.AuditFilter) ([]domain.AuditEntry, error)) *MockAuditInterface_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAdminInterface creates a new instance of MockAdminInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAdminInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAdminInterface {
+	mock := &MockAdminInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAdminInterface is an autogenerated mock type for the AdminInterface type
+type MockAdminInterface struct {
+	mock.Mock
+}
+
+type MockAdminInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAdminInterface) EXPECT() *MockAdminInterface_Expecter {
+	return &MockAdminInterface_Expecter{mock: &_m.Mock}
+}
+
+// MergeServices provides a mock function for the type MockAdminInterface
+func (_mock *MockAdminInterface) MergeServices(context1 context.Context, v domain.ServiceName, v1 domain.ServiceName) (domain.ServiceRewrite, error) {
+	ret := _mock.Called(context1, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeServices")
+	}
+
+	var r0 domain.ServiceRewrite
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ServiceName, domain.ServiceName) (domain.ServiceRewrite, error)); ok {
+		return returnFunc(context1, v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ServiceName, domain.ServiceName) domain.ServiceRewrite); ok {
+		r0 = returnFunc(context1, v, v1)
+	} else {
+		r0 = ret.Get(0).(domain.ServiceRewrite)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ServiceName, domain.ServiceName) error); ok {
+		r1 = returnFunc(context1, v, v1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAdminInterface_MergeServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeServices'
+type MockAdminInterface_MergeServices_Call struct {
+	*mock.Call
+}
+
+// MergeServices is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.ServiceName
+//   - v1 domain.ServiceName
+func (_e *MockAdminInterface_Expecter) MergeServices(context1 interface{}, v interface{}, v1 interface{}) *MockAdminInterface_MergeServices_Call {
+	return &MockAdminInterface_MergeServices_Call{Call: _e.mock.On("MergeServices", context1, v, v1)}
+}
+
+func (_c *MockAdminInterface_MergeServices_Call) Run(run func(context1 context.Context, v domain.ServiceName, v1 domain.ServiceName)) *MockAdminInterface_MergeServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ServiceName
+		if args[1] != nil {
+			arg1 = args[1].(domain.ServiceName)
+		}
+		var arg2 domain.ServiceName
+		if args[2] != nil {
+			arg2 = args[2].(domain.ServiceName)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAdminInterface_MergeServices_Call) Return(serviceRewrite domain.ServiceRewrite, err error) *MockAdminInterface_MergeServices_Call {
+	_c.Call.Return(serviceRewrite, err)
+	return _c
+}
+
+func (_c *MockAdminInterface_MergeServices_Call) RunAndReturn(run func(context1 context.Context, v domain.ServiceName, v1 domain.ServiceName) (domain.ServiceRewrite, error)) *MockAdminInterface_MergeServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameService provides a mock function for the type MockAdminInterface
+func (_mock *MockAdminInterface) RenameService(context1 context.Context, v domain.ServiceName, v1 domain.ServiceName) (domain.ServiceRewrite, error) {
+	ret := _mock.Called(context1, v, v1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameService")
+	}
+
+	var r0 domain.ServiceRewrite
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ServiceName, domain.ServiceName) (domain.ServiceRewrite, error)); ok {
+		return returnFunc(context1, v, v1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ServiceName, domain.ServiceName) domain.ServiceRewrite); ok {
+		r0 = returnFunc(context1, v, v1)
+	} else {
+		r0 = ret.Get(0).(domain.ServiceRewrite)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ServiceName, domain.ServiceName) error); ok {
+		r1 = returnFunc(context1, v, v1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAdminInterface_RenameService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameService'
+type MockAdminInterface_RenameService_Call struct {
+	*mock.Call
+}
+
+// RenameService is a helper method to define mock.On call
+//   - context1 context.Context
+//   - v domain.ServiceName
+//   - v1 domain.ServiceName
+func (_e *MockAdminInterface_Expecter) RenameService(context1 interface{}, v interface{}, v1 interface{}) *MockAdminInterface_RenameService_Call {
+	return &MockAdminInterface_RenameService_Call{Call: _e.mock.On("RenameService", context1, v, v1)}
+}
+
+func (_c *MockAdminInterface_RenameService_Call) Run(run func(context1 context.Context, v domain.ServiceName, v1 domain.ServiceName)) *MockAdminInterface_RenameService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ServiceName
+		if args[1] != nil {
+			arg1 = args[1].(domain.ServiceName)
+		}
+		var arg2 domain.ServiceName
+		if args[2] != nil {
+			arg2 = args[2].(domain.ServiceName)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAdminInterface_RenameService_Call) Return(serviceRewrite domain.ServiceRewrite, err error) *MockAdminInterface_RenameService_Call {
+	_c.Call.Return(serviceRewrite, err)
+	return _c
+}
+
+func (_c *MockAdminInterface_RenameService_Call) RunAndReturn(run func(context1 context.Context, v domain.ServiceName, v1 domain.ServiceName) (domain.ServiceRewrite, error)) *MockAdminInterface_RenameService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferUser provides a mock function for the type MockAdminInterface
+func (_mock *MockAdminInterface) TransferUser(context1 context.Context, userTransfer domain.UserTransfer) (domain.TransferResult, error) {
+	ret := _mock.Called(context1, userTransfer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferUser")
+	}
+
+	var r0 domain.TransferResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserTransfer) (domain.TransferResult, error)); ok {
+		return returnFunc(context1, userTransfer)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserTransfer) domain.TransferResult); ok {
+		r0 = returnFunc(context1, userTransfer)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(domain.TransferResult)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, ...any) error); ok {
-		r1 = returnFunc(context1, s, vs...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserTransfer) error); ok {
+		r1 = returnFunc(context1, userTransfer)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockConnection_ExecContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecContext'
-type MockConnection_ExecContext_Call struct {
+// MockAdminInterface_TransferUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferUser'
+type MockAdminInterface_TransferUser_Call struct {
 	*mock.Call
 }
 
-// ExecContext is a helper method to define mock.On call
+// TransferUser is a helper method to define mock.On call
 //   - context1 context.Context
-//   - s string
-//   - vs ...any
-func (_e *MockConnection_Expecter) ExecContext(context1 interface{}, s interface{}, vs ...interface{}) *MockConnection_ExecContext_Call {
-	return &MockConnection_ExecContext_Call{Call: _e.mock.On("ExecContext",
-		append([]interface{}{context1, s}, vs...)...)}
+//   - userTransfer domain.UserTransfer
+func (_e *MockAdminInterface_Expecter) TransferUser(context1 interface{}, userTransfer interface{}) *MockAdminInterface_TransferUser_Call {
+	return &MockAdminInterface_TransferUser_Call{Call: _e.mock.On("TransferUser", context1, userTransfer)}
 }
 
-func (_c *MockConnection_ExecContext_Call) Run(run func(context1 context.Context, s string, vs ...any)) *MockConnection_ExecContext_Call {
+func (_c *MockAdminInterface_TransferUser_Call) Run(run func(context1 context.Context, userTransfer domain.UserTransfer)) *MockAdminInterface_TransferUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.UserTransfer
 		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 []any
-		var variadicArgs []any
-		if len(args) > 2 {
-			variadicArgs = args[2].([]any)
+			arg1 = args[1].(domain.UserTransfer)
 		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockConnection_ExecContext_Call) Return(n int64, err error) *MockConnection_ExecContext_Call {
-	_c.Call.Return(n, err)
+func (_c *MockAdminInterface_TransferUser_Call) Return(transferResult domain.TransferResult, err error) *MockAdminInterface_TransferUser_Call {
+	_c.Call.Return(transferResult, err)
 	return _c
 }
 
-func (_c *MockConnection_ExecContext_Call) RunAndReturn(run func(context1 context.Context, s string, vs ...any) (int64, error)) *MockConnection_ExecContext_Call {
+func (_c *MockAdminInterface_TransferUser_Call) RunAndReturn(run func(context1 context.Context, userTransfer domain.UserTransfer) (domain.TransferResult, error)) *MockAdminInterface_TransferUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetContext provides a mock function for the type MockConnection
-func (_mock *MockConnection) GetContext(context1 context.Context, v any, s string, vs ...any) error {
-	var tmpRet mock.Arguments
-	if len(vs) > 0 {
-		tmpRet = _mock.Called(context1, v, s, vs)
-	} else {
-		tmpRet = _mock.Called(context1, v, s)
-	}
-	ret := tmpRet
+// NewMockAnalyticsInterface creates a new instance of MockAnalyticsInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnalyticsInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnalyticsInterface {
+	mock := &MockAnalyticsInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAnalyticsInterface is an autogenerated mock type for the AnalyticsInterface type
+type MockAnalyticsInterface struct {
+	mock.Mock
+}
+
+type MockAnalyticsInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnalyticsInterface) EXPECT() *MockAnalyticsInterface_Expecter {
+	return &MockAnalyticsInterface_Expecter{mock: &_m.Mock}
+}
+
+// Churn provides a mock function for the type MockAnalyticsInterface
+func (_mock *MockAnalyticsInterface) Churn(context1 context.Context, period domain.Period, v *domain.ServiceName) ([]domain.ServiceChurn, error) {
+	ret := _mock.Called(context1, period, v)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetContext")
+		panic("no return value specified for Churn")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, any, string, ...any) error); ok {
-		r0 = returnFunc(context1, v, s, vs...)
+	var r0 []domain.ServiceChurn
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Period, *domain.ServiceName) ([]domain.ServiceChurn, error)); ok {
+		return returnFunc(context1, period, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Period, *domain.ServiceName) []domain.ServiceChurn); ok {
+		r0 = returnFunc(context1, period, v)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ServiceChurn)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Period, *domain.ServiceName) error); ok {
+		r1 = returnFunc(context1, period, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockConnection_GetContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetContext'
-type MockConnection_GetContext_Call struct {
+// MockAnalyticsInterface_Churn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Churn'
+type MockAnalyticsInterface_Churn_Call struct {
 	*mock.Call
 }
 
-// GetContext is a helper method to define mock.On call
+// Churn is a helper method to define mock.On call
 //   - context1 context.Context
-//   - v any
-//   - s string
-//   - vs ...any
-func (_e *MockConnection_Expecter) GetContext(context1 interface{}, v interface{}, s interface{}, vs ...interface{}) *MockConnection_GetContext_Call {
-	return &MockConnection_GetContext_Call{Call: _e.mock.On("GetContext",
-		append([]interface{}{context1, v, s}, vs...)...)}
+//   - period domain.Period
+//   - v *domain.ServiceName
+func (_e *MockAnalyticsInterface_Expecter) Churn(context1 interface{}, period interface{}, v interface{}) *MockAnalyticsInterface_Churn_Call {
+	return &MockAnalyticsInterface_Churn_Call{Call: _e.mock.On("Churn", context1, period, v)}
 }
 
-func (_c *MockConnection_GetContext_Call) Run(run func(context1 context.Context, v any, s string, vs ...any)) *MockConnection_GetContext_Call {
+func (_c *MockAnalyticsInterface_Churn_Call) Run(run func(context1 context.Context, period domain.Period, v *domain.ServiceName)) *MockAnalyticsInterface_Churn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 any
+		var arg1 domain.Period
 		if args[1] != nil {
-			arg1 = args[1].(any)
+			arg1 = args[1].(domain.Period)
 		}
-		var arg2 string
+		var arg2 *domain.ServiceName
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(*domain.ServiceName)
 		}
-		var arg3 []any
-		var variadicArgs []any
-		if len(args) > 3 {
-			variadicArgs = args[3].([]any)
-		}
-		arg3 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockConnection_GetContext_Call) Return(err error) *MockConnection_GetContext_Call {
-	_c.Call.Return(err)
+func (_c *MockAnalyticsInterface_Churn_Call) Return(serviceChurns []domain.ServiceChurn, err error) *MockAnalyticsInterface_Churn_Call {
+	_c.Call.Return(serviceChurns, err)
 	return _c
 }
 
-func (_c *MockConnection_GetContext_Call) RunAndReturn(run func(context1 context.Context, v any, s string, vs ...any) error) *MockConnection_GetContext_Call {
+func (_c *MockAnalyticsInterface_Churn_Call) RunAndReturn(run func(context1 context.Context, period domain.Period, v *domain.ServiceName) ([]domain.ServiceChurn, error)) *MockAnalyticsInterface_Churn_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SelectContext provides a mock function for the type MockConnection
-func (_mock *MockConnection) SelectContext(context1 context.Context, v any, s string, vs ...any) error {
-	var tmpRet mock.Arguments
-	if len(vs) > 0 {
-		tmpRet = _mock.Called(context1, v, s, vs)
-	} else {
-		tmpRet = _mock.Called(context1, v, s)
-	}
-	ret := tmpRet
+// Flow provides a mock function for the type MockAnalyticsInterface
+func (_mock *MockAnalyticsInterface) Flow(context1 context.Context, period domain.Period) ([]domain.SubscriptionFlow, error) {
+	ret := _mock.Called(context1, period)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SelectContext")
+		panic("no return value specified for Flow")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, any, string, ...any) error); ok {
-		r0 = returnFunc(context1, v, s, vs...)
+	var r0 []domain.SubscriptionFlow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Period) ([]domain.SubscriptionFlow, error)); ok {
+		return returnFunc(context1, period)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Period) []domain.SubscriptionFlow); ok {
+		r0 = returnFunc(context1, period)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SubscriptionFlow)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Period) error); ok {
+		r1 = returnFunc(context1, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockConnection_SelectContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectContext'
-type MockConnection_SelectContext_Call struct {
+// MockAnalyticsInterface_Flow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flow'
+type MockAnalyticsInterface_Flow_Call struct {
 	*mock.Call
 }
 
-// SelectContext is a helper method to define mock.On call
+// Flow is a helper method to define mock.On call
 //   - context1 context.Context
-//   - v any
-//   - s string
-//   - vs ...any
-func (_e *MockConnection_Expecter) SelectContext(context1 interface{}, v interface{}, s interface{}, vs ...interface{}) *MockConnection_SelectContext_Call {
-	return &MockConnection_SelectContext_Call{Call: _e.mock.On("SelectContext",
-		append([]interface{}{context1, v, s}, vs...)...)}
+//   - period domain.Period
+func (_e *MockAnalyticsInterface_Expecter) Flow(context1 interface{}, period interface{}) *MockAnalyticsInterface_Flow_Call {
+	return &MockAnalyticsInterface_Flow_Call{Call: _e.mock.On("Flow", context1, period)}
 }
 
-func (_c *MockConnection_SelectContext_Call) Run(run func(context1 context.Context, v any, s string, vs ...any)) *MockConnection_SelectContext_Call {
+func (_c *MockAnalyticsInterface_Flow_Call) Run(run func(context1 context.Context, period domain.Period)) *MockAnalyticsInterface_Flow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 any
+		var arg1 domain.Period
 		if args[1] != nil {
-			arg1 = args[1].(any)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 []any
-		var variadicArgs []any
-		if len(args) > 3 {
-			variadicArgs = args[3].([]any)
+			arg1 = args[1].(domain.Period)
 		}
-		arg3 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockConnection_SelectContext_Call) Return(err error) *MockConnection_SelectContext_Call {
-	_c.Call.Return(err)
+func (_c *MockAnalyticsInterface_Flow_Call) Return(subscriptionFlows []domain.SubscriptionFlow, err error) *MockAnalyticsInterface_Flow_Call {
+	_c.Call.Return(subscriptionFlows, err)
 	return _c
 }
 
-func (_c *MockConnection_SelectContext_Call) RunAndReturn(run func(context1 context.Context, v any, s string, vs ...any) error) *MockConnection_SelectContext_Call {
+func (_c *MockAnalyticsInterface_Flow_Call) RunAndReturn(run func(context1 context.Context, period domain.Period) ([]domain.SubscriptionFlow, error)) *MockAnalyticsInterface_Flow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockConnectionProvider creates a new instance of MockConnectionProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockConnectionProvider(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockConnectionProvider {
-	mock := &MockConnectionProvider{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockConnectionProvider is an autogenerated mock type for the ConnectionProvider type
-type MockConnectionProvider struct {
-	mock.Mock
-}
-
-type MockConnectionProvider_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockConnectionProvider) EXPECT() *MockConnectionProvider_Expecter {
-	return &MockConnectionProvider_Expecter{mock: &_m.Mock}
-}
-
-// Close provides a mock function for the type MockConnectionProvider
-func (_mock *MockConnectionProvider) Close() error {
-	ret := _mock.Called()
+// PriceAnomalies provides a mock function for the type MockAnalyticsInterface
+func (_mock *MockAnalyticsInterface) PriceAnomalies(context1 context.Context, period domain.Period) ([]domain.PriceAnomaly, error) {
+	ret := _mock.Called(context1, period)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Close")
+		panic("no return value specified for PriceAnomalies")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func() error); ok {
-		r0 = returnFunc()
+	var r0 []domain.PriceAnomaly
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Period) ([]domain.PriceAnomaly, error)); ok {
+		return returnFunc(context1, period)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Period) []domain.PriceAnomaly); ok {
+		r0 = returnFunc(context1, period)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PriceAnomaly)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Period) error); ok {
+		r1 = returnFunc(context1, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockConnectionProvider_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
-type MockConnectionProvider_Close_Call struct {
+// MockAnalyticsInterface_PriceAnomalies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PriceAnomalies'
+type MockAnalyticsInterface_PriceAnomalies_Call struct {
 	*mock.Call
 }
 
-// Close is a helper method to define mock.On call
-func (_e *MockConnectionProvider_Expecter) Close() *MockConnectionProvider_Close_Call {
-	return &MockConnectionProvider_Close_Call{Call: _e.mock.On("Close")}
+// PriceAnomalies is a helper method to define mock.On call
+//   - context1 context.Context
+//   - period domain.Period
+func (_e *MockAnalyticsInterface_Expecter) PriceAnomalies(context1 interface{}, period interface{}) *MockAnalyticsInterface_PriceAnomalies_Call {
+	return &MockAnalyticsInterface_PriceAnomalies_Call{Call: _e.mock.On("PriceAnomalies", context1, period)}
 }
 
-func (_c *MockConnectionProvider_Close_Call) Run(run func()) *MockConnectionProvider_Close_Call {
+func (_c *MockAnalyticsInterface_PriceAnomalies_Call) Run(run func(context1 context.Context, period domain.Period)) *MockAnalyticsInterface_PriceAnomalies_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Period
+		if args[1] != nil {
+			arg1 = args[1].(domain.Period)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
 
-func (_c *MockConnectionProvider_Close_Call) Return(err error) *MockConnectionProvider_Close_Call {
-	_c.Call.Return(err)
+func (_c *MockAnalyticsInterface_PriceAnomalies_Call) Return(priceAnomalys []domain.PriceAnomaly, err error) *MockAnalyticsInterface_PriceAnomalies_Call {
+	_c.Call.Return(priceAnomalys, err)
 	return _c
 }
 
-func (_c *MockConnectionProvider_Close_Call) RunAndReturn(run func() error) *MockConnectionProvider_Close_Call {
+func (_c *MockAnalyticsInterface_PriceAnomalies_Call) RunAndReturn(run func(context1 context.Context, period domain.Period) ([]domain.PriceAnomaly, error)) *MockAnalyticsInterface_PriceAnomalies_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Execute provides a mock function for the type MockConnectionProvider
-func (_mock *MockConnectionProvider) Execute(context1 context.Context, fn func(context.Context, domain.Connection) error) error {
-	ret := _mock.Called(context1, fn)
+// PriceInsights provides a mock function for the type MockAnalyticsInterface
+func (_mock *MockAnalyticsInterface) PriceInsights(context1 context.Context, v domain.UserID, time1 time.Time) ([]domain.PriceAnomaly, error) {
+	ret := _mock.Called(context1, v, time1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Execute")
+		panic("no return value specified for PriceInsights")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, func(context.Context, domain.Connection) error) error); ok {
-		r0 = returnFunc(context1, fn)
+	var r0 []domain.PriceAnomaly
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, time.Time) ([]domain.PriceAnomaly, error)); ok {
+		return returnFunc(context1, v, time1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, time.Time) []domain.PriceAnomaly); ok {
+		r0 = returnFunc(context1, v, time1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PriceAnomaly)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID, time.Time) error); ok {
+		r1 = returnFunc(context1, v, time1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockConnectionProvider_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
-type MockConnectionProvider_Execute_Call struct {
+// MockAnalyticsInterface_PriceInsights_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PriceInsights'
+type MockAnalyticsInterface_PriceInsights_Call struct {
 	*mock.Call
 }
 
-// Execute is a helper method to define mock.On call
+// PriceInsights is a helper method to define mock.On call
 //   - context1 context.Context
-//   - fn func(context.Context, domain.Connection) error
-func (_e *MockConnectionProvider_Expecter) Execute(context1 interface{}, fn interface{}) *MockConnectionProvider_Execute_Call {
-	return &MockConnectionProvider_Execute_Call{Call: _e.mock.On("Execute", context1, fn)}
+//   - v domain.UserID
+//   - time1 time.Time
+func (_e *MockAnalyticsInterface_Expecter) PriceInsights(context1 interface{}, v interface{}, time1 interface{}) *MockAnalyticsInterface_PriceInsights_Call {
+	return &MockAnalyticsInterface_PriceInsights_Call{Call: _e.mock.On("PriceInsights", context1, v, time1)}
 }
 
-func (_c *MockConnectionProvider_Execute_Call) Run(run func(context1 context.Context, fn func(context.Context, domain.Connection) error)) *MockConnectionProvider_Execute_Call {
+func (_c *MockAnalyticsInterface_PriceInsights_Call) Run(run func(context1 context.Context, v domain.UserID, time1 time.Time)) *MockAnalyticsInterface_PriceInsights_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 func(context.Context, domain.Connection) error
+		var arg1 domain.UserID
 		if args[1] != nil {
-			arg1 = args[1].(func(context.Context, domain.Connection) error)
+			arg1 = args[1].(domain.UserID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockConnectionProvider_Execute_Call) Return(err error) *MockConnectionProvider_Execute_Call {
-	_c.Call.Return(err)
+func (_c *MockAnalyticsInterface_PriceInsights_Call) Return(priceAnomalys []domain.PriceAnomaly, err error) *MockAnalyticsInterface_PriceInsights_Call {
+	_c.Call.Return(priceAnomalys, err)
 	return _c
 }
 
-func (_c *MockConnectionProvider_Execute_Call) RunAndReturn(run func(context1 context.Context, fn func(context.Context, domain.Connection) error) error) *MockConnectionProvider_Execute_Call {
+func (_c *MockAnalyticsInterface_PriceInsights_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID, time1 time.Time) ([]domain.PriceAnomaly, error)) *MockAnalyticsInterface_PriceInsights_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExecuteTx provides a mock function for the type MockConnectionProvider
-func (_mock *MockConnectionProvider) ExecuteTx(context1 context.Context, fn func(context.Context, domain.Connection) error) error {
-	ret := _mock.Called(context1, fn)
+// Prices provides a mock function for the type MockAnalyticsInterface
+func (_mock *MockAnalyticsInterface) Prices(context1 context.Context) ([]domain.ServicePrice, error) {
+	ret := _mock.Called(context1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExecuteTx")
+		panic("no return value specified for Prices")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, func(context.Context, domain.Connection) error) error); ok {
-		r0 = returnFunc(context1, fn)
+	var r0 []domain.ServicePrice
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.ServicePrice, error)); ok {
+		return returnFunc(context1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.ServicePrice); ok {
+		r0 = returnFunc(context1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ServicePrice)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(context1)
+	} else {
+		r1 = ret.Error(1)
 	}
-	return r0
+	return r0, r1
 }
 
-// MockConnectionProvider_ExecuteTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteTx'
-type MockConnectionProvider_ExecuteTx_Call struct {
+// MockAnalyticsInterface_Prices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prices'
+type MockAnalyticsInterface_Prices_Call struct {
 	*mock.Call
 }
 
-// ExecuteTx is a helper method to define mock.On call
+// Prices is a helper method to define mock.On call
 //   - context1 context.Context
-//   - fn func(context.Context, domain.Connection) error
-func (_e *MockConnectionProvider_Expecter) ExecuteTx(context1 interface{}, fn interface{}) *MockConnectionProvider_ExecuteTx_Call {
-	return &MockConnectionProvider_ExecuteTx_Call{Call: _e.mock.On("ExecuteTx", context1, fn)}
+func (_e *MockAnalyticsInterface_Expecter) Prices(context1 interface{}) *MockAnalyticsInterface_Prices_Call {
+	return &MockAnalyticsInterface_Prices_Call{Call: _e.mock.On("Prices", context1)}
 }
 
-func (_c *MockConnectionProvider_ExecuteTx_Call) Run(run func(context1 context.Context, fn func(context.Context, domain.Connection) error)) *MockConnectionProvider_ExecuteTx_Call {
+func (_c *MockAnalyticsInterface_Prices_Call) Run(run func(context1 context.Context)) *MockAnalyticsInterface_Prices_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 func(context.Context, domain.Connection) error
-		if args[1] != nil {
-			arg1 = args[1].(func(context.Context, domain.Connection) error)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockConnectionProvider_ExecuteTx_Call) Return(err error) *MockConnectionProvider_ExecuteTx_Call {
-	_c.Call.Return(err)
+func (_c *MockAnalyticsInterface_Prices_Call) Return(servicePrices []domain.ServicePrice, err error) *MockAnalyticsInterface_Prices_Call {
+	_c.Call.Return(servicePrices, err)
 	return _c
 }
 
-func (_c *MockConnectionProvider_ExecuteTx_Call) RunAndReturn(run func(context1 context.Context, fn func(context.Context, domain.Connection) error) error) *MockConnectionProvider_ExecuteTx_Call {
+func (_c *MockAnalyticsInterface_Prices_Call) RunAndReturn(run func(context1 context.Context) ([]domain.ServicePrice, error)) *MockAnalyticsInterface_Prices_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSubscriptionInterface creates a new instance of MockSubscriptionInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockSubscriptionInterface(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockSubscriptionInterface {
-	mock := &MockSubscriptionInterface{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockSubscriptionInterface is an autogenerated mock type for the SubscriptionInterface type
-type MockSubscriptionInterface struct {
-	mock.Mock
-}
-
-type MockSubscriptionInterface_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockSubscriptionInterface) EXPECT() *MockSubscriptionInterface_Expecter {
-	return &MockSubscriptionInterface_Expecter{mock: &_m.Mock}
-}
-
-// Create provides a mock function for the type MockSubscriptionInterface
-func (_mock *MockSubscriptionInterface) Create(context1 context.Context, subscription domain.Subscription) error {
-	ret := _mock.Called(context1, subscription)
+// Retention provides a mock function for the type MockAnalyticsInterface
+func (_mock *MockAnalyticsInterface) Retention(context1 context.Context, period domain.Period, v *domain.ServiceName) ([]domain.Cohort, error) {
+	ret := _mock.Called(context1, period, v)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Retention")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Subscription) error); ok {
-		r0 = returnFunc(context1, subscription)
+	var r0 []domain.Cohort
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Period, *domain.ServiceName) ([]domain.Cohort, error)); ok {
+		return returnFunc(context1, period, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Period, *domain.ServiceName) []domain.Cohort); ok {
+		r0 = returnFunc(context1, period, v)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Cohort)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Period, *domain.ServiceName) error); ok {
+		r1 = returnFunc(context1, period, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockSubscriptionInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockSubscriptionInterface_Create_Call struct {
+// MockAnalyticsInterface_Retention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retention'
+type MockAnalyticsInterface_Retention_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// Retention is a helper method to define mock.On call
 //   - context1 context.Context
-//   - subscription domain.Subscription
-func (_e *MockSubscriptionInterface_Expecter) Create(context1 interface{}, subscription interface{}) *MockSubscriptionInterface_Create_Call {
-	return &MockSubscriptionInterface_Create_Call{Call: _e.mock.On("Create", context1, subscription)}
+//   - period domain.Period
+//   - v *domain.ServiceName
+func (_e *MockAnalyticsInterface_Expecter) Retention(context1 interface{}, period interface{}, v interface{}) *MockAnalyticsInterface_Retention_Call {
+	return &MockAnalyticsInterface_Retention_Call{Call: _e.mock.On("Retention", context1, period, v)}
 }
 
-func (_c *MockSubscriptionInterface_Create_Call) Run(run func(context1 context.Context, subscription domain.Subscription)) *MockSubscriptionInterface_Create_Call {
+func (_c *MockAnalyticsInterface_Retention_Call) Run(run func(context1 context.Context, period domain.Period, v *domain.ServiceName)) *MockAnalyticsInterface_Retention_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.Subscription
+		var arg1 domain.Period
 		if args[1] != nil {
-			arg1 = args[1].(domain.Subscription)
+			arg1 = args[1].(domain.Period)
+		}
+		var arg2 *domain.ServiceName
+		if args[2] != nil {
+			arg2 = args[2].(*domain.ServiceName)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSubscriptionInterface_Create_Call) Return(err error) *MockSubscriptionInterface_Create_Call {
-	_c.Call.Return(err)
+func (_c *MockAnalyticsInterface_Retention_Call) Return(cohorts []domain.Cohort, err error) *MockAnalyticsInterface_Retention_Call {
+	_c.Call.Return(cohorts, err)
 	return _c
 }
 
-func (_c *MockSubscriptionInterface_Create_Call) RunAndReturn(run func(context1 context.Context, subscription domain.Subscription) error) *MockSubscriptionInterface_Create_Call {
+func (_c *MockAnalyticsInterface_Retention_Call) RunAndReturn(run func(context1 context.Context, period domain.Period, v *domain.ServiceName) ([]domain.Cohort, error)) *MockAnalyticsInterface_Retention_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockSubscriptionInterface
-func (_mock *MockSubscriptionInterface) Delete(context1 context.Context, v domain.UserID, v1 domain.ServiceName) error {
-	ret := _mock.Called(context1, v, v1)
+// Revenue provides a mock function for the type MockAnalyticsInterface
+func (_mock *MockAnalyticsInterface) Revenue(context1 context.Context, period domain.Period) ([]domain.ServiceRevenue, error) {
+	ret := _mock.Called(context1, period)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for Revenue")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.ServiceName) error); ok {
-		r0 = returnFunc(context1, v, v1)
+	var r0 []domain.ServiceRevenue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Period) ([]domain.ServiceRevenue, error)); ok {
+		return returnFunc(context1, period)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Period) []domain.ServiceRevenue); ok {
+		r0 = returnFunc(context1, period)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ServiceRevenue)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Period) error); ok {
+		r1 = returnFunc(context1, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockSubscriptionInterface_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockSubscriptionInterface_Delete_Call struct {
+// MockAnalyticsInterface_Revenue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revenue'
+type MockAnalyticsInterface_Revenue_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// Revenue is a helper method to define mock.On call
 //   - context1 context.Context
-//   - v domain.UserID
-//   - v1 domain.ServiceName
-func (_e *MockSubscriptionInterface_Expecter) Delete(context1 interface{}, v interface{}, v1 interface{}) *MockSubscriptionInterface_Delete_Call {
-	return &MockSubscriptionInterface_Delete_Call{Call: _e.mock.On("Delete", context1, v, v1)}
+//   - period domain.Period
+func (_e *MockAnalyticsInterface_Expecter) Revenue(context1 interface{}, period interface{}) *MockAnalyticsInterface_Revenue_Call {
+	return &MockAnalyticsInterface_Revenue_Call{Call: _e.mock.On("Revenue", context1, period)}
 }
 
-func (_c *MockSubscriptionInterface_Delete_Call) Run(run func(context1 context.Context, v domain.UserID, v1 domain.ServiceName)) *MockSubscriptionInterface_Delete_Call {
+func (_c *MockAnalyticsInterface_Revenue_Call) Run(run func(context1 context.Context, period domain.Period)) *MockAnalyticsInterface_Revenue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.UserID
+		var arg1 domain.Period
 		if args[1] != nil {
-			arg1 = args[1].(domain.UserID)
-		}
-		var arg2 domain.ServiceName
-		if args[2] != nil {
-			arg2 = args[2].(domain.ServiceName)
+			arg1 = args[1].(domain.Period)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSubscriptionInterface_Delete_Call) Return(err error) *MockSubscriptionInterface_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockAnalyticsInterface_Revenue_Call) Return(serviceRevenues []domain.ServiceRevenue, err error) *MockAnalyticsInterface_Revenue_Call {
+	_c.Call.Return(serviceRevenues, err)
 	return _c
 }
 
-func (_c *MockSubscriptionInterface_Delete_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID, v1 domain.ServiceName) error) *MockSubscriptionInterface_Delete_Call {
+func (_c *MockAnalyticsInterface_Revenue_Call) RunAndReturn(run func(context1 context.Context, period domain.Period) ([]domain.ServiceRevenue, error)) *MockAnalyticsInterface_Revenue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLatest provides a mock function for the type MockSubscriptionInterface
-func (_mock *MockSubscriptionInterface) GetLatest(context1 context.Context, v domain.UserID) (domain.Subscription, error) {
-	ret := _mock.Called(context1, v)
+// TopServices provides a mock function for the type MockAnalyticsInterface
+func (_mock *MockAnalyticsInterface) TopServices(context1 context.Context, time1 time.Time, n int) ([]domain.ServiceSubscribers, error) {
+	ret := _mock.Called(context1, time1, n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLatest")
+		panic("no return value specified for TopServices")
 	}
 
-	var r0 domain.Subscription
+	var r0 []domain.ServiceSubscribers
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) (domain.Subscription, error)); ok {
-		return returnFunc(context1, v)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]domain.ServiceSubscribers, error)); ok {
+		return returnFunc(context1, time1, n)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) domain.Subscription); ok {
-		r0 = returnFunc(context1, v)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []domain.ServiceSubscribers); ok {
+		r0 = returnFunc(context1, time1, n)
 	} else {
-		r0 = ret.Get(0).(domain.Subscription)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ServiceSubscribers)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID) error); ok {
-		r1 = returnFunc(context1, v)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(context1, time1, n)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockSubscriptionInterface_GetLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatest'
-type MockSubscriptionInterface_GetLatest_Call struct {
+// MockAnalyticsInterface_TopServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TopServices'
+type MockAnalyticsInterface_TopServices_Call struct {
 	*mock.Call
 }
 
-// GetLatest is a helper method to define mock.On call
+// TopServices is a helper method to define mock.On call
 //   - context1 context.Context
-//   - v domain.UserID
-func (_e *MockSubscriptionInterface_Expecter) GetLatest(context1 interface{}, v interface{}) *MockSubscriptionInterface_GetLatest_Call {
-	return &MockSubscriptionInterface_GetLatest_Call{Call: _e.mock.On("GetLatest", context1, v)}
+//   - time1 time.Time
+//   - n int
+func (_e *MockAnalyticsInterface_Expecter) TopServices(context1 interface{}, time1 interface{}, n interface{}) *MockAnalyticsInterface_TopServices_Call {
+	return &MockAnalyticsInterface_TopServices_Call{Call: _e.mock.On("TopServices", context1, time1, n)}
 }
 
-func (_c *MockSubscriptionInterface_GetLatest_Call) Run(run func(context1 context.Context, v domain.UserID)) *MockSubscriptionInterface_GetLatest_Call {
+func (_c *MockAnalyticsInterface_TopServices_Call) Run(run func(context1 context.Context, time1 time.Time, n int)) *MockAnalyticsInterface_TopServices_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.UserID
+		var arg1 time.Time
 		if args[1] != nil {
-			arg1 = args[1].(domain.UserID)
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSubscriptionInterface_GetLatest_Call) Return(subscription domain.Subscription, err error) *MockSubscriptionInterface_GetLatest_Call {
-	_c.Call.Return(subscription, err)
+func (_c *MockAnalyticsInterface_TopServices_Call) Return(serviceSubscriberss []domain.ServiceSubscribers, err error) *MockAnalyticsInterface_TopServices_Call {
+	_c.Call.Return(serviceSubscriberss, err)
 	return _c
 }
 
-func (_c *MockSubscriptionInterface_GetLatest_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID) (domain.Subscription, error)) *MockSubscriptionInterface_GetLatest_Call {
+func (_c *MockAnalyticsInterface_TopServices_Call) RunAndReturn(run func(context1 context.Context, time1 time.Time, n int) ([]domain.ServiceSubscribers, error)) *MockAnalyticsInterface_TopServices_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReadAllByUserID provides a mock function for the type MockSubscriptionInterface
-func (_mock *MockSubscriptionInterface) ReadAllByUserID(context1 context.Context, v domain.UserID) ([]domain.Subscription, error) {
-	ret := _mock.Called(context1, v)
+// NewMockLedgerInterface creates a new instance of MockLedgerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLedgerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLedgerInterface {
+	mock := &MockLedgerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLedgerInterface is an autogenerated mock type for the LedgerInterface type
+type MockLedgerInterface struct {
+	mock.Mock
+}
+
+type MockLedgerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLedgerInterface) EXPECT() *MockLedgerInterface_Expecter {
+	return &MockLedgerInterface_Expecter{mock: &_m.Mock}
+}
+
+// Rebuild provides a mock function for the type MockLedgerInterface
+func (_mock *MockLedgerInterface) Rebuild(context1 context.Context, ledgerRebuild domain.LedgerRebuild) (int, error) {
+	ret := _mock.Called(context1, ledgerRebuild)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadAllByUserID")
+		panic("no return value specified for Rebuild")
 	}
 
-	var r0 []domain.Subscription
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) ([]domain.Subscription, error)); ok {
-		return returnFunc(context1, v)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.LedgerRebuild) (int, error)); ok {
+		return returnFunc(context1, ledgerRebuild)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID) []domain.Subscription); ok {
-		r0 = returnFunc(context1, v)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.LedgerRebuild) int); ok {
+		r0 = returnFunc(context1, ledgerRebuild)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Subscription)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID) error); ok {
-		r1 = returnFunc(context1, v)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.LedgerRebuild) error); ok {
+		r1 = returnFunc(context1, ledgerRebuild)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockSubscriptionInterface_ReadAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAllByUserID'
-type MockSubscriptionInterface_ReadAllByUserID_Call struct {
+// MockLedgerInterface_Rebuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rebuild'
+type MockLedgerInterface_Rebuild_Call struct {
 	*mock.Call
 }
 
-// ReadAllByUserID is a helper method to define mock.On call
+// Rebuild is a helper method to define mock.On call
 //   - context1 context.Context
-//   - v domain.UserID
-func (_e *MockSubscriptionInterface_Expecter) ReadAllByUserID(context1 interface{}, v interface{}) *MockSubscriptionInterface_ReadAllByUserID_Call {
-	return &MockSubscriptionInterface_ReadAllByUserID_Call{Call: _e.mock.On("ReadAllByUserID", context1, v)}
+//   - ledgerRebuild domain.LedgerRebuild
+func (_e *MockLedgerInterface_Expecter) Rebuild(context1 interface{}, ledgerRebuild interface{}) *MockLedgerInterface_Rebuild_Call {
+	return &MockLedgerInterface_Rebuild_Call{Call: _e.mock.On("Rebuild", context1, ledgerRebuild)}
 }
 
-func (_c *MockSubscriptionInterface_ReadAllByUserID_Call) Run(run func(context1 context.Context, v domain.UserID)) *MockSubscriptionInterface_ReadAllByUserID_Call {
+func (_c *MockLedgerInterface_Rebuild_Call) Run(run func(context1 context.Context, ledgerRebuild domain.LedgerRebuild)) *MockLedgerInterface_Rebuild_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.UserID
+		var arg1 domain.LedgerRebuild
 		if args[1] != nil {
-			arg1 = args[1].(domain.UserID)
+			arg1 = args[1].(domain.LedgerRebuild)
 		}
 		run(
 			arg0,
@@ -1272,153 +9308,176 @@ func (_c *MockSubscriptionInterface_ReadAllByUserID_Call) Run(run func(context1
 	return _c
 }
 
-func (_c *MockSubscriptionInterface_ReadAllByUserID_Call) Return(subscriptions []domain.Subscription, err error) *MockSubscriptionInterface_ReadAllByUserID_Call {
-	_c.Call.Return(subscriptions, err)
+func (_c *MockLedgerInterface_Rebuild_Call) Return(n int, err error) *MockLedgerInterface_Rebuild_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockSubscriptionInterface_ReadAllByUserID_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID) ([]domain.Subscription, error)) *MockSubscriptionInterface_ReadAllByUserID_Call {
+func (_c *MockLedgerInterface_Rebuild_Call) RunAndReturn(run func(context1 context.Context, ledgerRebuild domain.LedgerRebuild) (int, error)) *MockLedgerInterface_Rebuild_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// TotalSubscriptionsCost provides a mock function for the type MockSubscriptionInterface
-func (_mock *MockSubscriptionInterface) TotalSubscriptionsCost(context1 context.Context, v domain.UserID, v1 domain.ServiceName, time1 time.Time, time11 *time.Time) (int, error) {
-	ret := _mock.Called(context1, v, v1, time1, time11)
+// NewMockChargeLedger creates a new instance of MockChargeLedger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockChargeLedger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockChargeLedger {
+	mock := &MockChargeLedger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockChargeLedger is an autogenerated mock type for the ChargeLedger type
+type MockChargeLedger struct {
+	mock.Mock
+}
+
+type MockChargeLedger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockChargeLedger) EXPECT() *MockChargeLedger_Expecter {
+	return &MockChargeLedger_Expecter{mock: &_m.Mock}
+}
+
+// Charges provides a mock function for the type MockChargeLedger
+func (_mock *MockChargeLedger) Charges(context1 context.Context, connection domain.Connection, chargeQuery domain.ChargeQuery) ([]domain.Charge, error) {
+	ret := _mock.Called(context1, connection, chargeQuery)
 
 	if len(ret) == 0 {
-		panic("no return value specified for TotalSubscriptionsCost")
+		panic("no return value specified for Charges")
 	}
 
-	var r0 int
+	var r0 []domain.Charge
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.ServiceName, time.Time, *time.Time) (int, error)); ok {
-		return returnFunc(context1, v, v1, time1, time11)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ChargeQuery) ([]domain.Charge, error)); ok {
+		return returnFunc(context1, connection, chargeQuery)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.ServiceName, time.Time, *time.Time) int); ok {
-		r0 = returnFunc(context1, v, v1, time1, time11)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ChargeQuery) []domain.Charge); ok {
+		r0 = returnFunc(context1, connection, chargeQuery)
 	} else {
-		r0 = ret.Get(0).(int)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Charge)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserID, domain.ServiceName, time.Time, *time.Time) error); ok {
-		r1 = returnFunc(context1, v, v1, time1, time11)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.ChargeQuery) error); ok {
+		r1 = returnFunc(context1, connection, chargeQuery)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockSubscriptionInterface_TotalSubscriptionsCost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TotalSubscriptionsCost'
-type MockSubscriptionInterface_TotalSubscriptionsCost_Call struct {
+// MockChargeLedger_Charges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Charges'
+type MockChargeLedger_Charges_Call struct {
 	*mock.Call
 }
 
-// TotalSubscriptionsCost is a helper method to define mock.On call
+// Charges is a helper method to define mock.On call
 //   - context1 context.Context
-//   - v domain.UserID
-//   - v1 domain.ServiceName
-//   - time1 time.Time
-//   - time11 *time.Time
-func (_e *MockSubscriptionInterface_Expecter) TotalSubscriptionsCost(context1 interface{}, v interface{}, v1 interface{}, time1 interface{}, time11 interface{}) *MockSubscriptionInterface_TotalSubscriptionsCost_Call {
-	return &MockSubscriptionInterface_TotalSubscriptionsCost_Call{Call: _e.mock.On("TotalSubscriptionsCost", context1, v, v1, time1, time11)}
+//   - connection domain.Connection
+//   - chargeQuery domain.ChargeQuery
+func (_e *MockChargeLedger_Expecter) Charges(context1 interface{}, connection interface{}, chargeQuery interface{}) *MockChargeLedger_Charges_Call {
+	return &MockChargeLedger_Charges_Call{Call: _e.mock.On("Charges", context1, connection, chargeQuery)}
 }
 
-func (_c *MockSubscriptionInterface_TotalSubscriptionsCost_Call) Run(run func(context1 context.Context, v domain.UserID, v1 domain.ServiceName, time1 time.Time, time11 *time.Time)) *MockSubscriptionInterface_TotalSubscriptionsCost_Call {
+func (_c *MockChargeLedger_Charges_Call) Run(run func(context1 context.Context, connection domain.Connection, chargeQuery domain.ChargeQuery)) *MockChargeLedger_Charges_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.UserID
+		var arg1 domain.Connection
 		if args[1] != nil {
-			arg1 = args[1].(domain.UserID)
+			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 domain.ServiceName
+		var arg2 domain.ChargeQuery
 		if args[2] != nil {
-			arg2 = args[2].(domain.ServiceName)
-		}
-		var arg3 time.Time
-		if args[3] != nil {
-			arg3 = args[3].(time.Time)
-		}
-		var arg4 *time.Time
-		if args[4] != nil {
-			arg4 = args[4].(*time.Time)
+			arg2 = args[2].(domain.ChargeQuery)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
-			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSubscriptionInterface_TotalSubscriptionsCost_Call) Return(n int, err error) *MockSubscriptionInterface_TotalSubscriptionsCost_Call {
-	_c.Call.Return(n, err)
+func (_c *MockChargeLedger_Charges_Call) Return(charges []domain.Charge, err error) *MockChargeLedger_Charges_Call {
+	_c.Call.Return(charges, err)
 	return _c
 }
 
-func (_c *MockSubscriptionInterface_TotalSubscriptionsCost_Call) RunAndReturn(run func(context1 context.Context, v domain.UserID, v1 domain.ServiceName, time1 time.Time, time11 *time.Time) (int, error)) *MockSubscriptionInterface_TotalSubscriptionsCost_Call {
+func (_c *MockChargeLedger_Charges_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, chargeQuery domain.ChargeQuery) ([]domain.Charge, error)) *MockChargeLedger_Charges_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockSubscriptionInterface
-func (_mock *MockSubscriptionInterface) Update(context1 context.Context, subscription domain.Subscription) error {
-	ret := _mock.Called(context1, subscription)
+// Sync provides a mock function for the type MockChargeLedger
+func (_mock *MockChargeLedger) Sync(context1 context.Context, connection domain.Connection, v domain.SubscriptionID) error {
+	ret := _mock.Called(context1, connection, v)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for Sync")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Subscription) error); ok {
-		r0 = returnFunc(context1, subscription)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.SubscriptionID) error); ok {
+		r0 = returnFunc(context1, connection, v)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSubscriptionInterface_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockSubscriptionInterface_Update_Call struct {
+// MockChargeLedger_Sync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sync'
+type MockChargeLedger_Sync_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// Sync is a helper method to define mock.On call
 //   - context1 context.Context
-//   - subscription domain.Subscription
-func (_e *MockSubscriptionInterface_Expecter) Update(context1 interface{}, subscription interface{}) *MockSubscriptionInterface_Update_Call {
-	return &MockSubscriptionInterface_Update_Call{Call: _e.mock.On("Update", context1, subscription)}
+//   - connection domain.Connection
+//   - v domain.SubscriptionID
+func (_e *MockChargeLedger_Expecter) Sync(context1 interface{}, connection interface{}, v interface{}) *MockChargeLedger_Sync_Call {
+	return &MockChargeLedger_Sync_Call{Call: _e.mock.On("Sync", context1, connection, v)}
 }
 
-func (_c *MockSubscriptionInterface_Update_Call) Run(run func(context1 context.Context, subscription domain.Subscription)) *MockSubscriptionInterface_Update_Call {
+func (_c *MockChargeLedger_Sync_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID)) *MockChargeLedger_Sync_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.Subscription
+		var arg1 domain.Connection
 		if args[1] != nil {
-			arg1 = args[1].(domain.Subscription)
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.SubscriptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.SubscriptionID)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSubscriptionInterface_Update_Call) Return(err error) *MockSubscriptionInterface_Update_Call {
+func (_c *MockChargeLedger_Sync_Call) Return(err error) *MockChargeLedger_Sync_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSubscriptionInterface_Update_Call) RunAndReturn(run func(context1 context.Context, subscription domain.Subscription) error) *MockSubscriptionInterface_Update_Call {
+func (_c *MockChargeLedger_Sync_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.SubscriptionID) error) *MockChargeLedger_Sync_Call {
 	_c.Call.Return(run)
 	return _c
 }
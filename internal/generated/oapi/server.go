@@ -10,10 +10,12 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
@@ -22,286 +24,2695 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// MessageResponse defines model for MessageResponse.
-type MessageResponse struct {
-	Message string `json:"message"`
-}
+// Defines values for AnomalyType.
+const (
+	Duplicate       AnomalyType = "duplicate"
+	EndBeforeStart  AnomalyType = "end_before_start"
+	NonPositiveCost AnomalyType = "non_positive_cost"
+	Overlap         AnomalyType = "overlap"
+	SentinelEndDate AnomalyType = "sentinel_end_date"
+)
 
-// Subscription defines model for Subscription.
-type Subscription struct {
-	Cost      int                `json:"cost"`
-	DateEnd   *string            `json:"dateEnd,omitempty"`
-	DateStart string             `json:"dateStart"`
-	Id        openapi_types.UUID `json:"id"`
-	Name      string             `json:"name"`
+// Defines values for BreakdownItemKind.
+const (
+	BreakdownItemKindDiscount     BreakdownItemKind = "discount"
+	BreakdownItemKindSubscription BreakdownItemKind = "subscription"
+)
+
+// Defines values for BudgetScope.
+const (
+	BudgetScopeCategory BudgetScope = "category"
+	BudgetScopeService  BudgetScope = "service"
+	BudgetScopeTotal    BudgetScope = "total"
+)
+
+// Defines values for CancelSubscriptionRequestReasonCode.
+const (
+	CancelSubscriptionRequestReasonCodeMissingFeatures CancelSubscriptionRequestReasonCode = "missing_features"
+	CancelSubscriptionRequestReasonCodeNotUsing        CancelSubscriptionRequestReasonCode = "not_using"
+	CancelSubscriptionRequestReasonCodeOther           CancelSubscriptionRequestReasonCode = "other"
+	CancelSubscriptionRequestReasonCodeSwitchedService CancelSubscriptionRequestReasonCode = "switched_service"
+	CancelSubscriptionRequestReasonCodeTechnicalIssues CancelSubscriptionRequestReasonCode = "technical_issues"
+	CancelSubscriptionRequestReasonCodeTemporary       CancelSubscriptionRequestReasonCode = "temporary"
+	CancelSubscriptionRequestReasonCodeTooExpensive    CancelSubscriptionRequestReasonCode = "too_expensive"
+)
+
+// Defines values for DiscountKind.
+const (
+	FixedPrice DiscountKind = "fixed_price"
+	Percent    DiscountKind = "percent"
+)
+
+// Defines values for GroupBy.
+const (
+	GroupByCategory GroupBy = "category"
+	GroupByTag      GroupBy = "tag"
+)
+
+// Defines values for PriceAnomalyKind.
+const (
+	AboveCatalog PriceAnomalyKind = "above_catalog"
+	AboveMarket  PriceAnomalyKind = "above_market"
+	MedianJump   PriceAnomalyKind = "median_jump"
+)
+
+// Defines values for StatementLineSplitRule.
+const (
+	StatementLineSplitRuleEqual      StatementLineSplitRule = "equal"
+	StatementLineSplitRuleFixed      StatementLineSplitRule = "fixed"
+	StatementLineSplitRulePercentage StatementLineSplitRule = "percentage"
+)
+
+// Defines values for SubscriptionCancellationCode.
+const (
+	SubscriptionCancellationCodeMissingFeatures SubscriptionCancellationCode = "missing_features"
+	SubscriptionCancellationCodeNotUsing        SubscriptionCancellationCode = "not_using"
+	SubscriptionCancellationCodeOther           SubscriptionCancellationCode = "other"
+	SubscriptionCancellationCodeSwitchedService SubscriptionCancellationCode = "switched_service"
+	SubscriptionCancellationCodeTechnicalIssues SubscriptionCancellationCode = "technical_issues"
+	SubscriptionCancellationCodeTemporary       SubscriptionCancellationCode = "temporary"
+	SubscriptionCancellationCodeTooExpensive    SubscriptionCancellationCode = "too_expensive"
+)
+
+// Defines values for SubscriptionKind.
+const (
+	OneTime   SubscriptionKind = "one_time"
+	Recurring SubscriptionKind = "recurring"
+)
+
+// Defines values for SubscriptionSplitRule.
+const (
+	SubscriptionSplitRuleEqual      SubscriptionSplitRule = "equal"
+	SubscriptionSplitRuleFixed      SubscriptionSplitRule = "fixed"
+	SubscriptionSplitRulePercentage SubscriptionSplitRule = "percentage"
+)
+
+// Defines values for TransferUserRequestStrategy.
+const (
+	Fail       TransferUserRequestStrategy = "fail"
+	KeepSource TransferUserRequestStrategy = "keep_source"
+	KeepTarget TransferUserRequestStrategy = "keep_target"
+)
+
+// Defines values for GetAdminAnalyticsRetentionParamsFormat.
+const (
+	GetAdminAnalyticsRetentionParamsFormatCsv  GetAdminAnalyticsRetentionParamsFormat = "csv"
+	GetAdminAnalyticsRetentionParamsFormatJson GetAdminAnalyticsRetentionParamsFormat = "json"
+)
+
+// Defines values for GetUsersIdStatementsMonthParamsFormat.
+const (
+	GetUsersIdStatementsMonthParamsFormatCsv  GetUsersIdStatementsMonthParamsFormat = "csv"
+	GetUsersIdStatementsMonthParamsFormatHtml GetUsersIdStatementsMonthParamsFormat = "html"
+	GetUsersIdStatementsMonthParamsFormatJson GetUsersIdStatementsMonthParamsFormat = "json"
+)
+
+// ActiveSet defines model for ActiveSet.
+type ActiveSet struct {
+	Id    openapi_types.UUID `json:"id"`
+	Month string             `json:"month"`
+
+	// Subscriptions Подписки пользователя и семейные подписки, в которых он участвует
+	Subscriptions []Subscription `json:"subscriptions"`
+
+	// Total Сколько пользователь платит за месяц
+	Total int `json:"total"`
 }
 
-// TotalCostResponse defines model for TotalCostResponse.
-type TotalCostResponse struct {
-	TotalCost int `json:"totalCost"`
+// ActualCharge defines model for ActualCharge.
+type ActualCharge struct {
+	Amount int    `json:"amount"`
+	Month  string `json:"month"`
 }
 
-// GetAllParams defines parameters for GetAll.
-type GetAllParams struct {
-	// Id ID пользователя
-	Id *openapi_types.UUID `form:"id,omitempty" json:"id,omitempty"`
+// Anomaly defines model for Anomaly.
+type Anomaly struct {
+	// ConflictId Вторая подписка дубликата или пересечения
+	ConflictId   *openapi_types.UUID `json:"conflictId,omitempty"`
+	Subscription Subscription        `json:"subscription"`
+	Type         AnomalyType         `json:"type"`
 }
 
-// DeleteSubscriptionsParams defines parameters for DeleteSubscriptions.
-type DeleteSubscriptionsParams struct {
-	// Id ID пользователя
-	Id openapi_types.UUID `form:"id" json:"id"`
+// AnomalyType defines model for Anomaly.Type.
+type AnomalyType string
 
-	// Name Название подписки
-	Name string `form:"name" json:"name"`
-}
+// AuditRecord defines model for AuditRecord.
+type AuditRecord struct {
+	Actor string `json:"actor"`
 
-// GetSubscriptionsParams defines parameters for GetSubscriptions.
-type GetSubscriptionsParams struct {
-	// Id ID пользователя
-	Id *openapi_types.UUID `form:"id,omitempty" json:"id,omitempty"`
-}
+	// After Подписка после изменения
+	After   interface{}        `json:"after,omitempty"`
+	AuditId openapi_types.UUID `json:"auditId"`
 
-// GetSubscriptionsTotalCostParams defines parameters for GetSubscriptionsTotalCost.
-type GetSubscriptionsTotalCostParams struct {
-	Id        *openapi_types.UUID `form:"id,omitempty" json:"id,omitempty"`
-	Name      *string             `form:"name,omitempty" json:"name,omitempty"`
-	StartDate string              `form:"startDate" json:"startDate"`
-	EndDate   string              `form:"endDate" json:"endDate"`
+	// Before Подписка до изменения
+	Before    interface{} `json:"before,omitempty"`
+	CreatedAt time.Time   `json:"createdAt"`
+
+	// Details Параметры операции
+	Details        interface{}         `json:"details,omitempty"`
+	Operation      string              `json:"operation"`
+	RequestId      string              `json:"requestId"`
+	SubscriptionId *openapi_types.UUID `json:"subscriptionId,omitempty"`
+	UserId         *openapi_types.UUID `json:"userId,omitempty"`
 }
 
-// PostSubscriptionsJSONRequestBody defines body for PostSubscriptions for application/json ContentType.
-type PostSubscriptionsJSONRequestBody = Subscription
+// BreakdownItem defines model for BreakdownItem.
+type BreakdownItem struct {
+	// Actual Сумма взята из фактического списания, а не из месячной стоимости
+	Actual *bool `json:"actual,omitempty"`
 
-// PutSubscriptionsJSONRequestBody defines body for PutSubscriptions for application/json ContentType.
-type PutSubscriptionsJSONRequestBody = Subscription
+	// Amount Сумма строки, для скидок отрицательная
+	Amount     int                 `json:"amount"`
+	DiscountId *openapi_types.UUID `json:"discountId,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Получение списка подписок
-	// (GET /all)
-	GetAll(c *gin.Context, params GetAllParams)
-	// Удаление подписки
-	// (DELETE /subscriptions)
-	DeleteSubscriptions(c *gin.Context, params DeleteSubscriptionsParams)
-	// Получение последней подписки
-	// (GET /subscriptions)
-	GetSubscriptions(c *gin.Context, params GetSubscriptionsParams)
-	// Создание новой подписки
-	// (POST /subscriptions)
-	PostSubscriptions(c *gin.Context)
-	// Обновление подписки
-	// (PUT /subscriptions)
-	PutSubscriptions(c *gin.Context)
-	// Подсчёт суммарной стоимости подписок за период
-	// (GET /subscriptions/total_cost)
-	GetSubscriptionsTotalCost(c *gin.Context, params GetSubscriptionsTotalCostParams)
+	// Id Пользователь, на которого приходится строка
+	Id    openapi_types.UUID `json:"id"`
+	Kind  BreakdownItemKind  `json:"kind"`
+	Month string             `json:"month"`
+	Name  string             `json:"name"`
+
+	// OneTime Строка относится к разовой покупке
+	OneTime        *bool              `json:"oneTime,omitempty"`
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+// BreakdownItemKind defines model for BreakdownItem.Kind.
+type BreakdownItemKind string
+
+// BreakdownResponse defines model for BreakdownResponse.
+type BreakdownResponse struct {
+	Items []BreakdownItem `json:"items"`
+	Total int             `json:"total"`
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// Budget defines model for Budget.
+type Budget struct {
+	BudgetId *openapi_types.UUID `json:"budgetId,omitempty"`
 
-// GetAll operation middleware
-func (siw *ServerInterfaceWrapper) GetAll(c *gin.Context) {
+	// Limit Лимит расходов в месяц
+	Limit int `json:"limit"`
 
-	var err error
+	// Scope Лимит на все подписки, на категорию или на один сервис
+	Scope BudgetScope `json:"scope"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllParams
+	// Target Категория или название сервиса, для scope=total не задаётся
+	Target *string `json:"target,omitempty"`
 
-	// ------------- Optional query parameter "id" -------------
+	// Thresholds Пороги в процентах от лимита, при пересечении которых создаются события (по умолчанию 80 и 100)
+	Thresholds *[]int `json:"thresholds,omitempty"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "id", c.Request.URL.Query(), &params.Id)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+// BudgetScope Лимит на все подписки, на категорию или на один сервис
+type BudgetScope string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+// BudgetStatus defines model for BudgetStatus.
+type BudgetStatus struct {
+	Budget Budget `json:"budget"`
 
-	siw.Handler.GetAll(c, params)
-}
+	// Forecast Прогноз расходов на следующий месяц
+	Forecast int `json:"forecast"`
 
-// DeleteSubscriptions operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSubscriptions(c *gin.Context) {
+	// ForecastUtilisation Прогноз на следующий месяц в процентах от лимита
+	ForecastUtilisation float64 `json:"forecastUtilisation"`
 
-	var err error
+	// Spent Расходы за текущий месяц
+	Spent int `json:"spent"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteSubscriptionsParams
+	// Utilisation Расходы за текущий месяц в процентах от лимита
+	Utilisation float64 `json:"utilisation"`
+}
 
-	// ------------- Required query parameter "id" -------------
+// CancelSubscriptionRequest defines model for CancelSubscriptionRequest.
+type CancelSubscriptionRequest struct {
+	// DateEnd Последний оплачиваемый месяц, по умолчанию текущий
+	DateEnd *string            `json:"dateEnd,omitempty"`
+	Id      openapi_types.UUID `json:"id"`
+	Name    string             `json:"name"`
+	Reason  string             `json:"reason"`
 
-	if paramValue := c.Query("id"); paramValue != "" {
+	// ReasonCode Причина отмены, по умолчанию other
+	ReasonCode *CancelSubscriptionRequestReasonCode `json:"reasonCode,omitempty"`
+}
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument id is required, but not found"), http.StatusBadRequest)
-		return
-	}
+// CancelSubscriptionRequestReasonCode Причина отмены, по умолчанию other
+type CancelSubscriptionRequestReasonCode string
 
-	err = runtime.BindQueryParameter("form", true, true, "id", c.Request.URL.Query(), &params.Id)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+// CatalogMatch defines model for CatalogMatch.
+type CatalogMatch struct {
+	// Score Похожесть от 0 до 1
+	Score   float64        `json:"score"`
+	Service CatalogService `json:"service"`
+}
 
-	// ------------- Required query parameter "name" -------------
+// CatalogService defines model for CatalogService.
+type CatalogService struct {
+	Aliases      *[]string `json:"aliases,omitempty"`
+	Category     *string   `json:"category,omitempty"`
+	DefaultPrice *int      `json:"defaultPrice,omitempty"`
 
-	if paramValue := c.Query("name"); paramValue != "" {
+	// Name Каноническое название сервиса
+	Name      string              `json:"name"`
+	ServiceId *openapi_types.UUID `json:"serviceId,omitempty"`
+	Website   *string             `json:"website,omitempty"`
+}
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument name is required, but not found"), http.StatusBadRequest)
-		return
-	}
+// Cohort defines model for Cohort.
+type Cohort struct {
+	// Month Месяц начала подписок когорты
+	Month string `json:"month"`
+	Name  string `json:"name"`
 
-	err = runtime.BindQueryParameter("form", true, true, "name", c.Request.URL.Query(), &params.Name)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
-		return
-	}
+	// Retained Число подписок когорты, оплачиваемых через N месяцев после начала (индекс — N)
+	Retained []int `json:"retained"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// Retention Доля подписок когорты, оплачиваемых через N месяцев после начала (индекс — N)
+	Retention []float64 `json:"retention"`
 
-	siw.Handler.DeleteSubscriptions(c, params)
+	// Size Число подписок, начатых в месяце
+	Size int `json:"size"`
 }
 
-// GetSubscriptions operation middleware
-func (siw *ServerInterfaceWrapper) GetSubscriptions(c *gin.Context) {
+// CreateSubscriptionResponse defines model for CreateSubscriptionResponse.
+type CreateSubscriptionResponse struct {
+	Message string `json:"message"`
 
-	var err error
+	// Suggestions Похожие сервисы из каталога, если название не найдено
+	Suggestions *[]CatalogMatch `json:"suggestions,omitempty"`
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSubscriptionsParams
+// Discount defines model for Discount.
+type Discount struct {
+	DiscountId *openapi_types.UUID `json:"discountId,omitempty"`
 
-	// ------------- Optional query parameter "id" -------------
+	// EndDate Последний месяц скидки включительно
+	EndDate *string `json:"endDate,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "id", c.Request.URL.Query(), &params.Id)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+	// Kind percent - скидка value процентов, fixed_price - цена value в месяц
+	Kind DiscountKind `json:"kind"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// Months Длительность скидки в месяцах, если endDate не задан
+	Months *int `json:"months,omitempty"`
 
-	siw.Handler.GetSubscriptions(c, params)
+	// StartDate Первый месяц скидки, по умолчанию - начало подписки
+	StartDate *string `json:"startDate,omitempty"`
+	Value     int     `json:"value"`
 }
 
-// PostSubscriptions operation middleware
-func (siw *ServerInterfaceWrapper) PostSubscriptions(c *gin.Context) {
+// DiscountKind percent - скидка value процентов, fixed_price - цена value в месяц
+type DiscountKind string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+// GroupBy Группировка расходов по категории или по меткам
+type GroupBy string
 
-	siw.Handler.PostSubscriptions(c)
+// GroupTotal defines model for GroupTotal.
+type GroupTotal struct {
+	Group string `json:"group"`
+	Total int    `json:"total"`
 }
 
-// PutSubscriptions operation middleware
-func (siw *ServerInterfaceWrapper) PutSubscriptions(c *gin.Context) {
+// MemberDebt defines model for MemberDebt.
+type MemberDebt struct {
+	Amount int `json:"amount"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// Id Участник, который должен плательщику
+	Id             openapi_types.UUID `json:"id"`
+	Name           string             `json:"name"`
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+}
 
-	siw.Handler.PutSubscriptions(c)
+// MergeServicesRequest defines model for MergeServicesRequest.
+type MergeServicesRequest struct {
+	// Source Сервис, который поглощается
+	Source string `json:"source"`
+
+	// Target Сервис, в который переносятся подписки
+	Target string `json:"target"`
 }
 
-// GetSubscriptionsTotalCost operation middleware
-func (siw *ServerInterfaceWrapper) GetSubscriptionsTotalCost(c *gin.Context) {
+// MessageResponse defines model for MessageResponse.
+type MessageResponse struct {
+	Message string `json:"message"`
+}
 
-	var err error
+// OwedResponse defines model for OwedResponse.
+type OwedResponse struct {
+	Debts []MemberDebt `json:"debts"`
+	Total int          `json:"total"`
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSubscriptionsTotalCostParams
+// PeriodComparisonResponse defines model for PeriodComparisonResponse.
+type PeriodComparisonResponse struct {
+	Added        []SubscriptionChange `json:"added"`
+	BaseTotal    int                  `json:"baseTotal"`
+	CompareTotal int                  `json:"compareTotal"`
 
-	// ------------- Optional query parameter "id" -------------
+	// Delta compareTotal - baseTotal
+	Delta int `json:"delta"`
 
-	err = runtime.BindQueryParameter("form", true, false, "id", c.Request.URL.Query(), &params.Id)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+	// PercentChange Изменение в процентах от baseTotal, отсутствует при нулевом baseTotal
+	PercentChange *float64             `json:"percentChange,omitempty"`
+	Removed       []SubscriptionChange `json:"removed"`
+	Repriced      []SubscriptionChange `json:"repriced"`
+}
 
-	// ------------- Optional query parameter "name" -------------
+// PriceAnomaly defines model for PriceAnomaly.
+type PriceAnomaly struct {
+	// Kind above_catalog - цена подписки выше цены каталога, above_market - выше медианы других пользователей, median_jump - рост медианной цены сервиса за месяц
+	Kind  PriceAnomalyKind `json:"kind"`
+	Month string           `json:"month"`
+	Name  string           `json:"name"`
 
-	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
-		return
-	}
+	// Price Стоимость подписки или медианная цена сервиса за месяц
+	Price float64 `json:"price"`
 
-	// ------------- Required query parameter "startDate" -------------
+	// Ratio Отношение price к reference
+	Ratio float64 `json:"ratio"`
 
-	if paramValue := c.Query("startDate"); paramValue != "" {
+	// Reference Цена каталога, медиана других пользователей или медиана прошлого месяца
+	Reference      float64             `json:"reference"`
+	SubscriptionId *openapi_types.UUID `json:"subscriptionId,omitempty"`
+	UserId         *openapi_types.UUID `json:"userId,omitempty"`
+}
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument startDate is required, but not found"), http.StatusBadRequest)
-		return
-	}
+// PriceAnomalyKind above_catalog - цена подписки выше цены каталога, above_market - выше медианы других пользователей, median_jump - рост медианной цены сервиса за месяц
+type PriceAnomalyKind string
 
-	err = runtime.BindQueryParameter("form", true, true, "startDate", c.Request.URL.Query(), &params.StartDate)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
-		return
-	}
+// QualityFixRequest defines model for QualityFixRequest.
+type QualityFixRequest struct {
+	// DryRun Показать результат исправления, не сохраняя его
+	DryRun bool `json:"dryRun"`
+}
 
-	// ------------- Required query parameter "endDate" -------------
+// QualityFixResponse defines model for QualityFixResponse.
+type QualityFixResponse struct {
+	DryRun bool `json:"dryRun"`
 
-	if paramValue := c.Query("endDate"); paramValue != "" {
+	// Fixed Количество исправленных подписок по типу проблемы
+	Fixed map[string]int `json:"fixed"`
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument endDate is required, but not found"), http.StatusBadRequest)
-		return
-	}
+	// Remaining Проблемы, которые нужно разобрать вручную
+	Remaining []Anomaly `json:"remaining"`
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "endDate", c.Request.URL.Query(), &params.EndDate)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
-		return
-	}
+// RebuildLedgerRequest defines model for RebuildLedgerRequest.
+type RebuildLedgerRequest struct {
+	// DateEnd По умолчанию - последний месяц горизонта журнала (текущий месяц + 12)
+	DateEnd   *string `json:"dateEnd,omitempty"`
+	DateStart string  `json:"dateStart"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// Id Плательщик, чьи подписки пересчитываются. Если не задан - все подписки
+	Id *openapi_types.UUID `json:"id,omitempty"`
+}
 
-	siw.Handler.GetSubscriptionsTotalCost(c, params)
+// RebuildLedgerResponse defines model for RebuildLedgerResponse.
+type RebuildLedgerResponse struct {
+	Message string `json:"message"`
+
+	// Written Количество записанных строк журнала
+	Written int `json:"written"`
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
+// RenameServiceRequest defines model for RenameServiceRequest.
+type RenameServiceRequest struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ServiceChurn defines model for ServiceChurn.
+type ServiceChurn struct {
+	// Active Подписки, оплачиваемые в месяце
+	Active int `json:"active"`
+
+	// AverageLifetime Средняя продолжительность ушедших подписок в месяцах
+	AverageLifetime float64 `json:"averageLifetime"`
+
+	// Churned Подписки без автопродления, для которых месяц последний оплаченный
+	Churned int    `json:"churned"`
+	Month   string `json:"month"`
+	Name    string `json:"name"`
+
+	// Rate Доля ушедших среди оплачиваемых в месяце
+	Rate float64 `json:"rate"`
+
+	// Reasons Число ушедших подписок по причине отмены, unspecified — причина не указана
+	Reasons map[string]int `json:"reasons"`
+}
+
+// ServiceConflict defines model for ServiceConflict.
+type ServiceConflict struct {
+	SourceSubscriptionId openapi_types.UUID `json:"sourceSubscriptionId"`
+	TargetSubscriptionId openapi_types.UUID `json:"targetSubscriptionId"`
+	UserId               openapi_types.UUID `json:"userId"`
+}
+
+// ServiceConflictsResponse defines model for ServiceConflictsResponse.
+type ServiceConflictsResponse struct {
+	Conflicts []ServiceConflict `json:"conflicts"`
+	Message   string            `json:"message"`
+}
+
+// ServicePrice defines model for ServicePrice.
+type ServicePrice struct {
+	Average       float64 `json:"average"`
+	Median        float64 `json:"median"`
+	Name          string  `json:"name"`
+	Subscriptions int     `json:"subscriptions"`
+}
+
+// ServiceRevenue defines model for ServiceRevenue.
+type ServiceRevenue struct {
+	Month   string `json:"month"`
+	Name    string `json:"name"`
+	Revenue int    `json:"revenue"`
+}
+
+// ServiceRewriteResponse defines model for ServiceRewriteResponse.
+type ServiceRewriteResponse struct {
+	Message string `json:"message"`
+
+	// Updated Количество изменённых подписок
+	Updated int64 `json:"updated"`
+}
+
+// ServiceSubscribers defines model for ServiceSubscribers.
+type ServiceSubscribers struct {
+	Name string `json:"name"`
+
+	// Subscribers Количество пользователей с активной подпиской
+	Subscribers int `json:"subscribers"`
+}
+
+// SetActualChargeRequest defines model for SetActualChargeRequest.
+type SetActualChargeRequest struct {
+	// Amount Фактически списанная сумма за месяц
+	Amount int `json:"amount"`
+}
+
+// SpendSeriesPoint defines model for SpendSeriesPoint.
+type SpendSeriesPoint struct {
+	Group *string `json:"group,omitempty"`
+	Month string  `json:"month"`
+	Total int     `json:"total"`
+}
+
+// SpendSeriesResponse defines model for SpendSeriesResponse.
+type SpendSeriesResponse struct {
+	Points []SpendSeriesPoint `json:"points"`
+}
+
+// Statement defines model for Statement.
+type Statement struct {
+	Categories []GroupTotal `json:"categories"`
+
+	// Change Разница с предыдущим месяцем
+	Change int                `json:"change"`
+	Id     openapi_types.UUID `json:"id"`
+	Lines  []StatementLine    `json:"lines"`
+	Month  string             `json:"month"`
+
+	// PreviousTotal Итого за предыдущий месяц
+	PreviousTotal int `json:"previousTotal"`
+	Total         int `json:"total"`
+}
+
+// StatementLine defines model for StatementLine.
+type StatementLine struct {
+	Actual bool `json:"actual"`
+
+	// Amount Итого по подписке с учётом скидки
+	Amount   int     `json:"amount"`
+	Category *string `json:"category,omitempty"`
+
+	// Cost Стоимость подписки за месяц для всех участников
+	Cost int `json:"cost"`
+
+	// Discount Скидка на долю пользователя, отрицательная или 0
+	Discount int    `json:"discount"`
+	Name     string `json:"name"`
+	OneTime  bool   `json:"oneTime"`
+
+	// PayerId Плательщик подписки
+	PayerId openapi_types.UUID `json:"payerId"`
+
+	// Share Доля пользователя до скидок
+	Share          int                     `json:"share"`
+	SplitRule      *StatementLineSplitRule `json:"splitRule,omitempty"`
+	SubscriptionId openapi_types.UUID      `json:"subscriptionId"`
+}
+
+// StatementLineSplitRule defines model for StatementLine.SplitRule.
+type StatementLineSplitRule string
+
+// Subscription defines model for Subscription.
+type Subscription struct {
+	// AutoRenew Продлевать подписку автоматически по окончании срока
+	AutoRenew          *bool                         `json:"autoRenew,omitempty"`
+	CancellationCode   *SubscriptionCancellationCode `json:"cancellationCode,omitempty"`
+	CancellationReason *string                       `json:"cancellationReason,omitempty"`
+
+	// Category Категория подписки (video, music, cloud, ...). Если не задана, берётся категория сервиса из каталога
+	Category  *string            `json:"category,omitempty"`
+	Cost      int                `json:"cost"`
+	DateEnd   *string            `json:"dateEnd,omitempty"`
+	DateStart string             `json:"dateStart"`
+	DeletedAt *time.Time         `json:"deletedAt,omitempty"`
+	ExpiredAt *time.Time         `json:"expiredAt,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Kind Регулярная подписка или разовая покупка, которая учитывается только в месяце покупки (dateStart)
+	Kind *SubscriptionKind `json:"kind,omitempty"`
+
+	// Members Участники семейной подписки, плательщик в список не входит и платит остаток
+	Members *[]SubscriptionMember `json:"members,omitempty"`
+	Name    string                `json:"name"`
+
+	// ServiceId Запись каталога сервисов, к которой привязана подписка
+	ServiceId *openapi_types.UUID `json:"serviceId,omitempty"`
+
+	// SplitRule Правило разделения стоимости между плательщиком (id) и участниками
+	SplitRule      *SubscriptionSplitRule `json:"splitRule,omitempty"`
+	SubscriptionId *openapi_types.UUID    `json:"subscriptionId,omitempty"`
+
+	// Tags Произвольные метки подписки
+	Tags *[]string `json:"tags,omitempty"`
+
+	// TermMonths Срок подписки в месяцах, если подписка срочная
+	TermMonths *int `json:"termMonths,omitempty"`
+}
+
+// SubscriptionCancellationCode defines model for Subscription.CancellationCode.
+type SubscriptionCancellationCode string
+
+// SubscriptionKind Регулярная подписка или разовая покупка, которая учитывается только в месяце покупки (dateStart)
+type SubscriptionKind string
+
+// SubscriptionSplitRule Правило разделения стоимости между плательщиком (id) и участниками
+type SubscriptionSplitRule string
+
+// SubscriptionChange defines model for SubscriptionChange.
+type SubscriptionChange struct {
+	// BaseCost Месячная стоимость в базовом периоде
+	BaseCost  *int `json:"baseCost,omitempty"`
+	BaseTotal int  `json:"baseTotal"`
+
+	// CompareCost Месячная стоимость в сравниваемом периоде
+	CompareCost  *int `json:"compareCost,omitempty"`
+	CompareTotal int  `json:"compareTotal"`
+
+	// Id Плательщик
+	Id   openapi_types.UUID `json:"id"`
+	Name string             `json:"name"`
+}
+
+// SubscriptionFlow defines model for SubscriptionFlow.
+type SubscriptionFlow struct {
+	// Churned Подписки без автопродления, для которых месяц последний оплаченный
+	Churned int    `json:"churned"`
+	Month   string `json:"month"`
+
+	// New Подписки, начавшиеся в месяце
+	New int `json:"new"`
+}
+
+// SubscriptionMember defines model for SubscriptionMember.
+type SubscriptionMember struct {
+	Id openapi_types.UUID `json:"id"`
+
+	// Share Доля в процентах (percentage) или сумма (fixed), для equal не задаётся
+	Share *int `json:"share,omitempty"`
+}
+
+// SubscriptionVersion defines model for SubscriptionVersion.
+type SubscriptionVersion struct {
+	Subscription Subscription `json:"subscription"`
+	ValidFrom    time.Time    `json:"validFrom"`
+
+	// ValidTo Отсутствует у текущей версии
+	ValidTo *time.Time `json:"validTo,omitempty"`
+}
+
+// TotalCostResponse defines model for TotalCostResponse.
+type TotalCostResponse struct {
+	Groups    *[]GroupTotal `json:"groups,omitempty"`
+	TotalCost int           `json:"totalCost"`
+}
+
+// TransferUserRequest defines model for TransferUserRequest.
+type TransferUserRequest struct {
+	// Source Пользователь, чьи подписки переносятся
+	Source openapi_types.UUID `json:"source"`
+
+	// Strategy Что делать с пересекающимися подписками на один сервис - отказать (по умолчанию), оставить подписку source или оставить подписку target; вторая переносится в корзину
+	Strategy *TransferUserRequestStrategy `json:"strategy,omitempty"`
+
+	// Target Пользователь, которому переносятся подписки
+	Target openapi_types.UUID `json:"target"`
+}
+
+// TransferUserRequestStrategy Что делать с пересекающимися подписками на один сервис - отказать (по умолчанию), оставить подписку source или оставить подписку target; вторая переносится в корзину
+type TransferUserRequestStrategy string
+
+// TransferUserResponse defines model for TransferUserResponse.
+type TransferUserResponse struct {
+	Message string `json:"message"`
+
+	// Moved Количество перенесённых подписок
+	Moved int64 `json:"moved"`
+
+	// Trashed Пересекающиеся подписки, перенесённые в корзину
+	Trashed []openapi_types.UUID `json:"trashed"`
+}
+
+// GetAdminAnalyticsChurnParams defines parameters for GetAdminAnalyticsChurn.
+type GetAdminAnalyticsChurnParams struct {
+	StartDate string  `form:"startDate" json:"startDate"`
+	EndDate   string  `form:"endDate" json:"endDate"`
+	Name      *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// GetAdminAnalyticsFlowParams defines parameters for GetAdminAnalyticsFlow.
+type GetAdminAnalyticsFlowParams struct {
+	StartDate string `form:"startDate" json:"startDate"`
+	EndDate   string `form:"endDate" json:"endDate"`
+}
+
+// GetAdminAnalyticsPriceAnomaliesParams defines parameters for GetAdminAnalyticsPriceAnomalies.
+type GetAdminAnalyticsPriceAnomaliesParams struct {
+	StartDate string `form:"startDate" json:"startDate"`
+	EndDate   string `form:"endDate" json:"endDate"`
+}
+
+// GetAdminAnalyticsRetentionParams defines parameters for GetAdminAnalyticsRetention.
+type GetAdminAnalyticsRetentionParams struct {
+	StartDate string                                  `form:"startDate" json:"startDate"`
+	EndDate   string                                  `form:"endDate" json:"endDate"`
+	Name      *string                                 `form:"name,omitempty" json:"name,omitempty"`
+	Format    *GetAdminAnalyticsRetentionParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetAdminAnalyticsRetentionParamsFormat defines parameters for GetAdminAnalyticsRetention.
+type GetAdminAnalyticsRetentionParamsFormat string
+
+// GetAdminAnalyticsRevenueParams defines parameters for GetAdminAnalyticsRevenue.
+type GetAdminAnalyticsRevenueParams struct {
+	StartDate string `form:"startDate" json:"startDate"`
+	EndDate   string `form:"endDate" json:"endDate"`
+}
+
+// GetAdminAnalyticsTopServicesParams defines parameters for GetAdminAnalyticsTopServices.
+type GetAdminAnalyticsTopServicesParams struct {
+	// Month Месяц, по умолчанию текущий
+	Month *string `form:"month,omitempty" json:"month,omitempty"`
+	Limit *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAdminAuditParams defines parameters for GetAdminAudit.
+type GetAdminAuditParams struct {
+	Actor          *string             `form:"actor,omitempty" json:"actor,omitempty"`
+	Operation      *string             `form:"operation,omitempty" json:"operation,omitempty"`
+	UserId         *openapi_types.UUID `form:"userId,omitempty" json:"userId,omitempty"`
+	SubscriptionId *openapi_types.UUID `form:"subscriptionId,omitempty" json:"subscriptionId,omitempty"`
+	From           *time.Time          `form:"from,omitempty" json:"from,omitempty"`
+	To             *time.Time          `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit По умолчанию 100, не больше 1000
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetAllParams defines parameters for GetAll.
+type GetAllParams struct {
+	// Id ID пользователя
+	Id *openapi_types.UUID `form:"id,omitempty" json:"id,omitempty"`
+
+	// AsOf Вернуть подписки, активные в этом месяце
+	AsOf *string `form:"asOf,omitempty" json:"asOf,omitempty"`
+}
+
+// GetServicesSearchParams defines parameters for GetServicesSearch.
+type GetServicesSearchParams struct {
+	Q     string `form:"q" json:"q"`
+	Limit *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DeleteSubscriptionsParams defines parameters for DeleteSubscriptions.
+type DeleteSubscriptionsParams struct {
+	// Id ID пользователя
+	Id openapi_types.UUID `form:"id" json:"id"`
+
+	// Name Название подписки
+	Name string `form:"name" json:"name"`
+}
+
+// GetSubscriptionsParams defines parameters for GetSubscriptions.
+type GetSubscriptionsParams struct {
+	// Id ID пользователя
+	Id *openapi_types.UUID `form:"id,omitempty" json:"id,omitempty"`
+
+	// AsOf Вернуть подписки, активные в этом месяце
+	AsOf *string `form:"asOf,omitempty" json:"asOf,omitempty"`
+}
+
+// GetSubscriptionsBreakdownParams defines parameters for GetSubscriptionsBreakdown.
+type GetSubscriptionsBreakdownParams struct {
+	Id        *openapi_types.UUID `form:"id,omitempty" json:"id,omitempty"`
+	Name      *string             `form:"name,omitempty" json:"name,omitempty"`
+	StartDate string              `form:"startDate" json:"startDate"`
+	EndDate   string              `form:"endDate" json:"endDate"`
+}
+
+// GetSubscriptionsCompareParams defines parameters for GetSubscriptionsCompare.
+type GetSubscriptionsCompareParams struct {
+	Id   *openapi_types.UUID `form:"id,omitempty" json:"id,omitempty"`
+	Name *string             `form:"name,omitempty" json:"name,omitempty"`
+
+	// BaseStart Начало базового периода
+	BaseStart string `form:"baseStart" json:"baseStart"`
+
+	// BaseEnd Конец базового периода
+	BaseEnd string `form:"baseEnd" json:"baseEnd"`
+
+	// CompareStart Начало сравниваемого периода
+	CompareStart string `form:"compareStart" json:"compareStart"`
+
+	// CompareEnd Конец сравниваемого периода
+	CompareEnd string `form:"compareEnd" json:"compareEnd"`
+}
+
+// GetSubscriptionsSpendSeriesParams defines parameters for GetSubscriptionsSpendSeries.
+type GetSubscriptionsSpendSeriesParams struct {
+	Id        *openapi_types.UUID `form:"id,omitempty" json:"id,omitempty"`
+	Name      *string             `form:"name,omitempty" json:"name,omitempty"`
+	StartDate string              `form:"startDate" json:"startDate"`
+	EndDate   string              `form:"endDate" json:"endDate"`
+	GroupBy   *GroupBy            `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+}
+
+// GetSubscriptionsTotalCostParams defines parameters for GetSubscriptionsTotalCost.
+type GetSubscriptionsTotalCostParams struct {
+	Id        *openapi_types.UUID `form:"id,omitempty" json:"id,omitempty"`
+	Name      *string             `form:"name,omitempty" json:"name,omitempty"`
+	StartDate string              `form:"startDate" json:"startDate"`
+	EndDate   string              `form:"endDate" json:"endDate"`
+	GroupBy   *GroupBy            `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+}
+
+// GetSubscriptionsSubscriptionIdVersionsParams defines parameters for GetSubscriptionsSubscriptionIdVersions.
+type GetSubscriptionsSubscriptionIdVersionsParams struct {
+	// At Вернуть только версию, действовавшую в этот момент (пустой список, если подписки тогда не было)
+	At *time.Time `form:"at,omitempty" json:"at,omitempty"`
+}
+
+// GetTrashParams defines parameters for GetTrash.
+type GetTrashParams struct {
+	// Id ID пользователя
+	Id openapi_types.UUID `form:"id" json:"id"`
+}
+
+// GetUsersIdActiveParams defines parameters for GetUsersIdActive.
+type GetUsersIdActiveParams struct {
+	// Date Месяц, по умолчанию текущий
+	Date *string `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// GetUsersIdInsightsParams defines parameters for GetUsersIdInsights.
+type GetUsersIdInsightsParams struct {
+	// Month Месяц, по умолчанию текущий
+	Month *string `form:"month,omitempty" json:"month,omitempty"`
+}
+
+// GetUsersIdOwedParams defines parameters for GetUsersIdOwed.
+type GetUsersIdOwedParams struct {
+	StartDate string `form:"startDate" json:"startDate"`
+	EndDate   string `form:"endDate" json:"endDate"`
+}
+
+// GetUsersIdStatementsMonthParams defines parameters for GetUsersIdStatementsMonth.
+type GetUsersIdStatementsMonthParams struct {
+	Format *GetUsersIdStatementsMonthParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetUsersIdStatementsMonthParamsFormat defines parameters for GetUsersIdStatementsMonth.
+type GetUsersIdStatementsMonthParamsFormat string
+
+// PostAdminLedgerRebuildJSONRequestBody defines body for PostAdminLedgerRebuild for application/json ContentType.
+type PostAdminLedgerRebuildJSONRequestBody = RebuildLedgerRequest
+
+// PostAdminQualityFixJSONRequestBody defines body for PostAdminQualityFix for application/json ContentType.
+type PostAdminQualityFixJSONRequestBody = QualityFixRequest
+
+// PostAdminServicesMergeJSONRequestBody defines body for PostAdminServicesMerge for application/json ContentType.
+type PostAdminServicesMergeJSONRequestBody = MergeServicesRequest
+
+// PostAdminServicesRenameJSONRequestBody defines body for PostAdminServicesRename for application/json ContentType.
+type PostAdminServicesRenameJSONRequestBody = RenameServiceRequest
+
+// PostAdminUsersTransferJSONRequestBody defines body for PostAdminUsersTransfer for application/json ContentType.
+type PostAdminUsersTransferJSONRequestBody = TransferUserRequest
+
+// PostServicesJSONRequestBody defines body for PostServices for application/json ContentType.
+type PostServicesJSONRequestBody = CatalogService
+
+// PutServicesServiceIdJSONRequestBody defines body for PutServicesServiceId for application/json ContentType.
+type PutServicesServiceIdJSONRequestBody = CatalogService
+
+// PostSubscriptionsJSONRequestBody defines body for PostSubscriptions for application/json ContentType.
+type PostSubscriptionsJSONRequestBody = Subscription
+
+// PutSubscriptionsJSONRequestBody defines body for PutSubscriptions for application/json ContentType.
+type PutSubscriptionsJSONRequestBody = Subscription
+
+// PostSubscriptionsCancelJSONRequestBody defines body for PostSubscriptionsCancel for application/json ContentType.
+type PostSubscriptionsCancelJSONRequestBody = CancelSubscriptionRequest
+
+// PutSubscriptionsSubscriptionIdActualsMonthJSONRequestBody defines body for PutSubscriptionsSubscriptionIdActualsMonth for application/json ContentType.
+type PutSubscriptionsSubscriptionIdActualsMonthJSONRequestBody = SetActualChargeRequest
+
+// PostSubscriptionsSubscriptionIdDiscountsJSONRequestBody defines body for PostSubscriptionsSubscriptionIdDiscounts for application/json ContentType.
+type PostSubscriptionsSubscriptionIdDiscountsJSONRequestBody = Discount
+
+// PostUsersIdBudgetsJSONRequestBody defines body for PostUsersIdBudgets for application/json ContentType.
+type PostUsersIdBudgetsJSONRequestBody = Budget
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Отток подписок и причины отмены по сервисам и месяцам
+	// (GET /admin/analytics/churn)
+	GetAdminAnalyticsChurn(c *gin.Context, params GetAdminAnalyticsChurnParams)
+	// Новые и ушедшие подписки по месяцам
+	// (GET /admin/analytics/flow)
+	GetAdminAnalyticsFlow(c *gin.Context, params GetAdminAnalyticsFlowParams)
+	// Аномалии цен - скачки медианной цены и подписки с завышенной ценой
+	// (GET /admin/analytics/price_anomalies)
+	GetAdminAnalyticsPriceAnomalies(c *gin.Context, params GetAdminAnalyticsPriceAnomaliesParams)
+	// Средняя и медианная цена по сервисам
+	// (GET /admin/analytics/prices)
+	GetAdminAnalyticsPrices(c *gin.Context)
+	// Удержание когорт подписок по месяцу начала
+	// (GET /admin/analytics/retention)
+	GetAdminAnalyticsRetention(c *gin.Context, params GetAdminAnalyticsRetentionParams)
+	// Выручка по сервисам и месяцам
+	// (GET /admin/analytics/revenue)
+	GetAdminAnalyticsRevenue(c *gin.Context, params GetAdminAnalyticsRevenueParams)
+	// Сервисы с наибольшим числом активных подписчиков
+	// (GET /admin/analytics/top_services)
+	GetAdminAnalyticsTopServices(c *gin.Context, params GetAdminAnalyticsTopServicesParams)
+	// Поиск по журналу аудита
+	// (GET /admin/audit)
+	GetAdminAudit(c *gin.Context, params GetAdminAuditParams)
+	// Пересчёт журнала списаний за период
+	// (POST /admin/ledger/rebuild)
+	PostAdminLedgerRebuild(c *gin.Context)
+	// Проверка подписок на дубликаты, пересечения и некорректные данные
+	// (GET /admin/quality)
+	GetAdminQuality(c *gin.Context)
+	// Автоматическое исправление проблем с данными подписок
+	// (POST /admin/quality/fix)
+	PostAdminQualityFix(c *gin.Context)
+	// Слияние сервиса с другим во всех подписках
+	// (POST /admin/services/merge)
+	PostAdminServicesMerge(c *gin.Context)
+	// Переименование сервиса во всех подписках
+	// (POST /admin/services/rename)
+	PostAdminServicesRename(c *gin.Context)
+	// Перенос подписок одного пользователя другому при слиянии аккаунтов
+	// (POST /admin/users/transfer)
+	PostAdminUsersTransfer(c *gin.Context)
+	// Получение списка подписок
+	// (GET /all)
+	GetAll(c *gin.Context, params GetAllParams)
+	// Получение каталога сервисов
+	// (GET /services)
+	GetServices(c *gin.Context)
+	// Добавление сервиса в каталог
+	// (POST /services)
+	PostServices(c *gin.Context)
+	// Поиск сервисов в каталоге для автодополнения
+	// (GET /services/search)
+	GetServicesSearch(c *gin.Context, params GetServicesSearchParams)
+	// Удаление сервиса из каталога
+	// (DELETE /services/{serviceId})
+	DeleteServicesServiceId(c *gin.Context, serviceId openapi_types.UUID)
+	// Получение сервиса из каталога
+	// (GET /services/{serviceId})
+	GetServicesServiceId(c *gin.Context, serviceId openapi_types.UUID)
+	// Обновление сервиса в каталоге
+	// (PUT /services/{serviceId})
+	PutServicesServiceId(c *gin.Context, serviceId openapi_types.UUID)
+	// Удаление ошибочно внесённой подписки
+	// (DELETE /subscriptions)
+	DeleteSubscriptions(c *gin.Context, params DeleteSubscriptionsParams)
+	// Получение последней подписки
+	// (GET /subscriptions)
+	GetSubscriptions(c *gin.Context, params GetSubscriptionsParams)
+	// Создание новой подписки
+	// (POST /subscriptions)
+	PostSubscriptions(c *gin.Context)
+	// Обновление подписки
+	// (PUT /subscriptions)
+	PutSubscriptions(c *gin.Context)
+	// Расходы на подписки за период построчно, скидки - отдельными строками
+	// (GET /subscriptions/breakdown)
+	GetSubscriptionsBreakdown(c *gin.Context, params GetSubscriptionsBreakdownParams)
+	// Отмена подписки в конце расчётного периода
+	// (POST /subscriptions/cancel)
+	PostSubscriptionsCancel(c *gin.Context)
+	// Сравнение расходов за два периода
+	// (GET /subscriptions/compare)
+	GetSubscriptionsCompare(c *gin.Context, params GetSubscriptionsCompareParams)
+	// Помесячные расходы на подписки за период
+	// (GET /subscriptions/spend_series)
+	GetSubscriptionsSpendSeries(c *gin.Context, params GetSubscriptionsSpendSeriesParams)
+	// Подсчёт суммарной стоимости подписок за период
+	// (GET /subscriptions/total_cost)
+	GetSubscriptionsTotalCost(c *gin.Context, params GetSubscriptionsTotalCostParams)
+	// Получение фактических списаний по подписке
+	// (GET /subscriptions/{subscriptionId}/actuals)
+	GetSubscriptionsSubscriptionIdActuals(c *gin.Context, subscriptionId openapi_types.UUID)
+	// Удаление фактического списания за месяц
+	// (DELETE /subscriptions/{subscriptionId}/actuals/{month})
+	DeleteSubscriptionsSubscriptionIdActualsMonth(c *gin.Context, subscriptionId openapi_types.UUID, month string)
+	// Запись фактического списания за месяц вместо месячной стоимости
+	// (PUT /subscriptions/{subscriptionId}/actuals/{month})
+	PutSubscriptionsSubscriptionIdActualsMonth(c *gin.Context, subscriptionId openapi_types.UUID, month string)
+	// Получение скидок подписки
+	// (GET /subscriptions/{subscriptionId}/discounts)
+	GetSubscriptionsSubscriptionIdDiscounts(c *gin.Context, subscriptionId openapi_types.UUID)
+	// Добавление скидки или промо-периода
+	// (POST /subscriptions/{subscriptionId}/discounts)
+	PostSubscriptionsSubscriptionIdDiscounts(c *gin.Context, subscriptionId openapi_types.UUID)
+	// Удаление скидки
+	// (DELETE /subscriptions/{subscriptionId}/discounts/{discountId})
+	DeleteSubscriptionsSubscriptionIdDiscountsDiscountId(c *gin.Context, subscriptionId openapi_types.UUID, discountId openapi_types.UUID)
+	// История изменений подписки
+	// (GET /subscriptions/{subscriptionId}/history)
+	GetSubscriptionsSubscriptionIdHistory(c *gin.Context, subscriptionId openapi_types.UUID)
+	// Восстановление удалённой подписки из корзины
+	// (POST /subscriptions/{subscriptionId}/restore)
+	PostSubscriptionsSubscriptionIdRestore(c *gin.Context, subscriptionId openapi_types.UUID)
+	// Версии подписки, сохранённые базой данных
+	// (GET /subscriptions/{subscriptionId}/versions)
+	GetSubscriptionsSubscriptionIdVersions(c *gin.Context, subscriptionId openapi_types.UUID, params GetSubscriptionsSubscriptionIdVersionsParams)
+	// Получение удалённых подписок пользователя
+	// (GET /trash)
+	GetTrash(c *gin.Context, params GetTrashParams)
+	// Все активные подписки пользователя на дату
+	// (GET /users/{id}/active)
+	GetUsersIdActive(c *gin.Context, id openapi_types.UUID, params GetUsersIdActiveParams)
+	// Получение бюджетов пользователя
+	// (GET /users/{id}/budgets)
+	GetUsersIdBudgets(c *gin.Context, id openapi_types.UUID)
+	// Создание бюджета
+	// (POST /users/{id}/budgets)
+	PostUsersIdBudgets(c *gin.Context, id openapi_types.UUID)
+	// Расходы и прогноз относительно бюджетов пользователя
+	// (GET /users/{id}/budgets/status)
+	GetUsersIdBudgetsStatus(c *gin.Context, id openapi_types.UUID)
+	// Удаление бюджета
+	// (DELETE /users/{id}/budgets/{budgetId})
+	DeleteUsersIdBudgetsBudgetId(c *gin.Context, id openapi_types.UUID, budgetId openapi_types.UUID)
+	// Подписки пользователя с ценой намного выше каталога или других пользователей
+	// (GET /users/{id}/insights)
+	GetUsersIdInsights(c *gin.Context, id openapi_types.UUID, params GetUsersIdInsightsParams)
+	// Сколько участники семейных подписок должны плательщику за период
+	// (GET /users/{id}/owed)
+	GetUsersIdOwed(c *gin.Context, id openapi_types.UUID, params GetUsersIdOwedParams)
+	// Выписка пользователя за месяц
+	// (GET /users/{id}/statements/{month})
+	GetUsersIdStatementsMonth(c *gin.Context, id openapi_types.UUID, month string, params GetUsersIdStatementsMonthParams)
+	// Отмена последнего изменения подписок пользователя
+	// (POST /users/{id}/undo)
+	PostUsersIdUndo(c *gin.Context, id openapi_types.UUID)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetAdminAnalyticsChurn operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAnalyticsChurn(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAnalyticsChurnParams
+
+	// ------------- Required query parameter "startDate" -------------
+
+	if paramValue := c.Query("startDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument startDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "endDate" -------------
+
+	if paramValue := c.Query("endDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument endDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminAnalyticsChurn(c, params)
+}
+
+// GetAdminAnalyticsFlow operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAnalyticsFlow(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAnalyticsFlowParams
+
+	// ------------- Required query parameter "startDate" -------------
+
+	if paramValue := c.Query("startDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument startDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "endDate" -------------
+
+	if paramValue := c.Query("endDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument endDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminAnalyticsFlow(c, params)
+}
+
+// GetAdminAnalyticsPriceAnomalies operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAnalyticsPriceAnomalies(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAnalyticsPriceAnomaliesParams
+
+	// ------------- Required query parameter "startDate" -------------
+
+	if paramValue := c.Query("startDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument startDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "endDate" -------------
+
+	if paramValue := c.Query("endDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument endDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminAnalyticsPriceAnomalies(c, params)
+}
+
+// GetAdminAnalyticsPrices operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAnalyticsPrices(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminAnalyticsPrices(c)
+}
+
+// GetAdminAnalyticsRetention operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAnalyticsRetention(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAnalyticsRetentionParams
+
+	// ------------- Required query parameter "startDate" -------------
+
+	if paramValue := c.Query("startDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument startDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "endDate" -------------
+
+	if paramValue := c.Query("endDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument endDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminAnalyticsRetention(c, params)
+}
+
+// GetAdminAnalyticsRevenue operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAnalyticsRevenue(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAnalyticsRevenueParams
+
+	// ------------- Required query parameter "startDate" -------------
+
+	if paramValue := c.Query("startDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument startDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "endDate" -------------
+
+	if paramValue := c.Query("endDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument endDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminAnalyticsRevenue(c, params)
+}
+
+// GetAdminAnalyticsTopServices operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAnalyticsTopServices(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAnalyticsTopServicesParams
+
+	// ------------- Optional query parameter "month" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "month", c.Request.URL.Query(), &params.Month)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter month: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminAnalyticsTopServices(c, params)
+}
+
+// GetAdminAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAudit(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAuditParams
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", c.Request.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "operation" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation", c.Request.URL.Query(), &params.Operation)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter operation: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "userId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userId", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "subscriptionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subscriptionId", c.Request.URL.Query(), &params.SubscriptionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subscriptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminAudit(c, params)
+}
+
+// PostAdminLedgerRebuild operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminLedgerRebuild(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminLedgerRebuild(c)
+}
+
+// GetAdminQuality operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminQuality(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminQuality(c)
+}
+
+// PostAdminQualityFix operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminQualityFix(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminQualityFix(c)
+}
+
+// PostAdminServicesMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminServicesMerge(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminServicesMerge(c)
+}
+
+// PostAdminServicesRename operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminServicesRename(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminServicesRename(c)
+}
+
+// PostAdminUsersTransfer operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersTransfer(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminUsersTransfer(c)
+}
+
+// GetAll operation middleware
+func (siw *ServerInterfaceWrapper) GetAll(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", c.Request.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "asOf" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asOf", c.Request.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter asOf: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAll(c, params)
+}
+
+// GetServices operation middleware
+func (siw *ServerInterfaceWrapper) GetServices(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetServices(c)
+}
+
+// PostServices operation middleware
+func (siw *ServerInterfaceWrapper) PostServices(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostServices(c)
+}
+
+// GetServicesSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetServicesSearch(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServicesSearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := c.Query("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetServicesSearch(c, params)
+}
+
+// DeleteServicesServiceId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServicesServiceId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "serviceId" -------------
+	var serviceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceId", c.Param("serviceId"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter serviceId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteServicesServiceId(c, serviceId)
+}
+
+// GetServicesServiceId operation middleware
+func (siw *ServerInterfaceWrapper) GetServicesServiceId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "serviceId" -------------
+	var serviceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceId", c.Param("serviceId"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter serviceId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetServicesServiceId(c, serviceId)
+}
+
+// PutServicesServiceId operation middleware
+func (siw *ServerInterfaceWrapper) PutServicesServiceId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "serviceId" -------------
+	var serviceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceId", c.Param("serviceId"), &serviceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter serviceId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutServicesServiceId(c, serviceId)
+}
+
+// DeleteSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSubscriptions(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSubscriptionsParams
+
+	// ------------- Required query parameter "id" -------------
+
+	if paramValue := c.Query("id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "id", c.Request.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "name" -------------
+
+	if paramValue := c.Query("name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSubscriptions(c, params)
+}
+
+// GetSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptions(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", c.Request.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "asOf" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asOf", c.Request.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter asOf: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptions(c, params)
+}
+
+// PostSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSubscriptions(c)
+}
+
+// PutSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) PutSubscriptions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutSubscriptions(c)
+}
+
+// GetSubscriptionsBreakdown operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsBreakdown(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsBreakdownParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", c.Request.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "startDate" -------------
+
+	if paramValue := c.Query("startDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument startDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "endDate" -------------
+
+	if paramValue := c.Query("endDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument endDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptionsBreakdown(c, params)
+}
+
+// PostSubscriptionsCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptionsCancel(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSubscriptionsCancel(c)
+}
+
+// GetSubscriptionsCompare operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsCompare(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsCompareParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", c.Request.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "baseStart" -------------
+
+	if paramValue := c.Query("baseStart"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument baseStart is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "baseStart", c.Request.URL.Query(), &params.BaseStart)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter baseStart: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "baseEnd" -------------
+
+	if paramValue := c.Query("baseEnd"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument baseEnd is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "baseEnd", c.Request.URL.Query(), &params.BaseEnd)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter baseEnd: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "compareStart" -------------
+
+	if paramValue := c.Query("compareStart"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument compareStart is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "compareStart", c.Request.URL.Query(), &params.CompareStart)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter compareStart: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "compareEnd" -------------
+
+	if paramValue := c.Query("compareEnd"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument compareEnd is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "compareEnd", c.Request.URL.Query(), &params.CompareEnd)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter compareEnd: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptionsCompare(c, params)
+}
+
+// GetSubscriptionsSpendSeries operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsSpendSeries(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsSpendSeriesParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", c.Request.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "startDate" -------------
+
+	if paramValue := c.Query("startDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument startDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "endDate" -------------
+
+	if paramValue := c.Query("endDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument endDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "groupBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupBy", c.Request.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptionsSpendSeries(c, params)
+}
+
+// GetSubscriptionsTotalCost operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsTotalCost(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsTotalCostParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", c.Request.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "startDate" -------------
+
+	if paramValue := c.Query("startDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument startDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "endDate" -------------
+
+	if paramValue := c.Query("endDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument endDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "groupBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupBy", c.Request.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptionsTotalCost(c, params)
+}
+
+// GetSubscriptionsSubscriptionIdActuals operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsSubscriptionIdActuals(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", c.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subscriptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptionsSubscriptionIdActuals(c, subscriptionId)
+}
+
+// DeleteSubscriptionsSubscriptionIdActualsMonth operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSubscriptionsSubscriptionIdActualsMonth(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", c.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subscriptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "month" -------------
+	var month string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "month", c.Param("month"), &month, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter month: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSubscriptionsSubscriptionIdActualsMonth(c, subscriptionId, month)
+}
+
+// PutSubscriptionsSubscriptionIdActualsMonth operation middleware
+func (siw *ServerInterfaceWrapper) PutSubscriptionsSubscriptionIdActualsMonth(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", c.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subscriptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "month" -------------
+	var month string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "month", c.Param("month"), &month, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter month: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutSubscriptionsSubscriptionIdActualsMonth(c, subscriptionId, month)
+}
+
+// GetSubscriptionsSubscriptionIdDiscounts operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsSubscriptionIdDiscounts(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", c.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subscriptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptionsSubscriptionIdDiscounts(c, subscriptionId)
+}
+
+// PostSubscriptionsSubscriptionIdDiscounts operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptionsSubscriptionIdDiscounts(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", c.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subscriptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSubscriptionsSubscriptionIdDiscounts(c, subscriptionId)
+}
+
+// DeleteSubscriptionsSubscriptionIdDiscountsDiscountId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSubscriptionsSubscriptionIdDiscountsDiscountId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", c.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subscriptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "discountId" -------------
+	var discountId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "discountId", c.Param("discountId"), &discountId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter discountId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSubscriptionsSubscriptionIdDiscountsDiscountId(c, subscriptionId, discountId)
+}
+
+// GetSubscriptionsSubscriptionIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsSubscriptionIdHistory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", c.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subscriptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptionsSubscriptionIdHistory(c, subscriptionId)
+}
+
+// PostSubscriptionsSubscriptionIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptionsSubscriptionIdRestore(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", c.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subscriptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSubscriptionsSubscriptionIdRestore(c, subscriptionId)
+}
+
+// GetSubscriptionsSubscriptionIdVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsSubscriptionIdVersions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", c.Param("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subscriptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsSubscriptionIdVersionsParams
+
+	// ------------- Optional query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at", c.Request.URL.Query(), &params.At)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter at: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSubscriptionsSubscriptionIdVersions(c, subscriptionId, params)
+}
+
+// GetTrash operation middleware
+func (siw *ServerInterfaceWrapper) GetTrash(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTrashParams
+
+	// ------------- Required query parameter "id" -------------
+
+	if paramValue := c.Query("id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "id", c.Request.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTrash(c, params)
+}
+
+// GetUsersIdActive operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdActive(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdActiveParams
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", c.Request.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersIdActive(c, id, params)
+}
+
+// GetUsersIdBudgets operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdBudgets(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersIdBudgets(c, id)
+}
+
+// PostUsersIdBudgets operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersIdBudgets(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersIdBudgets(c, id)
+}
+
+// GetUsersIdBudgetsStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdBudgetsStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersIdBudgetsStatus(c, id)
+}
+
+// DeleteUsersIdBudgetsBudgetId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersIdBudgetsBudgetId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "budgetId" -------------
+	var budgetId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "budgetId", c.Param("budgetId"), &budgetId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter budgetId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUsersIdBudgetsBudgetId(c, id, budgetId)
+}
+
+// GetUsersIdInsights operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdInsights(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdInsightsParams
+
+	// ------------- Optional query parameter "month" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "month", c.Request.URL.Query(), &params.Month)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter month: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersIdInsights(c, id, params)
+}
+
+// GetUsersIdOwed operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdOwed(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdOwedParams
+
+	// ------------- Required query parameter "startDate" -------------
+
+	if paramValue := c.Query("startDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument startDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "endDate" -------------
+
+	if paramValue := c.Query("endDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument endDate is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersIdOwed(c, id, params)
+}
+
+// GetUsersIdStatementsMonth operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdStatementsMonth(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "month" -------------
+	var month string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "month", c.Param("month"), &month, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter month: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdStatementsMonthParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersIdStatementsMonth(c, id, month, params)
+}
+
+// PostUsersIdUndo operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersIdUndo(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersIdUndo(c, id)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
 }
 
 // RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
@@ -309,230 +2720,2870 @@ func RegisterHandlers(router gin.IRouter, si ServerInterface) {
 	RegisterHandlersWithOptions(router, si, GinServerOptions{})
 }
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/admin/analytics/churn", wrapper.GetAdminAnalyticsChurn)
+	router.GET(options.BaseURL+"/admin/analytics/flow", wrapper.GetAdminAnalyticsFlow)
+	router.GET(options.BaseURL+"/admin/analytics/price_anomalies", wrapper.GetAdminAnalyticsPriceAnomalies)
+	router.GET(options.BaseURL+"/admin/analytics/prices", wrapper.GetAdminAnalyticsPrices)
+	router.GET(options.BaseURL+"/admin/analytics/retention", wrapper.GetAdminAnalyticsRetention)
+	router.GET(options.BaseURL+"/admin/analytics/revenue", wrapper.GetAdminAnalyticsRevenue)
+	router.GET(options.BaseURL+"/admin/analytics/top_services", wrapper.GetAdminAnalyticsTopServices)
+	router.GET(options.BaseURL+"/admin/audit", wrapper.GetAdminAudit)
+	router.POST(options.BaseURL+"/admin/ledger/rebuild", wrapper.PostAdminLedgerRebuild)
+	router.GET(options.BaseURL+"/admin/quality", wrapper.GetAdminQuality)
+	router.POST(options.BaseURL+"/admin/quality/fix", wrapper.PostAdminQualityFix)
+	router.POST(options.BaseURL+"/admin/services/merge", wrapper.PostAdminServicesMerge)
+	router.POST(options.BaseURL+"/admin/services/rename", wrapper.PostAdminServicesRename)
+	router.POST(options.BaseURL+"/admin/users/transfer", wrapper.PostAdminUsersTransfer)
+	router.GET(options.BaseURL+"/all", wrapper.GetAll)
+	router.GET(options.BaseURL+"/services", wrapper.GetServices)
+	router.POST(options.BaseURL+"/services", wrapper.PostServices)
+	router.GET(options.BaseURL+"/services/search", wrapper.GetServicesSearch)
+	router.DELETE(options.BaseURL+"/services/:serviceId", wrapper.DeleteServicesServiceId)
+	router.GET(options.BaseURL+"/services/:serviceId", wrapper.GetServicesServiceId)
+	router.PUT(options.BaseURL+"/services/:serviceId", wrapper.PutServicesServiceId)
+	router.DELETE(options.BaseURL+"/subscriptions", wrapper.DeleteSubscriptions)
+	router.GET(options.BaseURL+"/subscriptions", wrapper.GetSubscriptions)
+	router.POST(options.BaseURL+"/subscriptions", wrapper.PostSubscriptions)
+	router.PUT(options.BaseURL+"/subscriptions", wrapper.PutSubscriptions)
+	router.GET(options.BaseURL+"/subscriptions/breakdown", wrapper.GetSubscriptionsBreakdown)
+	router.POST(options.BaseURL+"/subscriptions/cancel", wrapper.PostSubscriptionsCancel)
+	router.GET(options.BaseURL+"/subscriptions/compare", wrapper.GetSubscriptionsCompare)
+	router.GET(options.BaseURL+"/subscriptions/spend_series", wrapper.GetSubscriptionsSpendSeries)
+	router.GET(options.BaseURL+"/subscriptions/total_cost", wrapper.GetSubscriptionsTotalCost)
+	router.GET(options.BaseURL+"/subscriptions/:subscriptionId/actuals", wrapper.GetSubscriptionsSubscriptionIdActuals)
+	router.DELETE(options.BaseURL+"/subscriptions/:subscriptionId/actuals/:month", wrapper.DeleteSubscriptionsSubscriptionIdActualsMonth)
+	router.PUT(options.BaseURL+"/subscriptions/:subscriptionId/actuals/:month", wrapper.PutSubscriptionsSubscriptionIdActualsMonth)
+	router.GET(options.BaseURL+"/subscriptions/:subscriptionId/discounts", wrapper.GetSubscriptionsSubscriptionIdDiscounts)
+	router.POST(options.BaseURL+"/subscriptions/:subscriptionId/discounts", wrapper.PostSubscriptionsSubscriptionIdDiscounts)
+	router.DELETE(options.BaseURL+"/subscriptions/:subscriptionId/discounts/:discountId", wrapper.DeleteSubscriptionsSubscriptionIdDiscountsDiscountId)
+	router.GET(options.BaseURL+"/subscriptions/:subscriptionId/history", wrapper.GetSubscriptionsSubscriptionIdHistory)
+	router.POST(options.BaseURL+"/subscriptions/:subscriptionId/restore", wrapper.PostSubscriptionsSubscriptionIdRestore)
+	router.GET(options.BaseURL+"/subscriptions/:subscriptionId/versions", wrapper.GetSubscriptionsSubscriptionIdVersions)
+	router.GET(options.BaseURL+"/trash", wrapper.GetTrash)
+	router.GET(options.BaseURL+"/users/:id/active", wrapper.GetUsersIdActive)
+	router.GET(options.BaseURL+"/users/:id/budgets", wrapper.GetUsersIdBudgets)
+	router.POST(options.BaseURL+"/users/:id/budgets", wrapper.PostUsersIdBudgets)
+	router.GET(options.BaseURL+"/users/:id/budgets/status", wrapper.GetUsersIdBudgetsStatus)
+	router.DELETE(options.BaseURL+"/users/:id/budgets/:budgetId", wrapper.DeleteUsersIdBudgetsBudgetId)
+	router.GET(options.BaseURL+"/users/:id/insights", wrapper.GetUsersIdInsights)
+	router.GET(options.BaseURL+"/users/:id/owed", wrapper.GetUsersIdOwed)
+	router.GET(options.BaseURL+"/users/:id/statements/:month", wrapper.GetUsersIdStatementsMonth)
+	router.POST(options.BaseURL+"/users/:id/undo", wrapper.PostUsersIdUndo)
+}
+
+type GetAdminAnalyticsChurnRequestObject struct {
+	Params GetAdminAnalyticsChurnParams
+}
+
+type GetAdminAnalyticsChurnResponseObject interface {
+	VisitGetAdminAnalyticsChurnResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAnalyticsChurn200JSONResponse []ServiceChurn
+
+func (response GetAdminAnalyticsChurn200JSONResponse) VisitGetAdminAnalyticsChurnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsChurn400JSONResponse MessageResponse
+
+func (response GetAdminAnalyticsChurn400JSONResponse) VisitGetAdminAnalyticsChurnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsFlowRequestObject struct {
+	Params GetAdminAnalyticsFlowParams
+}
+
+type GetAdminAnalyticsFlowResponseObject interface {
+	VisitGetAdminAnalyticsFlowResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAnalyticsFlow200JSONResponse []SubscriptionFlow
+
+func (response GetAdminAnalyticsFlow200JSONResponse) VisitGetAdminAnalyticsFlowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsFlow400JSONResponse MessageResponse
+
+func (response GetAdminAnalyticsFlow400JSONResponse) VisitGetAdminAnalyticsFlowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsPriceAnomaliesRequestObject struct {
+	Params GetAdminAnalyticsPriceAnomaliesParams
+}
+
+type GetAdminAnalyticsPriceAnomaliesResponseObject interface {
+	VisitGetAdminAnalyticsPriceAnomaliesResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAnalyticsPriceAnomalies200JSONResponse []PriceAnomaly
+
+func (response GetAdminAnalyticsPriceAnomalies200JSONResponse) VisitGetAdminAnalyticsPriceAnomaliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsPriceAnomalies400JSONResponse MessageResponse
+
+func (response GetAdminAnalyticsPriceAnomalies400JSONResponse) VisitGetAdminAnalyticsPriceAnomaliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsPricesRequestObject struct {
+}
+
+type GetAdminAnalyticsPricesResponseObject interface {
+	VisitGetAdminAnalyticsPricesResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAnalyticsPrices200JSONResponse []ServicePrice
+
+func (response GetAdminAnalyticsPrices200JSONResponse) VisitGetAdminAnalyticsPricesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsPrices400JSONResponse MessageResponse
+
+func (response GetAdminAnalyticsPrices400JSONResponse) VisitGetAdminAnalyticsPricesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsRetentionRequestObject struct {
+	Params GetAdminAnalyticsRetentionParams
+}
+
+type GetAdminAnalyticsRetentionResponseObject interface {
+	VisitGetAdminAnalyticsRetentionResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAnalyticsRetention200JSONResponse []Cohort
+
+func (response GetAdminAnalyticsRetention200JSONResponse) VisitGetAdminAnalyticsRetentionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsRetention200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetAdminAnalyticsRetention200TextcsvResponse) VisitGetAdminAnalyticsRetentionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAdminAnalyticsRetention400JSONResponse MessageResponse
+
+func (response GetAdminAnalyticsRetention400JSONResponse) VisitGetAdminAnalyticsRetentionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsRevenueRequestObject struct {
+	Params GetAdminAnalyticsRevenueParams
+}
+
+type GetAdminAnalyticsRevenueResponseObject interface {
+	VisitGetAdminAnalyticsRevenueResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAnalyticsRevenue200JSONResponse []ServiceRevenue
+
+func (response GetAdminAnalyticsRevenue200JSONResponse) VisitGetAdminAnalyticsRevenueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsRevenue400JSONResponse MessageResponse
+
+func (response GetAdminAnalyticsRevenue400JSONResponse) VisitGetAdminAnalyticsRevenueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsTopServicesRequestObject struct {
+	Params GetAdminAnalyticsTopServicesParams
+}
+
+type GetAdminAnalyticsTopServicesResponseObject interface {
+	VisitGetAdminAnalyticsTopServicesResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAnalyticsTopServices200JSONResponse []ServiceSubscribers
+
+func (response GetAdminAnalyticsTopServices200JSONResponse) VisitGetAdminAnalyticsTopServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAnalyticsTopServices400JSONResponse MessageResponse
+
+func (response GetAdminAnalyticsTopServices400JSONResponse) VisitGetAdminAnalyticsTopServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAuditRequestObject struct {
+	Params GetAdminAuditParams
+}
+
+type GetAdminAuditResponseObject interface {
+	VisitGetAdminAuditResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAudit200JSONResponse []AuditRecord
+
+func (response GetAdminAudit200JSONResponse) VisitGetAdminAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAudit400JSONResponse MessageResponse
+
+func (response GetAdminAudit400JSONResponse) VisitGetAdminAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminLedgerRebuildRequestObject struct {
+	Body *PostAdminLedgerRebuildJSONRequestBody
+}
+
+type PostAdminLedgerRebuildResponseObject interface {
+	VisitPostAdminLedgerRebuildResponse(w http.ResponseWriter) error
+}
+
+type PostAdminLedgerRebuild200JSONResponse RebuildLedgerResponse
+
+func (response PostAdminLedgerRebuild200JSONResponse) VisitPostAdminLedgerRebuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminLedgerRebuild400JSONResponse MessageResponse
+
+func (response PostAdminLedgerRebuild400JSONResponse) VisitPostAdminLedgerRebuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminQualityRequestObject struct {
+}
+
+type GetAdminQualityResponseObject interface {
+	VisitGetAdminQualityResponse(w http.ResponseWriter) error
+}
+
+type GetAdminQuality200JSONResponse []Anomaly
+
+func (response GetAdminQuality200JSONResponse) VisitGetAdminQualityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminQuality400JSONResponse MessageResponse
+
+func (response GetAdminQuality400JSONResponse) VisitGetAdminQualityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminQualityFixRequestObject struct {
+	Body *PostAdminQualityFixJSONRequestBody
+}
+
+type PostAdminQualityFixResponseObject interface {
+	VisitPostAdminQualityFixResponse(w http.ResponseWriter) error
+}
+
+type PostAdminQualityFix200JSONResponse QualityFixResponse
+
+func (response PostAdminQualityFix200JSONResponse) VisitPostAdminQualityFixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminQualityFix400JSONResponse MessageResponse
+
+func (response PostAdminQualityFix400JSONResponse) VisitPostAdminQualityFixResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminServicesMergeRequestObject struct {
+	Body *PostAdminServicesMergeJSONRequestBody
+}
+
+type PostAdminServicesMergeResponseObject interface {
+	VisitPostAdminServicesMergeResponse(w http.ResponseWriter) error
+}
+
+type PostAdminServicesMerge200JSONResponse ServiceRewriteResponse
+
+func (response PostAdminServicesMerge200JSONResponse) VisitPostAdminServicesMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminServicesMerge400JSONResponse MessageResponse
+
+func (response PostAdminServicesMerge400JSONResponse) VisitPostAdminServicesMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminServicesMerge409JSONResponse ServiceConflictsResponse
+
+func (response PostAdminServicesMerge409JSONResponse) VisitPostAdminServicesMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminServicesRenameRequestObject struct {
+	Body *PostAdminServicesRenameJSONRequestBody
+}
+
+type PostAdminServicesRenameResponseObject interface {
+	VisitPostAdminServicesRenameResponse(w http.ResponseWriter) error
+}
+
+type PostAdminServicesRename200JSONResponse ServiceRewriteResponse
+
+func (response PostAdminServicesRename200JSONResponse) VisitPostAdminServicesRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminServicesRename400JSONResponse MessageResponse
+
+func (response PostAdminServicesRename400JSONResponse) VisitPostAdminServicesRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminServicesRename409JSONResponse ServiceConflictsResponse
+
+func (response PostAdminServicesRename409JSONResponse) VisitPostAdminServicesRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersTransferRequestObject struct {
+	Body *PostAdminUsersTransferJSONRequestBody
+}
+
+type PostAdminUsersTransferResponseObject interface {
+	VisitPostAdminUsersTransferResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersTransfer200JSONResponse TransferUserResponse
+
+func (response PostAdminUsersTransfer200JSONResponse) VisitPostAdminUsersTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersTransfer400JSONResponse MessageResponse
+
+func (response PostAdminUsersTransfer400JSONResponse) VisitPostAdminUsersTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersTransfer409JSONResponse ServiceConflictsResponse
+
+func (response PostAdminUsersTransfer409JSONResponse) VisitPostAdminUsersTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllRequestObject struct {
+	Params GetAllParams
+}
+
+type GetAllResponseObject interface {
+	VisitGetAllResponse(w http.ResponseWriter) error
+}
+
+type GetAll200JSONResponse []Subscription
+
+func (response GetAll200JSONResponse) VisitGetAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAll400JSONResponse MessageResponse
+
+func (response GetAll400JSONResponse) VisitGetAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServicesRequestObject struct {
+}
+
+type GetServicesResponseObject interface {
+	VisitGetServicesResponse(w http.ResponseWriter) error
+}
+
+type GetServices200JSONResponse []CatalogService
+
+func (response GetServices200JSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServices400JSONResponse MessageResponse
+
+func (response GetServices400JSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServicesRequestObject struct {
+	Body *PostServicesJSONRequestBody
+}
+
+type PostServicesResponseObject interface {
+	VisitPostServicesResponse(w http.ResponseWriter) error
+}
+
+type PostServices201JSONResponse CatalogService
+
+func (response PostServices201JSONResponse) VisitPostServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServices400JSONResponse MessageResponse
+
+func (response PostServices400JSONResponse) VisitPostServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServicesSearchRequestObject struct {
+	Params GetServicesSearchParams
+}
+
+type GetServicesSearchResponseObject interface {
+	VisitGetServicesSearchResponse(w http.ResponseWriter) error
+}
+
+type GetServicesSearch200JSONResponse []CatalogMatch
+
+func (response GetServicesSearch200JSONResponse) VisitGetServicesSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServicesSearch400JSONResponse MessageResponse
+
+func (response GetServicesSearch400JSONResponse) VisitGetServicesSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServicesServiceIdRequestObject struct {
+	ServiceId openapi_types.UUID `json:"serviceId"`
+}
+
+type DeleteServicesServiceIdResponseObject interface {
+	VisitDeleteServicesServiceIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteServicesServiceId200JSONResponse MessageResponse
+
+func (response DeleteServicesServiceId200JSONResponse) VisitDeleteServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServicesServiceId400JSONResponse MessageResponse
+
+func (response DeleteServicesServiceId400JSONResponse) VisitDeleteServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServicesServiceIdRequestObject struct {
+	ServiceId openapi_types.UUID `json:"serviceId"`
+}
+
+type GetServicesServiceIdResponseObject interface {
+	VisitGetServicesServiceIdResponse(w http.ResponseWriter) error
+}
+
+type GetServicesServiceId200JSONResponse CatalogService
+
+func (response GetServicesServiceId200JSONResponse) VisitGetServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServicesServiceId400JSONResponse MessageResponse
+
+func (response GetServicesServiceId400JSONResponse) VisitGetServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutServicesServiceIdRequestObject struct {
+	ServiceId openapi_types.UUID `json:"serviceId"`
+	Body      *PutServicesServiceIdJSONRequestBody
+}
+
+type PutServicesServiceIdResponseObject interface {
+	VisitPutServicesServiceIdResponse(w http.ResponseWriter) error
+}
+
+type PutServicesServiceId200JSONResponse MessageResponse
+
+func (response PutServicesServiceId200JSONResponse) VisitPutServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutServicesServiceId400JSONResponse MessageResponse
+
+func (response PutServicesServiceId400JSONResponse) VisitPutServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscriptionsRequestObject struct {
+	Params DeleteSubscriptionsParams
+}
+
+type DeleteSubscriptionsResponseObject interface {
+	VisitDeleteSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type DeleteSubscriptions200JSONResponse MessageResponse
+
+func (response DeleteSubscriptions200JSONResponse) VisitDeleteSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscriptions400JSONResponse MessageResponse
+
+func (response DeleteSubscriptions400JSONResponse) VisitDeleteSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsRequestObject struct {
+	Params GetSubscriptionsParams
+}
+
+type GetSubscriptionsResponseObject interface {
+	VisitGetSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptions200JSONResponse Subscription
+
+func (response GetSubscriptions200JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptions400JSONResponse MessageResponse
+
+func (response GetSubscriptions400JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsRequestObject struct {
+	Body *PostSubscriptionsJSONRequestBody
+}
+
+type PostSubscriptionsResponseObject interface {
+	VisitPostSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type PostSubscriptions201JSONResponse CreateSubscriptionResponse
+
+func (response PostSubscriptions201JSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptions400JSONResponse MessageResponse
+
+func (response PostSubscriptions400JSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSubscriptionsRequestObject struct {
+	Body *PutSubscriptionsJSONRequestBody
+}
+
+type PutSubscriptionsResponseObject interface {
+	VisitPutSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type PutSubscriptions200JSONResponse MessageResponse
+
+func (response PutSubscriptions200JSONResponse) VisitPutSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSubscriptions400JSONResponse MessageResponse
+
+func (response PutSubscriptions400JSONResponse) VisitPutSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsBreakdownRequestObject struct {
+	Params GetSubscriptionsBreakdownParams
+}
+
+type GetSubscriptionsBreakdownResponseObject interface {
+	VisitGetSubscriptionsBreakdownResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsBreakdown200JSONResponse BreakdownResponse
+
+func (response GetSubscriptionsBreakdown200JSONResponse) VisitGetSubscriptionsBreakdownResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsBreakdown400JSONResponse MessageResponse
+
+func (response GetSubscriptionsBreakdown400JSONResponse) VisitGetSubscriptionsBreakdownResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsCancelRequestObject struct {
+	Body *PostSubscriptionsCancelJSONRequestBody
+}
+
+type PostSubscriptionsCancelResponseObject interface {
+	VisitPostSubscriptionsCancelResponse(w http.ResponseWriter) error
+}
+
+type PostSubscriptionsCancel200JSONResponse MessageResponse
+
+func (response PostSubscriptionsCancel200JSONResponse) VisitPostSubscriptionsCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsCancel400JSONResponse MessageResponse
+
+func (response PostSubscriptionsCancel400JSONResponse) VisitPostSubscriptionsCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsCompareRequestObject struct {
+	Params GetSubscriptionsCompareParams
+}
+
+type GetSubscriptionsCompareResponseObject interface {
+	VisitGetSubscriptionsCompareResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsCompare200JSONResponse PeriodComparisonResponse
+
+func (response GetSubscriptionsCompare200JSONResponse) VisitGetSubscriptionsCompareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsCompare400JSONResponse MessageResponse
+
+func (response GetSubscriptionsCompare400JSONResponse) VisitGetSubscriptionsCompareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSpendSeriesRequestObject struct {
+	Params GetSubscriptionsSpendSeriesParams
+}
+
+type GetSubscriptionsSpendSeriesResponseObject interface {
+	VisitGetSubscriptionsSpendSeriesResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsSpendSeries200JSONResponse SpendSeriesResponse
+
+func (response GetSubscriptionsSpendSeries200JSONResponse) VisitGetSubscriptionsSpendSeriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSpendSeries400JSONResponse MessageResponse
+
+func (response GetSubscriptionsSpendSeries400JSONResponse) VisitGetSubscriptionsSpendSeriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsTotalCostRequestObject struct {
+	Params GetSubscriptionsTotalCostParams
+}
+
+type GetSubscriptionsTotalCostResponseObject interface {
+	VisitGetSubscriptionsTotalCostResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsTotalCost200JSONResponse TotalCostResponse
+
+func (response GetSubscriptionsTotalCost200JSONResponse) VisitGetSubscriptionsTotalCostResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsTotalCost400JSONResponse MessageResponse
+
+func (response GetSubscriptionsTotalCost400JSONResponse) VisitGetSubscriptionsTotalCostResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSubscriptionIdActualsRequestObject struct {
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+}
+
+type GetSubscriptionsSubscriptionIdActualsResponseObject interface {
+	VisitGetSubscriptionsSubscriptionIdActualsResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsSubscriptionIdActuals200JSONResponse []ActualCharge
+
+func (response GetSubscriptionsSubscriptionIdActuals200JSONResponse) VisitGetSubscriptionsSubscriptionIdActualsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSubscriptionIdActuals400JSONResponse MessageResponse
+
+func (response GetSubscriptionsSubscriptionIdActuals400JSONResponse) VisitGetSubscriptionsSubscriptionIdActualsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscriptionsSubscriptionIdActualsMonthRequestObject struct {
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+	Month          string             `json:"month"`
+}
+
+type DeleteSubscriptionsSubscriptionIdActualsMonthResponseObject interface {
+	VisitDeleteSubscriptionsSubscriptionIdActualsMonthResponse(w http.ResponseWriter) error
+}
+
+type DeleteSubscriptionsSubscriptionIdActualsMonth200JSONResponse MessageResponse
+
+func (response DeleteSubscriptionsSubscriptionIdActualsMonth200JSONResponse) VisitDeleteSubscriptionsSubscriptionIdActualsMonthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscriptionsSubscriptionIdActualsMonth400JSONResponse MessageResponse
+
+func (response DeleteSubscriptionsSubscriptionIdActualsMonth400JSONResponse) VisitDeleteSubscriptionsSubscriptionIdActualsMonthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSubscriptionsSubscriptionIdActualsMonthRequestObject struct {
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+	Month          string             `json:"month"`
+	Body           *PutSubscriptionsSubscriptionIdActualsMonthJSONRequestBody
+}
+
+type PutSubscriptionsSubscriptionIdActualsMonthResponseObject interface {
+	VisitPutSubscriptionsSubscriptionIdActualsMonthResponse(w http.ResponseWriter) error
+}
+
+type PutSubscriptionsSubscriptionIdActualsMonth200JSONResponse MessageResponse
+
+func (response PutSubscriptionsSubscriptionIdActualsMonth200JSONResponse) VisitPutSubscriptionsSubscriptionIdActualsMonthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSubscriptionsSubscriptionIdActualsMonth400JSONResponse MessageResponse
+
+func (response PutSubscriptionsSubscriptionIdActualsMonth400JSONResponse) VisitPutSubscriptionsSubscriptionIdActualsMonthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSubscriptionIdDiscountsRequestObject struct {
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+}
+
+type GetSubscriptionsSubscriptionIdDiscountsResponseObject interface {
+	VisitGetSubscriptionsSubscriptionIdDiscountsResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsSubscriptionIdDiscounts200JSONResponse []Discount
+
+func (response GetSubscriptionsSubscriptionIdDiscounts200JSONResponse) VisitGetSubscriptionsSubscriptionIdDiscountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSubscriptionIdDiscounts400JSONResponse MessageResponse
+
+func (response GetSubscriptionsSubscriptionIdDiscounts400JSONResponse) VisitGetSubscriptionsSubscriptionIdDiscountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsSubscriptionIdDiscountsRequestObject struct {
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+	Body           *PostSubscriptionsSubscriptionIdDiscountsJSONRequestBody
+}
+
+type PostSubscriptionsSubscriptionIdDiscountsResponseObject interface {
+	VisitPostSubscriptionsSubscriptionIdDiscountsResponse(w http.ResponseWriter) error
+}
+
+type PostSubscriptionsSubscriptionIdDiscounts201JSONResponse Discount
+
+func (response PostSubscriptionsSubscriptionIdDiscounts201JSONResponse) VisitPostSubscriptionsSubscriptionIdDiscountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsSubscriptionIdDiscounts400JSONResponse MessageResponse
+
+func (response PostSubscriptionsSubscriptionIdDiscounts400JSONResponse) VisitPostSubscriptionsSubscriptionIdDiscountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscriptionsSubscriptionIdDiscountsDiscountIdRequestObject struct {
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+	DiscountId     openapi_types.UUID `json:"discountId"`
+}
+
+type DeleteSubscriptionsSubscriptionIdDiscountsDiscountIdResponseObject interface {
+	VisitDeleteSubscriptionsSubscriptionIdDiscountsDiscountIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteSubscriptionsSubscriptionIdDiscountsDiscountId200JSONResponse MessageResponse
+
+func (response DeleteSubscriptionsSubscriptionIdDiscountsDiscountId200JSONResponse) VisitDeleteSubscriptionsSubscriptionIdDiscountsDiscountIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscriptionsSubscriptionIdDiscountsDiscountId400JSONResponse MessageResponse
+
+func (response DeleteSubscriptionsSubscriptionIdDiscountsDiscountId400JSONResponse) VisitDeleteSubscriptionsSubscriptionIdDiscountsDiscountIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSubscriptionIdHistoryRequestObject struct {
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+}
+
+type GetSubscriptionsSubscriptionIdHistoryResponseObject interface {
+	VisitGetSubscriptionsSubscriptionIdHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsSubscriptionIdHistory200JSONResponse []AuditRecord
+
+func (response GetSubscriptionsSubscriptionIdHistory200JSONResponse) VisitGetSubscriptionsSubscriptionIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSubscriptionIdHistory400JSONResponse MessageResponse
+
+func (response GetSubscriptionsSubscriptionIdHistory400JSONResponse) VisitGetSubscriptionsSubscriptionIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsSubscriptionIdRestoreRequestObject struct {
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+}
+
+type PostSubscriptionsSubscriptionIdRestoreResponseObject interface {
+	VisitPostSubscriptionsSubscriptionIdRestoreResponse(w http.ResponseWriter) error
+}
+
+type PostSubscriptionsSubscriptionIdRestore200JSONResponse MessageResponse
+
+func (response PostSubscriptionsSubscriptionIdRestore200JSONResponse) VisitPostSubscriptionsSubscriptionIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsSubscriptionIdRestore400JSONResponse MessageResponse
+
+func (response PostSubscriptionsSubscriptionIdRestore400JSONResponse) VisitPostSubscriptionsSubscriptionIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSubscriptionIdVersionsRequestObject struct {
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+	Params         GetSubscriptionsSubscriptionIdVersionsParams
+}
+
+type GetSubscriptionsSubscriptionIdVersionsResponseObject interface {
+	VisitGetSubscriptionsSubscriptionIdVersionsResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsSubscriptionIdVersions200JSONResponse []SubscriptionVersion
+
+func (response GetSubscriptionsSubscriptionIdVersions200JSONResponse) VisitGetSubscriptionsSubscriptionIdVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSubscriptionIdVersions400JSONResponse MessageResponse
+
+func (response GetSubscriptionsSubscriptionIdVersions400JSONResponse) VisitGetSubscriptionsSubscriptionIdVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrashRequestObject struct {
+	Params GetTrashParams
+}
+
+type GetTrashResponseObject interface {
+	VisitGetTrashResponse(w http.ResponseWriter) error
+}
+
+type GetTrash200JSONResponse []Subscription
+
+func (response GetTrash200JSONResponse) VisitGetTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrash400JSONResponse MessageResponse
+
+func (response GetTrash400JSONResponse) VisitGetTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdActiveRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetUsersIdActiveParams
+}
+
+type GetUsersIdActiveResponseObject interface {
+	VisitGetUsersIdActiveResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdActive200JSONResponse ActiveSet
+
+func (response GetUsersIdActive200JSONResponse) VisitGetUsersIdActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdActive400JSONResponse MessageResponse
+
+func (response GetUsersIdActive400JSONResponse) VisitGetUsersIdActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetsRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetUsersIdBudgetsResponseObject interface {
+	VisitGetUsersIdBudgetsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdBudgets200JSONResponse []Budget
+
+func (response GetUsersIdBudgets200JSONResponse) VisitGetUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgets400JSONResponse MessageResponse
+
+func (response GetUsersIdBudgets400JSONResponse) VisitGetUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdBudgetsRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *PostUsersIdBudgetsJSONRequestBody
+}
+
+type PostUsersIdBudgetsResponseObject interface {
+	VisitPostUsersIdBudgetsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersIdBudgets201JSONResponse Budget
+
+func (response PostUsersIdBudgets201JSONResponse) VisitPostUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdBudgets400JSONResponse MessageResponse
+
+func (response PostUsersIdBudgets400JSONResponse) VisitPostUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetsStatusRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetUsersIdBudgetsStatusResponseObject interface {
+	VisitGetUsersIdBudgetsStatusResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdBudgetsStatus200JSONResponse []BudgetStatus
+
+func (response GetUsersIdBudgetsStatus200JSONResponse) VisitGetUsersIdBudgetsStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetsStatus400JSONResponse MessageResponse
+
+func (response GetUsersIdBudgetsStatus400JSONResponse) VisitGetUsersIdBudgetsStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersIdBudgetsBudgetIdRequestObject struct {
+	Id       openapi_types.UUID `json:"id"`
+	BudgetId openapi_types.UUID `json:"budgetId"`
+}
+
+type DeleteUsersIdBudgetsBudgetIdResponseObject interface {
+	VisitDeleteUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersIdBudgetsBudgetId200JSONResponse MessageResponse
+
+func (response DeleteUsersIdBudgetsBudgetId200JSONResponse) VisitDeleteUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersIdBudgetsBudgetId400JSONResponse MessageResponse
+
+func (response DeleteUsersIdBudgetsBudgetId400JSONResponse) VisitDeleteUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdInsightsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetUsersIdInsightsParams
+}
+
+type GetUsersIdInsightsResponseObject interface {
+	VisitGetUsersIdInsightsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdInsights200JSONResponse []PriceAnomaly
+
+func (response GetUsersIdInsights200JSONResponse) VisitGetUsersIdInsightsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdInsights400JSONResponse MessageResponse
+
+func (response GetUsersIdInsights400JSONResponse) VisitGetUsersIdInsightsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdOwedRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetUsersIdOwedParams
+}
+
+type GetUsersIdOwedResponseObject interface {
+	VisitGetUsersIdOwedResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdOwed200JSONResponse OwedResponse
+
+func (response GetUsersIdOwed200JSONResponse) VisitGetUsersIdOwedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdOwed400JSONResponse MessageResponse
+
+func (response GetUsersIdOwed400JSONResponse) VisitGetUsersIdOwedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdStatementsMonthRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Month  string             `json:"month"`
+	Params GetUsersIdStatementsMonthParams
+}
+
+type GetUsersIdStatementsMonthResponseObject interface {
+	VisitGetUsersIdStatementsMonthResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdStatementsMonth200JSONResponse Statement
+
+func (response GetUsersIdStatementsMonth200JSONResponse) VisitGetUsersIdStatementsMonthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdStatementsMonth200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetUsersIdStatementsMonth200TextcsvResponse) VisitGetUsersIdStatementsMonthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetUsersIdStatementsMonth200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetUsersIdStatementsMonth200TexthtmlResponse) VisitGetUsersIdStatementsMonthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetUsersIdStatementsMonth400JSONResponse MessageResponse
+
+func (response GetUsersIdStatementsMonth400JSONResponse) VisitGetUsersIdStatementsMonthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdUndoRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type PostUsersIdUndoResponseObject interface {
+	VisitPostUsersIdUndoResponse(w http.ResponseWriter) error
+}
+
+type PostUsersIdUndo200JSONResponse AuditRecord
+
+func (response PostUsersIdUndo200JSONResponse) VisitPostUsersIdUndoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdUndo400JSONResponse MessageResponse
+
+func (response PostUsersIdUndo400JSONResponse) VisitPostUsersIdUndoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdUndo409JSONResponse MessageResponse
+
+func (response PostUsersIdUndo409JSONResponse) VisitPostUsersIdUndoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Отток подписок и причины отмены по сервисам и месяцам
+	// (GET /admin/analytics/churn)
+	GetAdminAnalyticsChurn(ctx context.Context, request GetAdminAnalyticsChurnRequestObject) (GetAdminAnalyticsChurnResponseObject, error)
+	// Новые и ушедшие подписки по месяцам
+	// (GET /admin/analytics/flow)
+	GetAdminAnalyticsFlow(ctx context.Context, request GetAdminAnalyticsFlowRequestObject) (GetAdminAnalyticsFlowResponseObject, error)
+	// Аномалии цен - скачки медианной цены и подписки с завышенной ценой
+	// (GET /admin/analytics/price_anomalies)
+	GetAdminAnalyticsPriceAnomalies(ctx context.Context, request GetAdminAnalyticsPriceAnomaliesRequestObject) (GetAdminAnalyticsPriceAnomaliesResponseObject, error)
+	// Средняя и медианная цена по сервисам
+	// (GET /admin/analytics/prices)
+	GetAdminAnalyticsPrices(ctx context.Context, request GetAdminAnalyticsPricesRequestObject) (GetAdminAnalyticsPricesResponseObject, error)
+	// Удержание когорт подписок по месяцу начала
+	// (GET /admin/analytics/retention)
+	GetAdminAnalyticsRetention(ctx context.Context, request GetAdminAnalyticsRetentionRequestObject) (GetAdminAnalyticsRetentionResponseObject, error)
+	// Выручка по сервисам и месяцам
+	// (GET /admin/analytics/revenue)
+	GetAdminAnalyticsRevenue(ctx context.Context, request GetAdminAnalyticsRevenueRequestObject) (GetAdminAnalyticsRevenueResponseObject, error)
+	// Сервисы с наибольшим числом активных подписчиков
+	// (GET /admin/analytics/top_services)
+	GetAdminAnalyticsTopServices(ctx context.Context, request GetAdminAnalyticsTopServicesRequestObject) (GetAdminAnalyticsTopServicesResponseObject, error)
+	// Поиск по журналу аудита
+	// (GET /admin/audit)
+	GetAdminAudit(ctx context.Context, request GetAdminAuditRequestObject) (GetAdminAuditResponseObject, error)
+	// Пересчёт журнала списаний за период
+	// (POST /admin/ledger/rebuild)
+	PostAdminLedgerRebuild(ctx context.Context, request PostAdminLedgerRebuildRequestObject) (PostAdminLedgerRebuildResponseObject, error)
+	// Проверка подписок на дубликаты, пересечения и некорректные данные
+	// (GET /admin/quality)
+	GetAdminQuality(ctx context.Context, request GetAdminQualityRequestObject) (GetAdminQualityResponseObject, error)
+	// Автоматическое исправление проблем с данными подписок
+	// (POST /admin/quality/fix)
+	PostAdminQualityFix(ctx context.Context, request PostAdminQualityFixRequestObject) (PostAdminQualityFixResponseObject, error)
+	// Слияние сервиса с другим во всех подписках
+	// (POST /admin/services/merge)
+	PostAdminServicesMerge(ctx context.Context, request PostAdminServicesMergeRequestObject) (PostAdminServicesMergeResponseObject, error)
+	// Переименование сервиса во всех подписках
+	// (POST /admin/services/rename)
+	PostAdminServicesRename(ctx context.Context, request PostAdminServicesRenameRequestObject) (PostAdminServicesRenameResponseObject, error)
+	// Перенос подписок одного пользователя другому при слиянии аккаунтов
+	// (POST /admin/users/transfer)
+	PostAdminUsersTransfer(ctx context.Context, request PostAdminUsersTransferRequestObject) (PostAdminUsersTransferResponseObject, error)
+	// Получение списка подписок
+	// (GET /all)
+	GetAll(ctx context.Context, request GetAllRequestObject) (GetAllResponseObject, error)
+	// Получение каталога сервисов
+	// (GET /services)
+	GetServices(ctx context.Context, request GetServicesRequestObject) (GetServicesResponseObject, error)
+	// Добавление сервиса в каталог
+	// (POST /services)
+	PostServices(ctx context.Context, request PostServicesRequestObject) (PostServicesResponseObject, error)
+	// Поиск сервисов в каталоге для автодополнения
+	// (GET /services/search)
+	GetServicesSearch(ctx context.Context, request GetServicesSearchRequestObject) (GetServicesSearchResponseObject, error)
+	// Удаление сервиса из каталога
+	// (DELETE /services/{serviceId})
+	DeleteServicesServiceId(ctx context.Context, request DeleteServicesServiceIdRequestObject) (DeleteServicesServiceIdResponseObject, error)
+	// Получение сервиса из каталога
+	// (GET /services/{serviceId})
+	GetServicesServiceId(ctx context.Context, request GetServicesServiceIdRequestObject) (GetServicesServiceIdResponseObject, error)
+	// Обновление сервиса в каталоге
+	// (PUT /services/{serviceId})
+	PutServicesServiceId(ctx context.Context, request PutServicesServiceIdRequestObject) (PutServicesServiceIdResponseObject, error)
+	// Удаление ошибочно внесённой подписки
+	// (DELETE /subscriptions)
+	DeleteSubscriptions(ctx context.Context, request DeleteSubscriptionsRequestObject) (DeleteSubscriptionsResponseObject, error)
+	// Получение последней подписки
+	// (GET /subscriptions)
+	GetSubscriptions(ctx context.Context, request GetSubscriptionsRequestObject) (GetSubscriptionsResponseObject, error)
+	// Создание новой подписки
+	// (POST /subscriptions)
+	PostSubscriptions(ctx context.Context, request PostSubscriptionsRequestObject) (PostSubscriptionsResponseObject, error)
+	// Обновление подписки
+	// (PUT /subscriptions)
+	PutSubscriptions(ctx context.Context, request PutSubscriptionsRequestObject) (PutSubscriptionsResponseObject, error)
+	// Расходы на подписки за период построчно, скидки - отдельными строками
+	// (GET /subscriptions/breakdown)
+	GetSubscriptionsBreakdown(ctx context.Context, request GetSubscriptionsBreakdownRequestObject) (GetSubscriptionsBreakdownResponseObject, error)
+	// Отмена подписки в конце расчётного периода
+	// (POST /subscriptions/cancel)
+	PostSubscriptionsCancel(ctx context.Context, request PostSubscriptionsCancelRequestObject) (PostSubscriptionsCancelResponseObject, error)
+	// Сравнение расходов за два периода
+	// (GET /subscriptions/compare)
+	GetSubscriptionsCompare(ctx context.Context, request GetSubscriptionsCompareRequestObject) (GetSubscriptionsCompareResponseObject, error)
+	// Помесячные расходы на подписки за период
+	// (GET /subscriptions/spend_series)
+	GetSubscriptionsSpendSeries(ctx context.Context, request GetSubscriptionsSpendSeriesRequestObject) (GetSubscriptionsSpendSeriesResponseObject, error)
+	// Подсчёт суммарной стоимости подписок за период
+	// (GET /subscriptions/total_cost)
+	GetSubscriptionsTotalCost(ctx context.Context, request GetSubscriptionsTotalCostRequestObject) (GetSubscriptionsTotalCostResponseObject, error)
+	// Получение фактических списаний по подписке
+	// (GET /subscriptions/{subscriptionId}/actuals)
+	GetSubscriptionsSubscriptionIdActuals(ctx context.Context, request GetSubscriptionsSubscriptionIdActualsRequestObject) (GetSubscriptionsSubscriptionIdActualsResponseObject, error)
+	// Удаление фактического списания за месяц
+	// (DELETE /subscriptions/{subscriptionId}/actuals/{month})
+	DeleteSubscriptionsSubscriptionIdActualsMonth(ctx context.Context, request DeleteSubscriptionsSubscriptionIdActualsMonthRequestObject) (DeleteSubscriptionsSubscriptionIdActualsMonthResponseObject, error)
+	// Запись фактического списания за месяц вместо месячной стоимости
+	// (PUT /subscriptions/{subscriptionId}/actuals/{month})
+	PutSubscriptionsSubscriptionIdActualsMonth(ctx context.Context, request PutSubscriptionsSubscriptionIdActualsMonthRequestObject) (PutSubscriptionsSubscriptionIdActualsMonthResponseObject, error)
+	// Получение скидок подписки
+	// (GET /subscriptions/{subscriptionId}/discounts)
+	GetSubscriptionsSubscriptionIdDiscounts(ctx context.Context, request GetSubscriptionsSubscriptionIdDiscountsRequestObject) (GetSubscriptionsSubscriptionIdDiscountsResponseObject, error)
+	// Добавление скидки или промо-периода
+	// (POST /subscriptions/{subscriptionId}/discounts)
+	PostSubscriptionsSubscriptionIdDiscounts(ctx context.Context, request PostSubscriptionsSubscriptionIdDiscountsRequestObject) (PostSubscriptionsSubscriptionIdDiscountsResponseObject, error)
+	// Удаление скидки
+	// (DELETE /subscriptions/{subscriptionId}/discounts/{discountId})
+	DeleteSubscriptionsSubscriptionIdDiscountsDiscountId(ctx context.Context, request DeleteSubscriptionsSubscriptionIdDiscountsDiscountIdRequestObject) (DeleteSubscriptionsSubscriptionIdDiscountsDiscountIdResponseObject, error)
+	// История изменений подписки
+	// (GET /subscriptions/{subscriptionId}/history)
+	GetSubscriptionsSubscriptionIdHistory(ctx context.Context, request GetSubscriptionsSubscriptionIdHistoryRequestObject) (GetSubscriptionsSubscriptionIdHistoryResponseObject, error)
+	// Восстановление удалённой подписки из корзины
+	// (POST /subscriptions/{subscriptionId}/restore)
+	PostSubscriptionsSubscriptionIdRestore(ctx context.Context, request PostSubscriptionsSubscriptionIdRestoreRequestObject) (PostSubscriptionsSubscriptionIdRestoreResponseObject, error)
+	// Версии подписки, сохранённые базой данных
+	// (GET /subscriptions/{subscriptionId}/versions)
+	GetSubscriptionsSubscriptionIdVersions(ctx context.Context, request GetSubscriptionsSubscriptionIdVersionsRequestObject) (GetSubscriptionsSubscriptionIdVersionsResponseObject, error)
+	// Получение удалённых подписок пользователя
+	// (GET /trash)
+	GetTrash(ctx context.Context, request GetTrashRequestObject) (GetTrashResponseObject, error)
+	// Все активные подписки пользователя на дату
+	// (GET /users/{id}/active)
+	GetUsersIdActive(ctx context.Context, request GetUsersIdActiveRequestObject) (GetUsersIdActiveResponseObject, error)
+	// Получение бюджетов пользователя
+	// (GET /users/{id}/budgets)
+	GetUsersIdBudgets(ctx context.Context, request GetUsersIdBudgetsRequestObject) (GetUsersIdBudgetsResponseObject, error)
+	// Создание бюджета
+	// (POST /users/{id}/budgets)
+	PostUsersIdBudgets(ctx context.Context, request PostUsersIdBudgetsRequestObject) (PostUsersIdBudgetsResponseObject, error)
+	// Расходы и прогноз относительно бюджетов пользователя
+	// (GET /users/{id}/budgets/status)
+	GetUsersIdBudgetsStatus(ctx context.Context, request GetUsersIdBudgetsStatusRequestObject) (GetUsersIdBudgetsStatusResponseObject, error)
+	// Удаление бюджета
+	// (DELETE /users/{id}/budgets/{budgetId})
+	DeleteUsersIdBudgetsBudgetId(ctx context.Context, request DeleteUsersIdBudgetsBudgetIdRequestObject) (DeleteUsersIdBudgetsBudgetIdResponseObject, error)
+	// Подписки пользователя с ценой намного выше каталога или других пользователей
+	// (GET /users/{id}/insights)
+	GetUsersIdInsights(ctx context.Context, request GetUsersIdInsightsRequestObject) (GetUsersIdInsightsResponseObject, error)
+	// Сколько участники семейных подписок должны плательщику за период
+	// (GET /users/{id}/owed)
+	GetUsersIdOwed(ctx context.Context, request GetUsersIdOwedRequestObject) (GetUsersIdOwedResponseObject, error)
+	// Выписка пользователя за месяц
+	// (GET /users/{id}/statements/{month})
+	GetUsersIdStatementsMonth(ctx context.Context, request GetUsersIdStatementsMonthRequestObject) (GetUsersIdStatementsMonthResponseObject, error)
+	// Отмена последнего изменения подписок пользователя
+	// (POST /users/{id}/undo)
+	PostUsersIdUndo(ctx context.Context, request PostUsersIdUndoRequestObject) (PostUsersIdUndoResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAdminAnalyticsChurn operation middleware
+func (sh *strictHandler) GetAdminAnalyticsChurn(ctx *gin.Context, params GetAdminAnalyticsChurnParams) {
+	var request GetAdminAnalyticsChurnRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAnalyticsChurn(ctx, request.(GetAdminAnalyticsChurnRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAnalyticsChurn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminAnalyticsChurnResponseObject); ok {
+		if err := validResponse.VisitGetAdminAnalyticsChurnResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminAnalyticsFlow operation middleware
+func (sh *strictHandler) GetAdminAnalyticsFlow(ctx *gin.Context, params GetAdminAnalyticsFlowParams) {
+	var request GetAdminAnalyticsFlowRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAnalyticsFlow(ctx, request.(GetAdminAnalyticsFlowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAnalyticsFlow")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminAnalyticsFlowResponseObject); ok {
+		if err := validResponse.VisitGetAdminAnalyticsFlowResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminAnalyticsPriceAnomalies operation middleware
+func (sh *strictHandler) GetAdminAnalyticsPriceAnomalies(ctx *gin.Context, params GetAdminAnalyticsPriceAnomaliesParams) {
+	var request GetAdminAnalyticsPriceAnomaliesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAnalyticsPriceAnomalies(ctx, request.(GetAdminAnalyticsPriceAnomaliesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAnalyticsPriceAnomalies")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminAnalyticsPriceAnomaliesResponseObject); ok {
+		if err := validResponse.VisitGetAdminAnalyticsPriceAnomaliesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminAnalyticsPrices operation middleware
+func (sh *strictHandler) GetAdminAnalyticsPrices(ctx *gin.Context) {
+	var request GetAdminAnalyticsPricesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAnalyticsPrices(ctx, request.(GetAdminAnalyticsPricesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAnalyticsPrices")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminAnalyticsPricesResponseObject); ok {
+		if err := validResponse.VisitGetAdminAnalyticsPricesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminAnalyticsRetention operation middleware
+func (sh *strictHandler) GetAdminAnalyticsRetention(ctx *gin.Context, params GetAdminAnalyticsRetentionParams) {
+	var request GetAdminAnalyticsRetentionRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAnalyticsRetention(ctx, request.(GetAdminAnalyticsRetentionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAnalyticsRetention")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminAnalyticsRetentionResponseObject); ok {
+		if err := validResponse.VisitGetAdminAnalyticsRetentionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminAnalyticsRevenue operation middleware
+func (sh *strictHandler) GetAdminAnalyticsRevenue(ctx *gin.Context, params GetAdminAnalyticsRevenueParams) {
+	var request GetAdminAnalyticsRevenueRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAnalyticsRevenue(ctx, request.(GetAdminAnalyticsRevenueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAnalyticsRevenue")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminAnalyticsRevenueResponseObject); ok {
+		if err := validResponse.VisitGetAdminAnalyticsRevenueResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminAnalyticsTopServices operation middleware
+func (sh *strictHandler) GetAdminAnalyticsTopServices(ctx *gin.Context, params GetAdminAnalyticsTopServicesParams) {
+	var request GetAdminAnalyticsTopServicesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAnalyticsTopServices(ctx, request.(GetAdminAnalyticsTopServicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAnalyticsTopServices")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminAnalyticsTopServicesResponseObject); ok {
+		if err := validResponse.VisitGetAdminAnalyticsTopServicesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminAudit operation middleware
+func (sh *strictHandler) GetAdminAudit(ctx *gin.Context, params GetAdminAuditParams) {
+	var request GetAdminAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAudit(ctx, request.(GetAdminAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAudit")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminAuditResponseObject); ok {
+		if err := validResponse.VisitGetAdminAuditResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminLedgerRebuild operation middleware
+func (sh *strictHandler) PostAdminLedgerRebuild(ctx *gin.Context) {
+	var request PostAdminLedgerRebuildRequestObject
+
+	var body PostAdminLedgerRebuildJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminLedgerRebuild(ctx, request.(PostAdminLedgerRebuildRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminLedgerRebuild")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminLedgerRebuildResponseObject); ok {
+		if err := validResponse.VisitPostAdminLedgerRebuildResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminQuality operation middleware
+func (sh *strictHandler) GetAdminQuality(ctx *gin.Context) {
+	var request GetAdminQualityRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminQuality(ctx, request.(GetAdminQualityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminQuality")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminQualityResponseObject); ok {
+		if err := validResponse.VisitGetAdminQualityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminQualityFix operation middleware
+func (sh *strictHandler) PostAdminQualityFix(ctx *gin.Context) {
+	var request PostAdminQualityFixRequestObject
+
+	var body PostAdminQualityFixJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminQualityFix(ctx, request.(PostAdminQualityFixRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminQualityFix")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminQualityFixResponseObject); ok {
+		if err := validResponse.VisitPostAdminQualityFixResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminServicesMerge operation middleware
+func (sh *strictHandler) PostAdminServicesMerge(ctx *gin.Context) {
+	var request PostAdminServicesMergeRequestObject
+
+	var body PostAdminServicesMergeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminServicesMerge(ctx, request.(PostAdminServicesMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminServicesMerge")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminServicesMergeResponseObject); ok {
+		if err := validResponse.VisitPostAdminServicesMergeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminServicesRename operation middleware
+func (sh *strictHandler) PostAdminServicesRename(ctx *gin.Context) {
+	var request PostAdminServicesRenameRequestObject
+
+	var body PostAdminServicesRenameJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminServicesRename(ctx, request.(PostAdminServicesRenameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminServicesRename")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminServicesRenameResponseObject); ok {
+		if err := validResponse.VisitPostAdminServicesRenameResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersTransfer operation middleware
+func (sh *strictHandler) PostAdminUsersTransfer(ctx *gin.Context) {
+	var request PostAdminUsersTransferRequestObject
+
+	var body PostAdminUsersTransferJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersTransfer(ctx, request.(PostAdminUsersTransferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersTransfer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersTransferResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersTransferResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAll operation middleware
+func (sh *strictHandler) GetAll(ctx *gin.Context, params GetAllParams) {
+	var request GetAllRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAll(ctx, request.(GetAllRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAll")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAllResponseObject); ok {
+		if err := validResponse.VisitGetAllResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetServices operation middleware
+func (sh *strictHandler) GetServices(ctx *gin.Context) {
+	var request GetServicesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetServices(ctx, request.(GetServicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetServices")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetServicesResponseObject); ok {
+		if err := validResponse.VisitGetServicesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostServices operation middleware
+func (sh *strictHandler) PostServices(ctx *gin.Context) {
+	var request PostServicesRequestObject
+
+	var body PostServicesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostServices(ctx, request.(PostServicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostServices")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostServicesResponseObject); ok {
+		if err := validResponse.VisitPostServicesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetServicesSearch operation middleware
+func (sh *strictHandler) GetServicesSearch(ctx *gin.Context, params GetServicesSearchParams) {
+	var request GetServicesSearchRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetServicesSearch(ctx, request.(GetServicesSearchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetServicesSearch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetServicesSearchResponseObject); ok {
+		if err := validResponse.VisitGetServicesSearchResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteServicesServiceId operation middleware
+func (sh *strictHandler) DeleteServicesServiceId(ctx *gin.Context, serviceId openapi_types.UUID) {
+	var request DeleteServicesServiceIdRequestObject
+
+	request.ServiceId = serviceId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteServicesServiceId(ctx, request.(DeleteServicesServiceIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteServicesServiceId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteServicesServiceIdResponseObject); ok {
+		if err := validResponse.VisitDeleteServicesServiceIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetServicesServiceId operation middleware
+func (sh *strictHandler) GetServicesServiceId(ctx *gin.Context, serviceId openapi_types.UUID) {
+	var request GetServicesServiceIdRequestObject
+
+	request.ServiceId = serviceId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetServicesServiceId(ctx, request.(GetServicesServiceIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetServicesServiceId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetServicesServiceIdResponseObject); ok {
+		if err := validResponse.VisitGetServicesServiceIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutServicesServiceId operation middleware
+func (sh *strictHandler) PutServicesServiceId(ctx *gin.Context, serviceId openapi_types.UUID) {
+	var request PutServicesServiceIdRequestObject
+
+	request.ServiceId = serviceId
+
+	var body PutServicesServiceIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutServicesServiceId(ctx, request.(PutServicesServiceIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutServicesServiceId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutServicesServiceIdResponseObject); ok {
+		if err := validResponse.VisitPutServicesServiceIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteSubscriptions operation middleware
+func (sh *strictHandler) DeleteSubscriptions(ctx *gin.Context, params DeleteSubscriptionsParams) {
+	var request DeleteSubscriptionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSubscriptions(ctx, request.(DeleteSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSubscriptions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitDeleteSubscriptionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSubscriptions operation middleware
+func (sh *strictHandler) GetSubscriptions(ctx *gin.Context, params GetSubscriptionsParams) {
+	var request GetSubscriptionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptions(ctx, request.(GetSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSubscriptions operation middleware
+func (sh *strictHandler) PostSubscriptions(ctx *gin.Context) {
+	var request PostSubscriptionsRequestObject
+
+	var body PostSubscriptionsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSubscriptions(ctx, request.(PostSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSubscriptions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitPostSubscriptionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutSubscriptions operation middleware
+func (sh *strictHandler) PutSubscriptions(ctx *gin.Context) {
+	var request PutSubscriptionsRequestObject
+
+	var body PutSubscriptionsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutSubscriptions(ctx, request.(PutSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutSubscriptions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitPutSubscriptionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSubscriptionsBreakdown operation middleware
+func (sh *strictHandler) GetSubscriptionsBreakdown(ctx *gin.Context, params GetSubscriptionsBreakdownParams) {
+	var request GetSubscriptionsBreakdownRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsBreakdown(ctx, request.(GetSubscriptionsBreakdownRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsBreakdown")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSubscriptionsBreakdownResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsBreakdownResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
 		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+// PostSubscriptionsCancel operation middleware
+func (sh *strictHandler) PostSubscriptionsCancel(ctx *gin.Context) {
+	var request PostSubscriptionsCancelRequestObject
+
+	var body PostSubscriptionsCancelJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
 	}
+	request.Body = &body
 
-	router.GET(options.BaseURL+"/all", wrapper.GetAll)
-	router.DELETE(options.BaseURL+"/subscriptions", wrapper.DeleteSubscriptions)
-	router.GET(options.BaseURL+"/subscriptions", wrapper.GetSubscriptions)
-	router.POST(options.BaseURL+"/subscriptions", wrapper.PostSubscriptions)
-	router.PUT(options.BaseURL+"/subscriptions", wrapper.PutSubscriptions)
-	router.GET(options.BaseURL+"/subscriptions/total_cost", wrapper.GetSubscriptionsTotalCost)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSubscriptionsCancel(ctx, request.(PostSubscriptionsCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSubscriptionsCancel")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSubscriptionsCancelResponseObject); ok {
+		if err := validResponse.VisitPostSubscriptionsCancelResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllRequestObject struct {
-	Params GetAllParams
+// GetSubscriptionsCompare operation middleware
+func (sh *strictHandler) GetSubscriptionsCompare(ctx *gin.Context, params GetSubscriptionsCompareParams) {
+	var request GetSubscriptionsCompareRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsCompare(ctx, request.(GetSubscriptionsCompareRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsCompare")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSubscriptionsCompareResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsCompareResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllResponseObject interface {
-	VisitGetAllResponse(w http.ResponseWriter) error
+// GetSubscriptionsSpendSeries operation middleware
+func (sh *strictHandler) GetSubscriptionsSpendSeries(ctx *gin.Context, params GetSubscriptionsSpendSeriesParams) {
+	var request GetSubscriptionsSpendSeriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsSpendSeries(ctx, request.(GetSubscriptionsSpendSeriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsSpendSeries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSubscriptionsSpendSeriesResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsSpendSeriesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAll200JSONResponse []Subscription
+// GetSubscriptionsTotalCost operation middleware
+func (sh *strictHandler) GetSubscriptionsTotalCost(ctx *gin.Context, params GetSubscriptionsTotalCostParams) {
+	var request GetSubscriptionsTotalCostRequestObject
 
-func (response GetAll200JSONResponse) VisitGetAllResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsTotalCost(ctx, request.(GetSubscriptionsTotalCostRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsTotalCost")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSubscriptionsTotalCostResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsTotalCostResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAll400JSONResponse MessageResponse
+// GetSubscriptionsSubscriptionIdActuals operation middleware
+func (sh *strictHandler) GetSubscriptionsSubscriptionIdActuals(ctx *gin.Context, subscriptionId openapi_types.UUID) {
+	var request GetSubscriptionsSubscriptionIdActualsRequestObject
 
-func (response GetAll400JSONResponse) VisitGetAllResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.SubscriptionId = subscriptionId
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsSubscriptionIdActuals(ctx, request.(GetSubscriptionsSubscriptionIdActualsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsSubscriptionIdActuals")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSubscriptionsSubscriptionIdActualsResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsSubscriptionIdActualsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSubscriptionsRequestObject struct {
-	Params DeleteSubscriptionsParams
+// DeleteSubscriptionsSubscriptionIdActualsMonth operation middleware
+func (sh *strictHandler) DeleteSubscriptionsSubscriptionIdActualsMonth(ctx *gin.Context, subscriptionId openapi_types.UUID, month string) {
+	var request DeleteSubscriptionsSubscriptionIdActualsMonthRequestObject
+
+	request.SubscriptionId = subscriptionId
+	request.Month = month
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSubscriptionsSubscriptionIdActualsMonth(ctx, request.(DeleteSubscriptionsSubscriptionIdActualsMonthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSubscriptionsSubscriptionIdActualsMonth")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteSubscriptionsSubscriptionIdActualsMonthResponseObject); ok {
+		if err := validResponse.VisitDeleteSubscriptionsSubscriptionIdActualsMonthResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSubscriptionsResponseObject interface {
-	VisitDeleteSubscriptionsResponse(w http.ResponseWriter) error
+// PutSubscriptionsSubscriptionIdActualsMonth operation middleware
+func (sh *strictHandler) PutSubscriptionsSubscriptionIdActualsMonth(ctx *gin.Context, subscriptionId openapi_types.UUID, month string) {
+	var request PutSubscriptionsSubscriptionIdActualsMonthRequestObject
+
+	request.SubscriptionId = subscriptionId
+	request.Month = month
+
+	var body PutSubscriptionsSubscriptionIdActualsMonthJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutSubscriptionsSubscriptionIdActualsMonth(ctx, request.(PutSubscriptionsSubscriptionIdActualsMonthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutSubscriptionsSubscriptionIdActualsMonth")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutSubscriptionsSubscriptionIdActualsMonthResponseObject); ok {
+		if err := validResponse.VisitPutSubscriptionsSubscriptionIdActualsMonthResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSubscriptions200JSONResponse MessageResponse
+// GetSubscriptionsSubscriptionIdDiscounts operation middleware
+func (sh *strictHandler) GetSubscriptionsSubscriptionIdDiscounts(ctx *gin.Context, subscriptionId openapi_types.UUID) {
+	var request GetSubscriptionsSubscriptionIdDiscountsRequestObject
 
-func (response DeleteSubscriptions200JSONResponse) VisitDeleteSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.SubscriptionId = subscriptionId
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsSubscriptionIdDiscounts(ctx, request.(GetSubscriptionsSubscriptionIdDiscountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsSubscriptionIdDiscounts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSubscriptionsSubscriptionIdDiscountsResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsSubscriptionIdDiscountsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSubscriptions400JSONResponse MessageResponse
+// PostSubscriptionsSubscriptionIdDiscounts operation middleware
+func (sh *strictHandler) PostSubscriptionsSubscriptionIdDiscounts(ctx *gin.Context, subscriptionId openapi_types.UUID) {
+	var request PostSubscriptionsSubscriptionIdDiscountsRequestObject
 
-func (response DeleteSubscriptions400JSONResponse) VisitDeleteSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.SubscriptionId = subscriptionId
 
-	return json.NewEncoder(w).Encode(response)
+	var body PostSubscriptionsSubscriptionIdDiscountsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSubscriptionsSubscriptionIdDiscounts(ctx, request.(PostSubscriptionsSubscriptionIdDiscountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSubscriptionsSubscriptionIdDiscounts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSubscriptionsSubscriptionIdDiscountsResponseObject); ok {
+		if err := validResponse.VisitPostSubscriptionsSubscriptionIdDiscountsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSubscriptionsRequestObject struct {
-	Params GetSubscriptionsParams
+// DeleteSubscriptionsSubscriptionIdDiscountsDiscountId operation middleware
+func (sh *strictHandler) DeleteSubscriptionsSubscriptionIdDiscountsDiscountId(ctx *gin.Context, subscriptionId openapi_types.UUID, discountId openapi_types.UUID) {
+	var request DeleteSubscriptionsSubscriptionIdDiscountsDiscountIdRequestObject
+
+	request.SubscriptionId = subscriptionId
+	request.DiscountId = discountId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSubscriptionsSubscriptionIdDiscountsDiscountId(ctx, request.(DeleteSubscriptionsSubscriptionIdDiscountsDiscountIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSubscriptionsSubscriptionIdDiscountsDiscountId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteSubscriptionsSubscriptionIdDiscountsDiscountIdResponseObject); ok {
+		if err := validResponse.VisitDeleteSubscriptionsSubscriptionIdDiscountsDiscountIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSubscriptionsResponseObject interface {
-	VisitGetSubscriptionsResponse(w http.ResponseWriter) error
-}
+// GetSubscriptionsSubscriptionIdHistory operation middleware
+func (sh *strictHandler) GetSubscriptionsSubscriptionIdHistory(ctx *gin.Context, subscriptionId openapi_types.UUID) {
+	var request GetSubscriptionsSubscriptionIdHistoryRequestObject
 
-type GetSubscriptions200JSONResponse Subscription
+	request.SubscriptionId = subscriptionId
 
-func (response GetSubscriptions200JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsSubscriptionIdHistory(ctx, request.(GetSubscriptionsSubscriptionIdHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsSubscriptionIdHistory")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSubscriptionsSubscriptionIdHistoryResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsSubscriptionIdHistoryResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSubscriptions400JSONResponse MessageResponse
+// PostSubscriptionsSubscriptionIdRestore operation middleware
+func (sh *strictHandler) PostSubscriptionsSubscriptionIdRestore(ctx *gin.Context, subscriptionId openapi_types.UUID) {
+	var request PostSubscriptionsSubscriptionIdRestoreRequestObject
 
-func (response GetSubscriptions400JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.SubscriptionId = subscriptionId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSubscriptionsSubscriptionIdRestore(ctx, request.(PostSubscriptionsSubscriptionIdRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSubscriptionsSubscriptionIdRestore")
+	}
 
-type PostSubscriptionsRequestObject struct {
-	Body *PostSubscriptionsJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type PostSubscriptionsResponseObject interface {
-	VisitPostSubscriptionsResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSubscriptionsSubscriptionIdRestoreResponseObject); ok {
+		if err := validResponse.VisitPostSubscriptionsSubscriptionIdRestoreResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostSubscriptions201JSONResponse MessageResponse
-
-func (response PostSubscriptions201JSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// GetSubscriptionsSubscriptionIdVersions operation middleware
+func (sh *strictHandler) GetSubscriptionsSubscriptionIdVersions(ctx *gin.Context, subscriptionId openapi_types.UUID, params GetSubscriptionsSubscriptionIdVersionsParams) {
+	var request GetSubscriptionsSubscriptionIdVersionsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.SubscriptionId = subscriptionId
+	request.Params = params
 
-type PostSubscriptions400JSONResponse MessageResponse
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsSubscriptionIdVersions(ctx, request.(GetSubscriptionsSubscriptionIdVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsSubscriptionIdVersions")
+	}
 
-func (response PostSubscriptions400JSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSubscriptionsSubscriptionIdVersionsResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsSubscriptionIdVersionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutSubscriptionsRequestObject struct {
-	Body *PutSubscriptionsJSONRequestBody
-}
+// GetTrash operation middleware
+func (sh *strictHandler) GetTrash(ctx *gin.Context, params GetTrashParams) {
+	var request GetTrashRequestObject
 
-type PutSubscriptionsResponseObject interface {
-	VisitPutSubscriptionsResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type PutSubscriptions200JSONResponse MessageResponse
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTrash(ctx, request.(GetTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTrash")
+	}
 
-func (response PutSubscriptions200JSONResponse) VisitPutSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetTrashResponseObject); ok {
+		if err := validResponse.VisitGetTrashResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutSubscriptions400JSONResponse MessageResponse
+// GetUsersIdActive operation middleware
+func (sh *strictHandler) GetUsersIdActive(ctx *gin.Context, id openapi_types.UUID, params GetUsersIdActiveParams) {
+	var request GetUsersIdActiveRequestObject
 
-func (response PutSubscriptions400JSONResponse) VisitPutSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersIdActive(ctx, request.(GetUsersIdActiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersIdActive")
+	}
 
-type GetSubscriptionsTotalCostRequestObject struct {
-	Params GetSubscriptionsTotalCostParams
-}
+	response, err := handler(ctx, request)
 
-type GetSubscriptionsTotalCostResponseObject interface {
-	VisitGetSubscriptionsTotalCostResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUsersIdActiveResponseObject); ok {
+		if err := validResponse.VisitGetUsersIdActiveResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSubscriptionsTotalCost200JSONResponse TotalCostResponse
-
-func (response GetSubscriptionsTotalCost200JSONResponse) VisitGetSubscriptionsTotalCostResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetUsersIdBudgets operation middleware
+func (sh *strictHandler) GetUsersIdBudgets(ctx *gin.Context, id openapi_types.UUID) {
+	var request GetUsersIdBudgetsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type GetSubscriptionsTotalCost400JSONResponse MessageResponse
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersIdBudgets(ctx, request.(GetUsersIdBudgetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersIdBudgets")
+	}
 
-func (response GetSubscriptionsTotalCost400JSONResponse) VisitGetSubscriptionsTotalCostResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUsersIdBudgetsResponseObject); ok {
+		if err := validResponse.VisitGetUsersIdBudgetsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Получение списка подписок
-	// (GET /all)
-	GetAll(ctx context.Context, request GetAllRequestObject) (GetAllResponseObject, error)
-	// Удаление подписки
-	// (DELETE /subscriptions)
-	DeleteSubscriptions(ctx context.Context, request DeleteSubscriptionsRequestObject) (DeleteSubscriptionsResponseObject, error)
-	// Получение последней подписки
-	// (GET /subscriptions)
-	GetSubscriptions(ctx context.Context, request GetSubscriptionsRequestObject) (GetSubscriptionsResponseObject, error)
-	// Создание новой подписки
-	// (POST /subscriptions)
-	PostSubscriptions(ctx context.Context, request PostSubscriptionsRequestObject) (PostSubscriptionsResponseObject, error)
-	// Обновление подписки
-	// (PUT /subscriptions)
-	PutSubscriptions(ctx context.Context, request PutSubscriptionsRequestObject) (PutSubscriptionsResponseObject, error)
-	// Подсчёт суммарной стоимости подписок за период
-	// (GET /subscriptions/total_cost)
-	GetSubscriptionsTotalCost(ctx context.Context, request GetSubscriptionsTotalCostRequestObject) (GetSubscriptionsTotalCostResponseObject, error)
-}
+// PostUsersIdBudgets operation middleware
+func (sh *strictHandler) PostUsersIdBudgets(ctx *gin.Context, id openapi_types.UUID) {
+	var request PostUsersIdBudgetsRequestObject
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	request.Id = id
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	var body PostUsersIdBudgetsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersIdBudgets(ctx, request.(PostUsersIdBudgetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersIdBudgets")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostUsersIdBudgetsResponseObject); ok {
+		if err := validResponse.VisitPostUsersIdBudgetsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetAll operation middleware
-func (sh *strictHandler) GetAll(ctx *gin.Context, params GetAllParams) {
-	var request GetAllRequestObject
+// GetUsersIdBudgetsStatus operation middleware
+func (sh *strictHandler) GetUsersIdBudgetsStatus(ctx *gin.Context, id openapi_types.UUID) {
+	var request GetUsersIdBudgetsStatusRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAll(ctx, request.(GetAllRequestObject))
+		return sh.ssi.GetUsersIdBudgetsStatus(ctx, request.(GetUsersIdBudgetsStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAll")
+		handler = middleware(handler, "GetUsersIdBudgetsStatus")
 	}
 
 	response, err := handler(ctx, request)
@@ -540,8 +5591,8 @@ func (sh *strictHandler) GetAll(ctx *gin.Context, params GetAllParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetAllResponseObject); ok {
-		if err := validResponse.VisitGetAllResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetUsersIdBudgetsStatusResponseObject); ok {
+		if err := validResponse.VisitGetUsersIdBudgetsStatusResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -549,17 +5600,18 @@ func (sh *strictHandler) GetAll(ctx *gin.Context, params GetAllParams) {
 	}
 }
 
-// DeleteSubscriptions operation middleware
-func (sh *strictHandler) DeleteSubscriptions(ctx *gin.Context, params DeleteSubscriptionsParams) {
-	var request DeleteSubscriptionsRequestObject
+// DeleteUsersIdBudgetsBudgetId operation middleware
+func (sh *strictHandler) DeleteUsersIdBudgetsBudgetId(ctx *gin.Context, id openapi_types.UUID, budgetId openapi_types.UUID) {
+	var request DeleteUsersIdBudgetsBudgetIdRequestObject
 
-	request.Params = params
+	request.Id = id
+	request.BudgetId = budgetId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteSubscriptions(ctx, request.(DeleteSubscriptionsRequestObject))
+		return sh.ssi.DeleteUsersIdBudgetsBudgetId(ctx, request.(DeleteUsersIdBudgetsBudgetIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteSubscriptions")
+		handler = middleware(handler, "DeleteUsersIdBudgetsBudgetId")
 	}
 
 	response, err := handler(ctx, request)
@@ -567,8 +5619,8 @@ func (sh *strictHandler) DeleteSubscriptions(ctx *gin.Context, params DeleteSubs
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteSubscriptionsResponseObject); ok {
-		if err := validResponse.VisitDeleteSubscriptionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteUsersIdBudgetsBudgetIdResponseObject); ok {
+		if err := validResponse.VisitDeleteUsersIdBudgetsBudgetIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -576,17 +5628,18 @@ func (sh *strictHandler) DeleteSubscriptions(ctx *gin.Context, params DeleteSubs
 	}
 }
 
-// GetSubscriptions operation middleware
-func (sh *strictHandler) GetSubscriptions(ctx *gin.Context, params GetSubscriptionsParams) {
-	var request GetSubscriptionsRequestObject
+// GetUsersIdInsights operation middleware
+func (sh *strictHandler) GetUsersIdInsights(ctx *gin.Context, id openapi_types.UUID, params GetUsersIdInsightsParams) {
+	var request GetUsersIdInsightsRequestObject
 
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSubscriptions(ctx, request.(GetSubscriptionsRequestObject))
+		return sh.ssi.GetUsersIdInsights(ctx, request.(GetUsersIdInsightsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSubscriptions")
+		handler = middleware(handler, "GetUsersIdInsights")
 	}
 
 	response, err := handler(ctx, request)
@@ -594,8 +5647,8 @@ func (sh *strictHandler) GetSubscriptions(ctx *gin.Context, params GetSubscripti
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetSubscriptionsResponseObject); ok {
-		if err := validResponse.VisitGetSubscriptionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetUsersIdInsightsResponseObject); ok {
+		if err := validResponse.VisitGetUsersIdInsightsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -603,23 +5656,18 @@ func (sh *strictHandler) GetSubscriptions(ctx *gin.Context, params GetSubscripti
 	}
 }
 
-// PostSubscriptions operation middleware
-func (sh *strictHandler) PostSubscriptions(ctx *gin.Context) {
-	var request PostSubscriptionsRequestObject
+// GetUsersIdOwed operation middleware
+func (sh *strictHandler) GetUsersIdOwed(ctx *gin.Context, id openapi_types.UUID, params GetUsersIdOwedParams) {
+	var request GetUsersIdOwedRequestObject
 
-	var body PostSubscriptionsJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostSubscriptions(ctx, request.(PostSubscriptionsRequestObject))
+		return sh.ssi.GetUsersIdOwed(ctx, request.(GetUsersIdOwedRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostSubscriptions")
+		handler = middleware(handler, "GetUsersIdOwed")
 	}
 
 	response, err := handler(ctx, request)
@@ -627,8 +5675,8 @@ func (sh *strictHandler) PostSubscriptions(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostSubscriptionsResponseObject); ok {
-		if err := validResponse.VisitPostSubscriptionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetUsersIdOwedResponseObject); ok {
+		if err := validResponse.VisitGetUsersIdOwedResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -636,23 +5684,19 @@ func (sh *strictHandler) PostSubscriptions(ctx *gin.Context) {
 	}
 }
 
-// PutSubscriptions operation middleware
-func (sh *strictHandler) PutSubscriptions(ctx *gin.Context) {
-	var request PutSubscriptionsRequestObject
+// GetUsersIdStatementsMonth operation middleware
+func (sh *strictHandler) GetUsersIdStatementsMonth(ctx *gin.Context, id openapi_types.UUID, month string, params GetUsersIdStatementsMonthParams) {
+	var request GetUsersIdStatementsMonthRequestObject
 
-	var body PutSubscriptionsJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.Month = month
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutSubscriptions(ctx, request.(PutSubscriptionsRequestObject))
+		return sh.ssi.GetUsersIdStatementsMonth(ctx, request.(GetUsersIdStatementsMonthRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutSubscriptions")
+		handler = middleware(handler, "GetUsersIdStatementsMonth")
 	}
 
 	response, err := handler(ctx, request)
@@ -660,8 +5704,8 @@ func (sh *strictHandler) PutSubscriptions(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PutSubscriptionsResponseObject); ok {
-		if err := validResponse.VisitPutSubscriptionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetUsersIdStatementsMonthResponseObject); ok {
+		if err := validResponse.VisitGetUsersIdStatementsMonthResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -669,17 +5713,17 @@ func (sh *strictHandler) PutSubscriptions(ctx *gin.Context) {
 	}
 }
 
-// GetSubscriptionsTotalCost operation middleware
-func (sh *strictHandler) GetSubscriptionsTotalCost(ctx *gin.Context, params GetSubscriptionsTotalCostParams) {
-	var request GetSubscriptionsTotalCostRequestObject
+// PostUsersIdUndo operation middleware
+func (sh *strictHandler) PostUsersIdUndo(ctx *gin.Context, id openapi_types.UUID) {
+	var request PostUsersIdUndoRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSubscriptionsTotalCost(ctx, request.(GetSubscriptionsTotalCostRequestObject))
+		return sh.ssi.PostUsersIdUndo(ctx, request.(PostUsersIdUndoRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSubscriptionsTotalCost")
+		handler = middleware(handler, "PostUsersIdUndo")
 	}
 
 	response, err := handler(ctx, request)
@@ -687,8 +5731,8 @@ func (sh *strictHandler) GetSubscriptionsTotalCost(ctx *gin.Context, params GetS
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetSubscriptionsTotalCostResponseObject); ok {
-		if err := validResponse.VisitGetSubscriptionsTotalCostResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersIdUndoResponseObject); ok {
+		if err := validResponse.VisitPostUsersIdUndoResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -699,21 +5743,137 @@ func (sh *strictHandler) GetSubscriptionsTotalCost(ctx *gin.Context, params GetS
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xXzY4bRRB+lVHDcYidBYQ0N2ARygEJsdySCPXO1G4mzF+6eyKs1Uix95ADkVY8AEQR",
-	"LzBYtmyytvcVqt8IVbf/Z2blhDjZzclWT3XXV1Vf/Z0xP42zNIFESeadMek/gpibvz+AlPwUfgKZpYkE",
-	"OspEmoFQIRiB2ArQX9XJgHlMKhEmp6woXCbgSR4KCJh3fyn40F0IpsePwVescNlRfix9EWYqTJOqCj+V",
-	"au39MFFwCoLuBVzBd0lAH+E3HmcRfQ+44s5JKmKunAes/dVnB+2DLx8w5m4DtPePFBfqrV8IjXIryzyW",
-	"52FQJ5bweAcXGSnX2mueXkdY57efU8Wjb1OpmuOjFiJ1HtzSv5KtKiPZMDlJ6ZkA1qLF8BUO9TPs41h3",
-	"HRzgpb5w9Dle6WdYYh8vcYhTHOsLB69whgO8IkF8jSVOcEzOCpXx+jH3f4UkcCSIp6FPjngKQlodd++0",
-	"77TJ4jSDhGch89jn5shlGVePjKUtHkX0ewrGVnICJ4j3Auax70F9HUVGXPAYFAjJvPvbptw7tCAv9Qsc",
-	"4Qz7WOoeDskkCgiJPMlBdNgiojZINl92IELxkBxuQ2UwH7TbluGJgsTA5lkWhb4B3nosbTqs3g8VxObi",
-	"pwJOmMc+aa0ytzVP29ZGNhVLEFwI3rGBrATQxmSGrzeDtDyoc0jhsi/eEP51qLcrTR3QP3GIfcO2qf4d",
-	"/3VwhKUh2kx3DZtlHsdcdEj2pYF9rp9b/uHQ0d0V9yp2mustueY6aakegYIqnw7N+dGG+H7ItUpQJXJ4",
-	"E7K5Z1X/lTgixXOPbGXkuAHHvC41I3nXNP+/PHm5WWioHA2wnJei8qYz9+81sLVRKtzGIvc+GPn+yt3u",
-	"Va6eBLpr3DjAqb6oaUAfUW0jS1bW4pAeqKFNNh8ENnnzYyorxKFkB6m+SYPOHkO2WVKKCl3uftiyQZ1h",
-	"ZLLxFpSNV2tgDSWmhtSzJirkdUzIbygRPmz/wBn+M3fnLekhf20Bru8jlZGnZVaAXxYb105dZrmEVNvN",
-	"O2gh7tl1I0nzCNJwT9IudcjV9fPMW6yCTQohCfaibp+ttbpW1m4N+hwnOMGSCIklbX1d3cMZjnFClNQ9",
-	"/aJmzL75nXWgu/q5/kP3yKB1G6mUbtuI45qVaWRnC1I5pm8Eq/gvAAD//+bUtrNmEQAA",
+	"H4sIAAAAAAAC/+x923IbyXnwq6Dm/y+kymhJbewkVioXK8l2tsrr3Yiyb+wt1hDTJMcLzGBnBpQUFasI",
+	"wlqti4ro3TiJy7G9u+WUfQtRhAiRBPgK3a+QJ0n1190z3TPdMw0eIGCtGxYBzKG/r7/zqR87zajdiUIU",
+	"polz67GTNDdR24N/32umwRZaQSn90ImjDorTAMFPgU//rkdx20udW063G/iO66SPOsi55SRpHIQbzrbr",
+	"tKMw3aRXoodeu9OiPy7//Y13l9/9ru7qpLuWNOOgkwZRCG/xUfbZueXgr/AEH+IzPCI9fIxHDXyGJ/iE",
+	"PMNHeIIP8IDs4iE+IfsNPGqQHh7iUzzEr/GY7OEhu1i6223ggwY+xhOyiydkh+yRJw08weMG6ZOneEB6",
+	"ZBcfkD4ekl3HdYIUtWFJ/z9G684t5/8t5Vhb4ihbWpHW72xnAHpx7D2Cz1HqtTRwfYOPOSDHeGKC6hn9",
+	"4QQ+jshuAx/hQYMCSHpkn3yWozMIU7SBYmd723Vi9Gk3iJHv3PqZAxvE9qOIabGyj7OHRGu/QM2Urvm9",
+	"Ztr1Wnc2vXgDlcnAa0fdEMijHYRBu9t2bi2XVzIdHRTWLZbMX6VdYxi1vdaj8vKaUbjeCprp+74G61+y",
+	"jccDss9wnhPHoIEPSR+/wCd4RD+SXfrViH6klw7JDsU7HpKneIjHeET2HbeeGWSkn4+UHjsopDj+meN3",
+	"O62g6aXIcZ1oC8Utr+O4Dgr91TW0HsVoNUm9OHVcJ4zC1U6UBJSTV5tRQr9LUJgGIWqt0ut9+pCP63YB",
+	"fi2AoN2Krh+k91Azin0NtTTTKKb/lFDjracoruX4AewT6eETys8jfAQMPs72gD6Ivv99O+HEMGXz1kM8",
+	"MbyvGSMvRf57qfJGitMbadBGutf6KPWCll6+DSg9AlfvUpFEBRIjtwH5DI/wiN5PMeoJGsr5Sd6a1W7H",
+	"91Lt2+mmooSjqJJELbHYTVBsdWmBosROuZws5KXJQMo41lHc7Rh5n/jRg/D9FLW1NNfVC13Sx6f4lG7v",
+	"AT4i+4LHjxrkl3iAj0HOPgU+p9L5JZ5QncKIYsAIwG3QW8acGHNp/BSP8QS/boAOmeARPqVUSx+YY2Ut",
+	"ilrIA97OhahxifRusoMnXHEdgpZjeowSJz5uUD1GdvCIfJZrDDymws3RCWQ/SJr0rZabHPgGNtHoKZei",
+	"ZCCpVo49fAbrewLMNSK7FFUyYAMbGfpJEPqyHFQEUg6WRqBNa4yEXhtpeSQK0f2gjbT7lcEC+0HJgPQy",
+	"WPFxA9ib4YsSCKidY9LHZ/gYD7XEMTVHGtRn4TkuswcASI7VShWbcdk9lHSiMNHYApmJZGUrqWxbZSzV",
+	"2TXwtioT5nbX39DZsGvwvR6pMfL8D0NqU6RxF2nIoxW0Ax3P/jewO7XQQGr3OL1PqLF5oBpsmcl0U8eh",
+	"STPqoMoXMDY7oIaIzrrlTMj48iUw4og8z8wY+BlYkdq8PdAyB/R2sCMYdzGcug41NDai+BHYDvFW0ERa",
+	"BkupkahDyu8Kq9iXV4GP8AGTqHioLAQPMlEHyPgnWA4XuEd4gA/xgHzBmEvHwelmjJLNqOUbfAkumUaw",
+	"MVQ4TchnVLdTTQDOAMXxiUA3rAZEmNYExCNJ4oEzQXp4go9gkc8zaYcn+AXZA92y37hGd60BQp5K0qdc",
+	"rzxv/MMydWFuLi9fl12PanpR+afAJIyYBNWamWQl9dJuYmKVWp5mV22DHEdNL9HRwlcc7VRFHmmYZAzq",
+	"jpp41AYnz8mv8Ai/VjmnDL144U/SoBUkmXlU+e76V9lShqy5/Ki71pJMr7DbXuMc3UFaNf91jgKyxxw7",
+	"4JZj0rcDvlsJ9DSPv0yACyTISUigQaIRFQD9XupI9o4XNlFL9pTuMQuyTL/UGv5+aDBiBAkAG79mNjd1",
+	"tJ/iEUimIT4lewqa3IaJc1XMOq5ka/he6jUY1ho/F5bHzx2zuVVrDBlNlBh5SRRW/HQn8pGBP0YA91iY",
+	"MODxkD0zxFG6iWJFZ0Sr6GEHhUmwhcABTVe7CX276yQPgrS5ifxVoUWoEkzoj6vryEu7MQJNjpqbYdD0",
+	"WqtBknT5V+1OFHuggdgLaz1W2b7h+NATUeq1oo0PvLS5WaabpGn0EIGjXgFF7JJnjEWWmat401IecCTU",
+	"SFW+whV+dUm0Z6hki60AciV/YcFJagVeUrDiyuq0YKBlRoHuYh+te91W+lHM31cWWoJ4y6YCiOex4n0N",
+	"6ywFbbyFgXtOA+8BWkuCVMdfhQ0AQLRYjzajWCOMMj+kAPrvcyk8BvEzoGJINe3A1eP+KNkhu2RPkTHn",
+	"8WdiGo8IkU44/gXeeYIndYtwDVKTmkFPmbGEjxo/lkQoHoKqycM5MsjXQAIdgijtNf535zeNHyuWUJ31",
+	"A0ChkIFRguo3IMH25wwmC4FRBDIJ/hVNtWtutiQKIHmiuiSyB2rytrhAzbxKugKJhGTEa1kCQjmqyjb5",
+	"k22UJN4GMsSpNjZQUpEn4NK5KCjIHg/V8KAuxRJ+Cab9kPT0LgnzN+i3r2H7xnhimxBQlEudjS7g1eHt",
+	"rohrlC2bykBOrZRDoX/XS5GdYZSbiVnoCdIwB/gYn5DnlE2k0NNElUzfM0omEdNRF9BBcROFaeOG/LJB",
+	"Y8trdVHRRp3gA7exHjxE/mqHahx6E/yW31DwvYW1wt9CFXZ+uzl6lGjFyYkKN7cICjiSGW1AnkgUx/dA",
+	"dWzxuDpC4DoP4iBF+eZSrqAxf+N2AhMUDFllkWYb74YsyialYIOtCoKtqEsWFbiCh6bYrTrW+GEcdTu3",
+	"H2lA/neyA6E1uk7q9x0AAZU9Tgp0MVCCR1mIAn6GkDy9CJ9K1CPFRVJvQ0s2sLz7Ipal8u4G/U1va1kG",
+	"v9gTqoJfHyCqPe6itbQqe1dWqNqI75+yzCiVCMeuEvKghEUxekKtYjzO05XAGOAQHZO+TZDXaKpcNBgK",
+	"l5QioVynVUQ/P0DxBuLWc2J0MpOoGze1ceFcAZVRdgYa6ARPyK+ohVERzjJF19THH2jewIwVJpr2RTS6",
+	"zMTVyOPgZQvRIwp02HmU+hTa8MMHyDe/wkdrqX0cWuKPCwSh2Tur+PAjFAeRfydqd7w4SKrsHs/3kW+9",
+	"ftmWurPphRtIB8eal6D7JlhcqADx4qorfNRKvTLtyTc2bjTy1+jCVFzX8lWWnoV/qyZYqTI0R6OyV7nw",
+	"mfRIn+zKRRtZsHZM+mDG0JTLqbJEC5M7Ru1o69K3I0ZgZ1zuY4vxNglQZX/FZrqc0nIgpYVpSZj+ZKy1",
+	"0Ftx3lpE6w6YHSybZUXxQxMZe+RzPOSXkD2hkyUjnT2t7cWfIGoYZrcA2dBMxoDdeAia/yXNNhrKaWhh",
+	"kNtoIz/wwtVfdNsdurYdZrspjxOpXLEoNeygKcMRtoECuePyz2ztjutIr77KZGVHBGDKqUo5M02eaTaE",
+	"2z8qMmi1TLaJtciw4TAa4NWs8I88f/p5Jg2YaY+PGzFaRzEKm8jyDdnl5bf8WZBj2SGU4R7Y0pQWawMh",
+	"xT7nj58ozoAdFDMszeA2d8HXZ6Qko1Nsnk5Y/EvXawXpox8ED81B+fjRvW5oKCw4Bi98wDwpCLVQMU6e",
+	"gQ7YbQDFnUE6/QAwLwoyxszjn5An8OOY7FNbB+x6TX69qMbZiurgMVoeGUDlPD64l1y9BxRQr/WRcrNO",
+	"5xYioxPwNJ+ymDPVZzo0jMmeoFAltgWu3S4e4TPS5/QIBW4Q03I0EMeo7QUhpQ9TIi27vWDVDpnSfUX5",
+	"Ny95eEF2+H7iA8pLtEyGZt1sgylC89QpPoZpef1u1cbeQ2vdoOX/CPkbKD5fAsngMp9VRVAyR5OiZsyK",
+	"j16RPtnBYxEwNGfp/qZx893ritd9812jbqBLX4FqQFWb3DTeYaj2KXlzboM8Jc/wSKW2YyVDzoJCZA8f",
+	"5Hnwdxr4P/JomxzzaNwwFTTU+41FXs7Attj188QgafwlRaE2h6HhVApjXj025iUComKosPn1gVixsnwd",
+	"ejCpEOeeq5G41+OobQhD1DtqcDNcqlsAf/WdzW4caovzaJKwttLbFIrnLkJ1BNt1vC0UexvoR8E6Sk2V",
+	"WzuMTZm6YNJRBDO0wb0+GCaH5PPcIFDEbTHeZ6fkmxRPyLepfX8B6Qcq+MGUE0uWdSGrnSnWuEsy6Kwq",
+	"+c2VCX6dL/ScFd1VySd9tFKkaApoJj2+TyNzbqZMEFYmIk0QJxfSz3napZY4zvJySJZsHxaS7d0w6aBm",
+	"sB4gH1JFysW85pT0hZFEvytr8OrMDWe9nOT4XpS5JcdOFYPzSntTVGxleuuVhZlWZmf28vtc/ZINC7LA",
+	"SWLWL6JBwT5eVcS3JrowdYzNldZRAU+WzC8IcUYvljlM5vdaXmwVCE4s4nOc+NXbMlLP3PEq6O+hLRR2",
+	"UUU6/6Jp+Oz50+VgxY2Va4dk0fnsHNZL4NvaOVmbBPnC6I3IQjkI07/7zjT2jlhPBbycR9dQrKllrCMq",
+	"cZcNsOY4AOk1RBcBPuBBpIJNO8Gv6+FWKRfWpgc8lTu1jKaesdfgf0o9DyO134GHfnp520Qp2jNFWq8i",
+	"2bLSQaG/guIAJR9FgS7nbc6bTceLttF9wWnm6L60ZjOTdSg0U0j6Ih7qHF/+fO36Ui9FbaRDJs9hBsh+",
+	"ZVJOU1cXZorvfw3Wypj1qACDnDFzjuxB/S31KU/VcppTre1pWR7ZCsIpgMpQ9KMg1Abtp6OtToy2gqib",
+	"3Df0fP6W7IpQ4BEe6FBRW/Zr3SEhd34ypLjytrtZnb+66GwrKykK0FXRdjVFv5OEk7NymQGN60FzLq35",
+	"h1yOXLygxVBliSI0Q54vMq5KvszRgsgFdVP6ap6cKofKLixDT7CoeeEBaNA1z41dz25FD5gISy9rl2HT",
+	"6lTexo73SNjZ9aGic8RzXCfZ9GJUV8Cn7f8+ZN16WXucFuyk0wrSe92W0liLPu0yVmDJSmZysJDix+7l",
+	"VySYihEEcjmZClxIJJPxkSuYLd8vLcMWuo8L/NpNo3soRA+Mwd5DnkQdaHiC9PNAxCkeFMwIxswT4IOx",
+	"CJGCeSF1/pXpqwnl/S2o/xf16rMvMq+toJOXeS+ru7e4LRdN9d1SRQl0bSvwUeQ22t0kaLqNZivq+m7j",
+	"nXfeuW6OrUJS6wWNy4qmqXJ72H4ppaerltTxqpCmGiGXh87P1QxhiF9P8wTUQtVN2rXbhR52gvhij7C0",
+	"WvI8OlTQO7ecGDW7ccwTGUWTaohfQmJsnwWPtbMMmOyXek+zy0Tv6UDO4MDPpC9H7kVdEs0gSZMq1FCb",
+	"+shR41q2c9elvLgMTRSi1VQVWJItD5U5SX0ZWmHah87VYrWNGq10kDs5EJkDljnIm5QbeJTfCZ9ZJ/eA",
+	"7HK9MnX9Bqs40lmYZs9U7mQoIOO/RF6BPCsxqsrNUCXLy9yzzuzXPLaID8i+iCWWSEijrGvJXdGuGo1C",
+	"M5YjFi5lhHnIfecxl0PF3nmgNfyK2sfazQST8FrgX6d7VjLB6FSFkeNenZ6vRUjqbSRG7TqCknPGWnxe",
+	"jSg5HelsJ/s2nRTF7Q9MtcvfiOxTuRrHXK9cEjBMkZOn2byBqk5VfXyDWzmAyerMnaYUqtyu6iXojt68",
+	"/700o2GgoTNIT1M9mffpn4p05gjg1ueY7CrsLrQo0uNsM85THrbLq6/ws036XqCAt6JDz1itVkcCP2hF",
+	"DzRBjW9rHk1voOt6/8HQPqApKLbO6Xt9RMiAvjTPE9VtCFdv550YVuvzaUtCr+Vi/Hpm7+Shymsg2K9n",
+	"WwnC3zhIYIowZlCPj5+iONG6XBcZB7XltQL/Bzxtbzd2CG65b6i005TPkr7czAylbQfgPPSo8+a4Vq+t",
+	"8HYdGQodEoH/qcA0R1QhEHxZcctUvM8ipJZfq1157IXJOop/klRUFBm7BYxjdeqKbdQCf6sAS0pzvhs6",
+	"J/QvVNQ1uFHGy/B6yuQLMDbZzIRTsD/Lrgf9oWLSCK32mZBdpdbPNBPjupuZ3nCzNgjBcCoEQP31LKP7",
+	"jw0m+/NZcBI68+k9vLFiBx9RQEhfMibXvYAqrk8Q6qxmbRLwqdQrYdHPYSQAxXA/Jf3CUqtaO6aMSlm0",
+	"eqhEfp7EYlZZb5dpE5DSLy+WWnSdNPaSTYN9oCPwoRavrmFZvCypSC3lVl9j6YNNn6hAYA5NeZvovUG4",
+	"HlV3DWUDxfrlklotT9N3BikYK2te8xMU+o082LYlFJ5z853ld5b5yLrQ6wTOLedv4SvX6XjcIVny/HYQ",
+	"Lnmh13qUBs1kqSlKxThzZJPgqNvl/BCl79Eb3hPXs8oy+sDYa6MUAgY/e+wE9P2fdlH8SNiYt6T2SBmh",
+	"zFtjKuKcEaZtV/9C0Vw7o9eJVHH27OJ9EM5knAq4f3d5mRehpDw16HXYWMkgCpd+wUOZ+fOmKlCBfSnT",
+	"8rarMz92pbooSmOv8CEXcUr3dp+nXvglhWZW+rbvTAlSdWuY2symW/wfICZOVzhmq4EyT9ZJApybdNtt",
+	"GlIuAapWhI2UEi+yp9SDMcSokVnq9o0UHx2fwgtLDLXOPSQ7fgJ/6q+LnWbDFkWf1YY1/kAtAKZPRkpV",
+	"oaZAeqFZYzpAz/DEivKhY2XVg74B4TFYMYHU6cYS5G/Z4ZLZQekltGGFb8Aae8oIoNDuV6YHNSRGsxtH",
+	"3JA+hX9F52Bmr+VNhwfFcM6wUJQy77z0a0iBnEIWANKsAJqYo6HDYbHHUedkkh57qYQ46Sb6bwUPTst6",
+	"iTNDSwXeaEWDf+b4ITtq2q2q46qgtOedeAqNENXtnwYIdWSgzGOyo4R72S1vrXsb695wH3uncmeeWgZi",
+	"y+MY/GMz2XI+fkOKgQ9N0znBKXqYLtG1KU8trrJM/7+H0NKOqDvs06AW2cGv8jHe8hTqfHp1NgqsP+9c",
+	"+ycVJDxU1m8QTblOo36VNKrMxMFZfbot/7Ib3lpPV6W5BIat7Ke8brt+TH2ZYrSd/ovpa3xJ9nj78bFJ",
+	"h9m61mnUEVVmU5g496POiripxBymmZBTjJzVkXs2sO8qeIlNldZqmJvLrtP2HvJqgOXlmtqAWXKP3CRi",
+	"54EoYwT5brzgBVJ8L57yJkC1hoZ+zcuA593+U4CkRj/tSB7hFywnAS75aQ4mVB9IbS4lS1iGXOIgevZH",
+	"PcPAVVbqQxwhMrV1JJ8zMvXNecNgdmdtnsWg/oplwBd+Im/L1jynMl2rf1ganetRdpMSqFTguficyob0",
+	"62XHtZQ35XStYbvX1xNUc+tMZJB8TpKN8Mmq/PCoMCqggQdg0oojCibsZAiIp1FmPG7wgkU6mWPu/U+a",
+	"+4SQA7dRJVBJXwFVlictGOOwFLOhDqzXKtFIlo+ihIkWMfeBXZ8dPXQ78h9dGmq0g0W2t7eLFuf2Belt",
+	"ijVUbNJ/5oguTe+grDr/hJMtmZbyFJlEPbqJP0uJEsr09Ckb9lOrofhQoJlEq6YJlv5BGlYsTj8sDPyZ",
+	"9+3kE1vp1cfaIehjzSl9/JwCzfl8Dd4PwZLy9HcwWjhyDsVMFjzUkMHSevDQQqbkE6KuSKCUR2rNWJpo",
+	"ZmDp9vlry2ld8x9I13c0wXEEGohKbNYgPZm2TjWOtUxuwpNcaiNx2GY1xQkvEsbTXhHRaUffzpjuDFMM",
+	"dPv7lak2LpP+8yj76Hq+d9noKo8f0S2sPHRVOreqx4KH9hVRZXeSFnLta4/sYNwhpipSRxIKvUQTbeHR",
+	"dISSjldiJIrNLZmFjcS6MptPM2/rLbe85RYrbuE2LGUGnlQ1HXczFa/QMEWylPJ6TQtWoSWdiajvvCJO",
+	"0dVIz5hRtBWsU7HJmG/0WzbRFtGysuQrYRH25LJXMIGSjXyIhGE8ANc6oooaBoWTnqSsRhDWhOX1xREj",
+	"nKdarUq/sNWqC+q/f9e4NEPAK5g6JFg865xTSV870MItxHBZ/TL5Nz5mo9A1pFugl3y47ixcHZ5l2F/N",
+	"m5YSqdp9XIAw2wnkwCQ9ph6zXvZSbDJdUmJrBrUChZPxLHbzd3KDdKk9evG2rbbjm4JkVvfKdl2+ki9u",
+	"kI1+v3mlb69ugpjgF7lDP+/E8Bt1uXorsUAgKiMvJciLm5s2/LzCrrRKxn1aWVRx7pxylkb+7lxkkWvO",
+	"dtNakcZT6RYnLVOUL2UaYwFVsLNEU/UhtEPTrq5xHvxTKPFxNuJim9UPtFCKyhR5F77PiZLfY6BL2mQk",
+	"5Vilq83kWWdbfXyFDonNrskSC5JhNDP2BR4vQonaoEJSaecMbbsWgunbRQPTKi0T3hbO+rSihk5XZ8h0",
+	"3zw1zIft9OZEEdgiLGK1EOLoj/lyrY0nkRwsDZ+u0VbK5VcTGzg/9bqPdclj5fxbzfQhc326veH3pjXp",
+	"V8UpRn1JRQ0WTZ/C6VJQp8fqehswJyhrStcORavUrzOg2rcRrYsEZJVAlsHfyLrY+LkmBZL/9oSvSj17",
+	"emqviIQU6P0qVHp5y2YYDDGfvG4lHLMY/iIIx2+kxfIj3NkoNwNVmKzKuaSJN6slZUNvMTSlztTTpplU",
+	"w25pLUbeJ370oLJlUSGR29kdVnGy4FIKvc/bF/i248qCFrMdrXaEsu7BUel4+Xnnjq/z5ZI9XlWpnywh",
+	"1cpydcvAZvamq0zjF5O9DsUQel7+Jjda0i90fMcGaleXKShsd4fdcFVuOH24qjffSLXC+YS1GCKzIKI6",
+	"W+5AP5KWjZCns6Y5n7HDIKSMvzT1QUtcbLaotUi/w6+fM4Fedtl58+5EHV2rQYre3aHzV9nM3SvQBpr5",
+	"btQ1/ewCa/1+6M9gpTJeDdN3rVfNKW/2SL6khV8Fxq9SdX+E4iDyGf8GSVSnwQWKJAsxR80iNG6WICha",
+	"IlyNH1IasJCUSQeFcIZGzeQkRVxKZ1a9tYHf/MwQmI97+5HjWhLlD/n1Vxy50hyWpu8jUUxTzZSna9k0",
+	"sJdQ0XeGz+j38qx66fgT+aoR7y86xoPrixDtkmY2kL0Cc9vb7To+h1HGq+L4FisuzyYzv+XxtzxuqK0u",
+	"De+uHEqSHV6jO/mgVIw4/+x6mPWh5lPoAUbbeStWrPtYHRuwvcQOAptCXSv3v8fvtkqdFwcWvLlqCrvu",
+	"WelwVKtqLc15qHKV7GAxWhg1lRa/LANGnhQA43Hy0gmM0xDh0mOYObM9ZYJcS5If8Ok1M6VLV/uC/NTl",
+	"etluPMhjDqrI5N0eqrnvycLVkpVpmvm3RXYtnVlsm/z5K6LKK0hx6c+mnsuKJpUtGBFmX809Y8hnwZ2b",
+	"KWjlCPuwK3tc5mlxVlpBnFt6XuPkbnb/t9E8EdBZj0IWeRZdOnvhSj+zY3ovUrMxT+Ry+TI0J5DZlo2o",
+	"7606o7rQPYMHC9o/I/EWP0bojJ23gyc36qOmZrm39Fj8a9fjYEHbd7MHzocV4svrWeQWi5ysF7soVCZn",
+	"K2rdDJKUH0p9Dh39z/zub2UA4WoG9un9bD7GLx/cxwb5ibF+8z/G77fMShTHl48KYzNeWxVglagzRpS+",
+	"0BSlISp93uP3Lxh9XnpVyAFY7j022G+RSvm+NCxcDmFU1LtnfUX5uWx7VoTHjzQ7r+/yU3H77LV0ZdV8",
+	"4TB3cbonee6ygx9fi+P3oGzggHxO+uR5XkhPZ3mcstwUHZFBj24kfe4evlbOVTcfHj1ii3hJ904MgSV7",
+	"tODiuqkwP51+Fu3MJ0vwHbfSFF/mp6pqKHax9UAVbFAxOCFPoHBhLJ+dKKqCXkvT88RIIzjrsIoP78MF",
+	"89ZmNf+zTVT5qT9bc/GCC/VAVTTBUHpjw7MeByyxEWxVlg7C4CyID9MLbaT9pXfvXdaBAT5LYc9vfRdD",
+	"8grShyZ+Xez+KpsC8gHhmrzAfB9h0aMwDWqBNE7AYtNz8YCdblMg9LWuv4HSxILSb/MrZ0DqM5GgDB4r",
+	"2fkFeY4P8St6VjrZM2J68UQmfpEDxsoGK0Azu2FviEAuP/IqSGK2cVf5rWbCU9ryFq4pTya0gUkILSWp",
+	"l3ankEUr7Ppvl0TiQNnZdBMefNk3cPSCtSRlEfiX4Pccsa6W7HB+0WGEJyVA7U07QWyP2T9WEXqV7G7z",
+	"G2dk9WkeupavYHFjVYpwW+DxRjXCLQiTYGPTysR6X1y60O7E5Zw/Np9HE391jqNyF6CE1caPID0JKvAq",
+	"8GnejccRUB5SKTKr2fR38sTwDhqFLHFP9AD5FpzzIb1shuL4bYP19JRL96iSbGmW/gS/ZMfCP2UjpEHG",
+	"LshxesdSiL0EwQhmTkEA/bUxPHUIGHgljjo/ydjvGUjcY9Ln4YtStbjEMtSKRm2AVarLrWGglewm+1LH",
+	"YH7LGy/3gGTX2Uzbras4J7kyxCu2ZIoTkfl1sNwpj07+kuzlicMFONjVYrZROdZXYJVu6EfVKWbOHj+h",
+	"Fy6An2ld1mB14EVhpsDEhXwynRgHPWm/wgMIicEw/yGzA46kslR8oJZDDAuH+s0XiWWWypgfJgbwZBgg",
+	"+zSPetlHLZwrp5+XWIxARfR45xQbiqWsWW4AL1RmkP0iUxVHMihDtvSPmCrFsr39fwMAw1I26IHgAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
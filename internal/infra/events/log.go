@@ -0,0 +1,35 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/infra/log"
+)
+
+var _ domain.BudgetEventPublisher = (*LogPublisher)(nil)
+
+type LogPublisher struct{}
+
+func NewLogPublisher() *LogPublisher {
+	return &LogPublisher{}
+}
+
+func (p *LogPublisher) PublishBudgetBreach(ctx context.Context, breach domain.BudgetBreach) error {
+	slog.WarnContext(
+		ctx,
+		"Budget threshold breached.",
+		log.RequestID(ctx),
+		slog.String("event", "budget_breach"),
+		slog.String("budget_id", breach.Budget.ID.String()),
+		slog.String("user_id", breach.Budget.UserID.String()),
+		slog.String("scope", string(breach.Budget.Scope)),
+		slog.Any("target", breach.Budget.Target),
+		slog.Int("limit", breach.Budget.Limit),
+		slog.Int("threshold", breach.Threshold),
+		slog.Int("spent", breach.Spent),
+		slog.String("month", breach.Month.Format("01-2006")),
+	)
+	return nil
+}
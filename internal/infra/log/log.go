@@ -11,14 +11,35 @@ func ErrorAttr(err error) slog.Attr {
 	return slog.String("error", err.Error())
 }
 
+// Keys of the request values in the gin context. They are read through
+// ctx.Value, which gin answers for string keys, so they survive contexts
+// derived from the request one, such as the one of a database transaction.
+const (
+	requestIDKey = "request_id"
+	actorKey     = "actor"
+)
+
 func SetRequestID(ctx *gin.Context, id string) {
-	ctx.Set("request_id", id)
+	ctx.Set(requestIDKey, id)
+}
+
+func SetActor(ctx *gin.Context, actor string) {
+	ctx.Set(actorKey, actor)
+}
+
+func Actor(ctx context.Context) slog.Attr {
+	actor, _ := ctx.Value(actorKey).(string)
+	if actor == "" {
+		actor = "unknown"
+	}
+
+	return slog.String("actor", actor)
 }
 
 func RequestID(ctx context.Context) slog.Attr {
-	id := "unknown"
-	if ginCtx, ok := ctx.(*gin.Context); ok {
-		id = ginCtx.GetString("request_id")
+	id, ok := ctx.Value(requestIDKey).(string)
+	if !ok {
+		id = "unknown"
 	}
 
 	return slog.String("request_id", id)
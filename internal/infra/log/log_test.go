@@ -0,0 +1,46 @@
+package log_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"ef_project/internal/infra/log"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestValues(t *testing.T) {
+	t.Parallel()
+
+	ginCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	log.SetRequestID(ginCtx, "request")
+	log.SetActor(ginCtx, "operator")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		requestID string
+		actor     string
+	}{
+		{name: "Request context", ctx: ginCtx, requestID: "request", actor: "operator"},
+		{
+			// The Postgres provider runs transactions in a context detached
+			// from the request one.
+			name:      "Transaction context",
+			ctx:       context.WithoutCancel(ginCtx),
+			requestID: "request",
+			actor:     "operator",
+		},
+		{name: "No request", ctx: context.Background(), requestID: "unknown", actor: "unknown"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.requestID, log.RequestID(test.ctx).Value.String())
+			require.Equal(t, test.actor, log.Actor(test.ctx).Value.String())
+		})
+	}
+}
@@ -3,3 +3,12 @@ package pointer
 func Ref[T any](v T) *T {
 	return &v
 }
+
+// Deref returns the value v points to, or the zero value of T for nil.
+func Deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
@@ -15,3 +15,12 @@ func TestRef(t *testing.T) {
 
 	require.Equal(t, value, *pointer.Ref(value))
 }
+
+func TestDeref(t *testing.T) {
+	t.Parallel()
+
+	value := "some value"
+
+	require.Equal(t, value, pointer.Deref(&value))
+	require.Empty(t, pointer.Deref[string](nil))
+}
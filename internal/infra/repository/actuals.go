@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ef_project/internal/domain"
+)
+
+var (
+	ErrUpsertActual   = errors.Join(errSubscription, errors.New("upsert actual charge failed"))
+	ErrReadActuals    = errors.Join(errSubscription, errors.New("read actual charges failed"))
+	ErrDeleteActual   = errors.Join(errSubscription, errors.New("delete actual charge failed"))
+	errActualNotFound = errors.New("no actual charge found")
+)
+
+func (s *Subscription) UpsertActual(
+	ctx context.Context,
+	connection domain.Connection,
+	actual domain.ActualCharge,
+) error {
+	const query = `insert into subscription_actuals (subscription_id, month, amount)
+	values ($1, $2, $3)
+	on conflict (subscription_id, month) do update set amount = excluded.amount`
+
+	if _, err := connection.ExecContext(ctx, query, actual.SubscriptionID, actual.Month, actual.Amount); err != nil {
+		return errors.Join(ErrUpsertActual, err)
+	}
+
+	return nil
+}
+
+func (s *Subscription) ReadActuals(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionIDs []domain.SubscriptionID,
+) ([]domain.ActualCharge, error) {
+	const query = `select subscription_id, month, amount from subscription_actuals
+	where subscription_id = any($1) order by subscription_id, month`
+	var actuals []domain.ActualCharge
+	if err := connection.SelectContext(ctx, &actuals, query, subscriptionIDs); err != nil {
+		return actuals, errors.Join(ErrReadActuals, err)
+	}
+	return actuals, nil
+}
+
+func (s *Subscription) DeleteActual(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionID domain.SubscriptionID,
+	month time.Time,
+) error {
+	const query = `delete from subscription_actuals where subscription_id = $1 and month = $2`
+	rowsAffected, err := connection.ExecContext(ctx, query, subscriptionID, month)
+	if err != nil {
+		return errors.Join(ErrDeleteActual, err)
+	}
+	if rowsAffected == 0 {
+		return errors.Join(ErrDeleteActual, errActualNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ef_project/internal/domain"
+)
+
+var _ domain.AnalyticsRepository = (*Analytics)(nil)
+
+var (
+	errAnalytics   = errors.New("analytics repository error")
+	ErrTopServices = errors.Join(errAnalytics, errors.New("top services failed"))
+	ErrRevenue     = errors.Join(errAnalytics, errors.New("revenue failed"))
+	ErrPrices      = errors.Join(errAnalytics, errors.New("prices failed"))
+	ErrFlow        = errors.Join(errAnalytics, errors.New("flow failed"))
+	ErrChurn       = errors.Join(errAnalytics, errors.New("churn failed"))
+	ErrChurnReason = errors.Join(errAnalytics, errors.New("churn reasons failed"))
+	ErrRetention   = errors.Join(errAnalytics, errors.New("retention failed"))
+	ErrOutliers    = errors.Join(errAnalytics, errors.New("price outliers failed"))
+	ErrMedianJumps = errors.Join(errAnalytics, errors.New("median jumps failed"))
+)
+
+type Analytics struct{}
+
+func NewAnalytics() *Analytics {
+	return &Analytics{}
+}
+
+func (s *Analytics) TopServices(
+	ctx context.Context,
+	connection domain.Connection,
+	month time.Time,
+	limit int,
+) ([]domain.ServiceSubscribers, error) {
+	const query = `select service_name, count(distinct user_id) as subscribers
+	from subscriptions
+	where deleted_at is null and date_trunc('month', subs_start_date) <= $1
+	and (subs_end_date is null or date_trunc('month', subs_end_date) >= $1)
+	group by service_name
+	order by subscribers desc, service_name
+	limit $2`
+	var services []domain.ServiceSubscribers
+	if err := connection.SelectContext(ctx, &services, query, month, limit); err != nil {
+		return services, errors.Join(ErrTopServices, err)
+	}
+	return services, nil
+}
+
+func (s *Analytics) Revenue(
+	ctx context.Context,
+	connection domain.Connection,
+	start time.Time,
+	end time.Time,
+) ([]domain.ServiceRevenue, error) {
+	const query = `select s.service_name, m.month::date as month, sum(s.month_cost)::int as revenue
+	from generate_series($1::date, $2::date, interval '1 month') as m(month)
+	join subscriptions s on date_trunc('month', s.subs_start_date) <= m.month
+	and (s.subs_end_date is null or date_trunc('month', s.subs_end_date) >= m.month)
+	where s.deleted_at is null
+	group by s.service_name, m.month
+	order by m.month, revenue desc, s.service_name`
+	var revenue []domain.ServiceRevenue
+	if err := connection.SelectContext(ctx, &revenue, query, start, end); err != nil {
+		return revenue, errors.Join(ErrRevenue, err)
+	}
+	return revenue, nil
+}
+
+func (s *Analytics) Prices(
+	ctx context.Context,
+	connection domain.Connection,
+) ([]domain.ServicePrice, error) {
+	const query = `select service_name, count(*) as subscriptions,
+	avg(month_cost)::float8 as average,
+	percentile_cont(0.5) within group (order by month_cost) as median
+	from subscriptions
+	where deleted_at is null and kind = 'recurring'
+	group by service_name
+	order by subscriptions desc, service_name`
+	var prices []domain.ServicePrice
+	if err := connection.SelectContext(ctx, &prices, query); err != nil {
+		return prices, errors.Join(ErrPrices, err)
+	}
+	return prices, nil
+}
+
+// Flow counts, for every month of [start, end], the recurring subscriptions
+// starting in it and the ones whose last billed month it is without renewing.
+func (s *Analytics) Flow(
+	ctx context.Context,
+	connection domain.Connection,
+	start time.Time,
+	end time.Time,
+) ([]domain.SubscriptionFlow, error) {
+	const query = `select m.month::date as month,
+	count(s.subscription_id) filter (where date_trunc('month', s.subs_start_date) = m.month) as new_subscriptions,
+	count(s.subscription_id) filter (where date_trunc('month', s.subs_end_date) = m.month and not s.auto_renew) as churned
+	from generate_series($1::date, $2::date, interval '1 month') as m(month)
+	left join subscriptions s on s.deleted_at is null and s.kind = 'recurring'
+	and (date_trunc('month', s.subs_start_date) = m.month or date_trunc('month', s.subs_end_date) = m.month)
+	group by m.month
+	order by m.month`
+	var flow []domain.SubscriptionFlow
+	if err := connection.SelectContext(ctx, &flow, query, start, end); err != nil {
+		return flow, errors.Join(ErrFlow, err)
+	}
+	return flow, nil
+}
+
+// Churn counts, for every service and month of [start, end], the recurring
+// subscriptions billed in the month and the ones billed for the last time
+// without renewing, with the average number of months the latter were billed.
+func (s *Analytics) Churn(
+	ctx context.Context,
+	connection domain.Connection,
+	start time.Time,
+	end time.Time,
+	name *domain.ServiceName,
+) ([]domain.ServiceChurn, error) {
+	const query = `select s.service_name, m.month::date as month,
+	count(*) as active,
+	count(*) filter (where date_trunc('month', s.subs_end_date) = m.month and not s.auto_renew) as churned,
+	coalesce(avg(
+		(extract(year from age(date_trunc('month', s.subs_end_date), date_trunc('month', s.subs_start_date))) * 12
+		+ extract(month from age(date_trunc('month', s.subs_end_date), date_trunc('month', s.subs_start_date))) + 1)
+	) filter (where date_trunc('month', s.subs_end_date) = m.month and not s.auto_renew), 0)::float8 as average_lifetime
+	from generate_series($1::date, $2::date, interval '1 month') as m(month)
+	join subscriptions s on date_trunc('month', s.subs_start_date) <= m.month
+	and (s.subs_end_date is null or date_trunc('month', s.subs_end_date) >= m.month)
+	where s.deleted_at is null and s.kind = 'recurring' and ($3::text is null or s.service_name = $3)
+	group by s.service_name, m.month
+	order by m.month, s.service_name`
+	var churn []domain.ServiceChurn
+	if err := connection.SelectContext(ctx, &churn, query, start, end, name); err != nil {
+		return churn, errors.Join(ErrChurn, err)
+	}
+	return churn, nil
+}
+
+// ChurnReasons counts the subscriptions billed for the last time in every
+// month of [start, end] per service and cancellation code.
+func (s *Analytics) ChurnReasons(
+	ctx context.Context,
+	connection domain.Connection,
+	start time.Time,
+	end time.Time,
+	name *domain.ServiceName,
+) ([]domain.ChurnReason, error) {
+	const query = `select service_name, date_trunc('month', subs_end_date)::date as month,
+	coalesce(cancellation_code, 'unspecified') as cancellation_code, count(*) as churned
+	from subscriptions
+	where deleted_at is null and kind = 'recurring' and not auto_renew
+	and date_trunc('month', subs_end_date) between $1 and $2
+	and ($3::text is null or service_name = $3)
+	group by service_name, date_trunc('month', subs_end_date), coalesce(cancellation_code, 'unspecified')
+	order by month, service_name, cancellation_code`
+	var reasons []domain.ChurnReason
+	if err := connection.SelectContext(ctx, &reasons, query, start, end, name); err != nil {
+		return reasons, errors.Join(ErrChurnReason, err)
+	}
+	return reasons, nil
+}
+
+// Retention counts, for every cohort of recurring subscriptions started in
+// [start, end] and every month from the cohort's one up to end, the
+// subscriptions of the cohort still billed in that month.
+func (s *Analytics) Retention(
+	ctx context.Context,
+	connection domain.Connection,
+	start time.Time,
+	end time.Time,
+	name *domain.ServiceName,
+) ([]domain.CohortMonth, error) {
+	const query = `select c.service_name, c.cohort_month, n.months_later,
+	count(*) as size,
+	count(*) filter (where c.end_month is null or c.end_month >= c.cohort_month + make_interval(months => n.months_later)) as retained
+	from (select service_name, date_trunc('month', subs_start_date)::date as cohort_month,
+		date_trunc('month', subs_end_date)::date as end_month
+		from subscriptions
+		where deleted_at is null and kind = 'recurring'
+		and date_trunc('month', subs_start_date) between $1 and $2
+		and ($3::text is null or service_name = $3)) as c
+	cross join lateral generate_series(0, (extract(year from age($2::date, c.cohort_month)) * 12
+		+ extract(month from age($2::date, c.cohort_month)))::int) as n(months_later)
+	group by c.service_name, c.cohort_month, n.months_later
+	order by c.cohort_month, c.service_name, n.months_later`
+	var months []domain.CohortMonth
+	if err := connection.SelectContext(ctx, &months, query, start, end, name); err != nil {
+		return months, errors.Join(ErrRetention, err)
+	}
+	return months, nil
+}
+
+// PriceOutliers lists the recurring subscriptions billed in the month, of the
+// user or of everyone, costing more than ratio times the catalog price of the
+// service or the median other users billed in the month pay for it. The
+// median needs at least minSample subscriptions of other users.
+func (s *Analytics) PriceOutliers(
+	ctx context.Context,
+	connection domain.Connection,
+	userID *domain.UserID,
+	month time.Time,
+	ratio float64,
+	minSample int,
+) ([]domain.PriceAnomaly, error) {
+	const query = `select r.kind, s.subscription_id, s.user_id, s.service_name, $1::date as month,
+	s.month_cost::float8 as price, r.reference, s.month_cost / r.reference as ratio
+	from subscriptions s
+	left join services c on c.service_id = s.service_id
+	cross join lateral (select percentile_cont(0.5) within group (order by o.month_cost) as median, count(*) as sample
+		from subscriptions o
+		where o.service_name = s.service_name and o.user_id <> s.user_id
+		and o.deleted_at is null and o.kind = 'recurring' and date_trunc('month', o.subs_start_date) <= $1
+		and (o.subs_end_date is null or date_trunc('month', o.subs_end_date) >= $1)) m
+	cross join lateral (values
+		('above_catalog', c.default_price::float8),
+		('above_market', case when m.sample >= $4 then m.median end)) as r(kind, reference)
+	where s.deleted_at is null and s.kind = 'recurring' and date_trunc('month', s.subs_start_date) <= $1
+	and (s.subs_end_date is null or date_trunc('month', s.subs_end_date) >= $1)
+	and ($2::uuid is null or s.user_id = $2)
+	and r.reference > 0 and s.month_cost > r.reference * $3
+	order by s.service_name, s.user_id, r.kind`
+	var outliers []domain.PriceAnomaly
+	if err := connection.SelectContext(ctx, &outliers, query, month, userID, ratio, minSample); err != nil {
+		return outliers, errors.Join(ErrOutliers, err)
+	}
+	return outliers, nil
+}
+
+// MedianJumps lists, for every month of [start, end], the services whose
+// median monthly cost over the recurring subscriptions billed in the month is
+// more than ratio times the one of the month before. Both months need at
+// least minSample subscriptions.
+func (s *Analytics) MedianJumps(
+	ctx context.Context,
+	connection domain.Connection,
+	start time.Time,
+	end time.Time,
+	ratio float64,
+	minSample int,
+) ([]domain.PriceAnomaly, error) {
+	const query = `with monthly as (select s.service_name, m.month::date as month, count(*) as sample,
+		percentile_cont(0.5) within group (order by s.month_cost) as median
+		from generate_series($1::date - interval '1 month', $2::date, interval '1 month') as m(month)
+		join subscriptions s on date_trunc('month', s.subs_start_date) <= m.month
+		and (s.subs_end_date is null or date_trunc('month', s.subs_end_date) >= m.month)
+		where s.deleted_at is null and s.kind = 'recurring'
+		group by s.service_name, m.month
+		having count(*) >= $4),
+	compared as (select *, lag(month) over w as previous_month, lag(median) over w as previous
+		from monthly window w as (partition by service_name order by month))
+	select 'median_jump' as kind, service_name, month, median as price, previous as reference, median / previous as ratio
+	from compared
+	where month >= $1 and previous_month = month - interval '1 month'
+	and previous > 0 and median > previous * $3
+	order by month, service_name`
+	var jumps []domain.PriceAnomaly
+	if err := connection.SelectContext(ctx, &jumps, query, start, end, ratio, minSample); err != nil {
+		return jumps, errors.Join(ErrMedianJumps, err)
+	}
+	return jumps, nil
+}
@@ -0,0 +1,156 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/repository"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsUnit(t *testing.T) {
+	ctx := context.Background()
+	month := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.Analytics, *mocks.MockConnection)
+	}{
+		{
+			name: "Top Services Error",
+			check: func(t *testing.T, repo *repository.Analytics, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.TopServices(ctx, connection, month, 10)
+
+				require.ErrorIs(t, err, repository.ErrTopServices)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Revenue Error",
+			check: func(t *testing.T, repo *repository.Analytics, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Revenue(ctx, connection, month, month)
+
+				require.ErrorIs(t, err, repository.ErrRevenue)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Prices Error",
+			check: func(t *testing.T, repo *repository.Analytics, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Prices(ctx, connection)
+
+				require.ErrorIs(t, err, repository.ErrPrices)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Flow Error",
+			check: func(t *testing.T, repo *repository.Analytics, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Flow(ctx, connection, month, month)
+
+				require.ErrorIs(t, err, repository.ErrFlow)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Churn Error",
+			check: func(t *testing.T, repo *repository.Analytics, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Churn(ctx, connection, month, month, nil)
+
+				require.ErrorIs(t, err, repository.ErrChurn)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Churn Reasons Error",
+			check: func(t *testing.T, repo *repository.Analytics, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ChurnReasons(ctx, connection, month, month, nil)
+
+				require.ErrorIs(t, err, repository.ErrChurnReason)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Retention Error",
+			check: func(t *testing.T, repo *repository.Analytics, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Retention(ctx, connection, month, month, nil)
+
+				require.ErrorIs(t, err, repository.ErrRetention)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Price Outliers Error",
+			check: func(t *testing.T, repo *repository.Analytics, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.PriceOutliers(ctx, connection, nil, month, 1.5, 3)
+
+				require.ErrorIs(t, err, repository.ErrOutliers)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Median Jumps Error",
+			check: func(t *testing.T, repo *repository.Analytics, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.MedianJumps(ctx, connection, month, month, 1.2, 3)
+
+				require.ErrorIs(t, err, repository.ErrMedianJumps)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewAnalytics(), mocks.NewMockConnection(t))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ef_project/internal/domain"
+)
+
+var _ domain.AuditRepository = (*Audit)(nil)
+
+var (
+	errAudit       = errors.New("audit repository error")
+	ErrRecordAudit = errors.Join(errAudit, errors.New("record failed"))
+	ErrListAudit   = errors.Join(errAudit, errors.New("list failed"))
+	ErrSearchAudit = errors.Join(errAudit, errors.New("search failed"))
+	ErrReadUndo    = errors.Join(errAudit, errors.New("read undoable failed"))
+)
+
+type Audit struct{}
+
+func NewAudit() *Audit {
+	return &Audit{}
+}
+
+func (s *Audit) Record(
+	ctx context.Context,
+	connection domain.Connection,
+	entry domain.AuditEntry,
+) error {
+	const query = `insert into audit_log
+	(audit_id, actor, request_id, operation, subscription_id, user_id, before, after, details, created_at)
+	values
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	if _, err := connection.ExecContext(ctx, query, entry.ID, entry.Actor, entry.RequestID, entry.Operation, entry.SubscriptionID, entry.UserID, entry.Before, entry.After, entry.Details, entry.CreatedAt); err != nil {
+		return errors.Join(ErrRecordAudit, err)
+	}
+
+	return nil
+}
+
+const auditColumns = `audit_id, actor, request_id, operation, subscription_id, user_id, before, after, details, created_at`
+
+func (s *Audit) ListBySubscriptionID(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionID domain.SubscriptionID,
+) ([]domain.AuditEntry, error) {
+	const query = `select ` + auditColumns + ` from audit_log
+	where subscription_id = $1 order by created_at, audit_id`
+
+	var entries []domain.AuditEntry
+	if err := connection.SelectContext(ctx, &entries, query, subscriptionID); err != nil {
+		return entries, errors.Join(ErrListAudit, err)
+	}
+	return entries, nil
+}
+
+func (s *Audit) Search(
+	ctx context.Context,
+	connection domain.Connection,
+	filter domain.AuditFilter,
+) ([]domain.AuditEntry, error) {
+	const query = `select ` + auditColumns + ` from audit_log
+	where ($1::text is null or actor = $1) and ($2::text is null or operation = $2)
+	and ($3::uuid is null or user_id = $3) and ($4::uuid is null or subscription_id = $4)
+	and ($5::timestamptz is null or created_at >= $5) and ($6::timestamptz is null or created_at <= $6)
+	order by created_at desc, audit_id
+	limit $7 offset $8`
+
+	var entries []domain.AuditEntry
+	if err := connection.SelectContext(
+		ctx,
+		&entries,
+		query,
+		filter.Actor,
+		filter.Operation,
+		filter.UserID,
+		filter.SubscriptionID,
+		filter.From,
+		filter.To,
+		filter.Limit,
+		filter.Offset,
+	); err != nil {
+		return entries, errors.Join(ErrSearchAudit, err)
+	}
+	return entries, nil
+}
+
+// ReadUndoable returns the user's subscription creates, updates and deletes
+// recorded since the moment and not undone yet, newest first.
+func (s *Audit) ReadUndoable(
+	ctx context.Context,
+	connection domain.Connection,
+	userID domain.UserID,
+	since time.Time,
+) ([]domain.AuditEntry, error) {
+	const query = `select ` + auditColumns + ` from audit_log a
+	where a.user_id = $1 and a.created_at >= $2 and a.operation = any($3)
+	and not exists (select 1 from audit_log u
+		where u.operation = $4 and u.details->>'auditId' = a.audit_id::text)
+	order by a.created_at desc, a.audit_id`
+
+	var entries []domain.AuditEntry
+	if err := connection.SelectContext(
+		ctx,
+		&entries,
+		query,
+		userID,
+		since,
+		[]string{
+			domain.AuditOperationCreateSubscription,
+			domain.AuditOperationUpdateSubscription,
+			domain.AuditOperationDeleteSubscription,
+			domain.AuditOperationCancelSubscription,
+			domain.AuditOperationRestoreSubscription,
+		},
+		domain.AuditOperationUndoSubscription,
+	); err != nil {
+		return entries, errors.Join(ErrReadUndo, err)
+	}
+	return entries, nil
+}
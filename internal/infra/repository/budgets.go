@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ef_project/internal/domain"
+)
+
+var _ domain.BudgetRepository = (*Budget)(nil)
+
+var (
+	errBudget         = errors.New("budget repository error")
+	ErrCreateBudget   = errors.Join(errBudget, errors.New("create failed"))
+	ErrListBudgets    = errors.Join(errBudget, errors.New("list failed"))
+	ErrDeleteBudget   = errors.Join(errBudget, errors.New("delete failed"))
+	errBudgetNotFound = errors.New("no budget found")
+)
+
+const budgetColumns = `budget_id, user_id, scope, target, monthly_limit, thresholds`
+
+type Budget struct{}
+
+func NewBudget() *Budget {
+	return &Budget{}
+}
+
+func (s *Budget) Create(
+	ctx context.Context,
+	connection domain.Connection,
+	budget domain.Budget,
+) error {
+	const query = `insert into budgets
+	(budget_id, user_id, scope, target, monthly_limit, thresholds)
+	values
+	($1, $2, $3, $4, $5, $6)`
+
+	if _, err := connection.ExecContext(ctx, query, budget.ID, budget.UserID, budget.Scope, budget.Target, budget.Limit, budget.Thresholds); err != nil {
+		return errors.Join(ErrCreateBudget, err)
+	}
+
+	return nil
+}
+
+func (s *Budget) ListByUserID(
+	ctx context.Context,
+	connection domain.Connection,
+	userID domain.UserID,
+) ([]domain.Budget, error) {
+	const query = `select ` + budgetColumns + ` from budgets where user_id = $1 order by created_at`
+	var budgets []domain.Budget
+	if err := connection.SelectContext(ctx, &budgets, query, userID); err != nil {
+		return budgets, errors.Join(ErrListBudgets, err)
+	}
+	return budgets, nil
+}
+
+func (s *Budget) Delete(
+	ctx context.Context,
+	connection domain.Connection,
+	userID domain.UserID,
+	budgetID domain.BudgetID,
+) error {
+	const query = `delete from budgets where user_id = $1 and budget_id = $2`
+	rowsAffected, err := connection.ExecContext(ctx, query, userID, budgetID)
+	if err != nil {
+		return errors.Join(ErrDeleteBudget, err)
+	}
+	if rowsAffected == 0 {
+		return errors.Join(ErrDeleteBudget, errBudgetNotFound)
+	}
+	return nil
+}
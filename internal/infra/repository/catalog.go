@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ef_project/internal/domain"
+)
+
+var _ domain.CatalogRepository = (*Catalog)(nil)
+
+var (
+	errCatalog              = errors.New("catalog repository error")
+	ErrCreateCatalogEntry   = errors.Join(errCatalog, errors.New("create failed"))
+	ErrGetCatalogEntry      = errors.Join(errCatalog, errors.New("get failed"))
+	ErrListCatalogEntries   = errors.Join(errCatalog, errors.New("list failed"))
+	ErrUpdateCatalogEntry   = errors.Join(errCatalog, errors.New("update failed"))
+	ErrDeleteCatalogEntry   = errors.Join(errCatalog, errors.New("delete failed"))
+	ErrFindCatalogEntry     = errors.Join(errCatalog, errors.New("find failed"))
+	ErrSearchCatalog        = errors.Join(errCatalog, errors.New("search failed"))
+	errCatalogEntryNotFound = errors.New("no catalog entry found")
+)
+
+const catalogColumns = `service_id, name, aliases, category, default_price, website`
+
+type Catalog struct{}
+
+func NewCatalog() *Catalog {
+	return &Catalog{}
+}
+
+func (s *Catalog) Create(
+	ctx context.Context,
+	connection domain.Connection,
+	entry domain.CatalogEntry,
+) error {
+	const query = `insert into services
+	(service_id, name, aliases, category, default_price, website)
+	values
+	($1, $2, $3, $4, $5, $6)`
+
+	if _, err := connection.ExecContext(ctx, query, entry.ID, entry.Name, entry.Aliases, entry.Category, entry.DefaultPrice, entry.Website); err != nil {
+		return errors.Join(ErrCreateCatalogEntry, err)
+	}
+
+	return nil
+}
+
+func (s *Catalog) GetByID(
+	ctx context.Context,
+	connection domain.Connection,
+	id domain.CatalogEntryID,
+) (domain.CatalogEntry, error) {
+	const query = `select ` + catalogColumns + ` from services where service_id = $1`
+	var entry domain.CatalogEntry
+	if err := connection.GetContext(ctx, &entry, query, id); err != nil {
+		return entry, errors.Join(ErrGetCatalogEntry, err)
+	}
+	return entry, nil
+}
+
+func (s *Catalog) List(
+	ctx context.Context,
+	connection domain.Connection,
+) ([]domain.CatalogEntry, error) {
+	const query = `select ` + catalogColumns + ` from services order by name`
+	var entries []domain.CatalogEntry
+	if err := connection.SelectContext(ctx, &entries, query); err != nil {
+		return entries, errors.Join(ErrListCatalogEntries, err)
+	}
+	return entries, nil
+}
+
+func (s *Catalog) Update(
+	ctx context.Context,
+	connection domain.Connection,
+	entry domain.CatalogEntry,
+) error {
+	const query = `update services
+	set name = $2, aliases = $3, category = $4, default_price = $5, website = $6
+	where service_id = $1`
+	rowsAffected, err := connection.ExecContext(ctx, query, entry.ID, entry.Name, entry.Aliases, entry.Category, entry.DefaultPrice, entry.Website)
+	if err != nil {
+		return errors.Join(ErrUpdateCatalogEntry, err)
+	}
+	if rowsAffected == 0 {
+		return errors.Join(ErrUpdateCatalogEntry, errCatalogEntryNotFound)
+	}
+	return nil
+}
+
+func (s *Catalog) Delete(
+	ctx context.Context,
+	connection domain.Connection,
+	id domain.CatalogEntryID,
+) error {
+	const query = `delete from services where service_id = $1`
+	rowsAffected, err := connection.ExecContext(ctx, query, id)
+	if err != nil {
+		return errors.Join(ErrDeleteCatalogEntry, err)
+	}
+	if rowsAffected == 0 {
+		return errors.Join(ErrDeleteCatalogEntry, errCatalogEntryNotFound)
+	}
+	return nil
+}
+
+func (s *Catalog) FindByNameOrAlias(
+	ctx context.Context,
+	connection domain.Connection,
+	name string,
+) ([]domain.CatalogEntry, error) {
+	const query = `select ` + catalogColumns + ` from services
+	where lower(name) = lower(trim($1))
+	or exists (select 1 from unnest(aliases) alias where lower(alias) = lower(trim($1)))`
+	var entries []domain.CatalogEntry
+	if err := connection.SelectContext(ctx, &entries, query, name); err != nil {
+		return entries, errors.Join(ErrFindCatalogEntry, err)
+	}
+	return entries, nil
+}
+
+// Search scores every catalog entry by the best trigram similarity between any
+// of the query variants and the entry's canonical name or aliases.
+// The catalog is small, so it is scanned: a trigram index on the name could
+// serve neither the aliases nor a threshold passed per call.
+func (s *Catalog) Search(
+	ctx context.Context,
+	connection domain.Connection,
+	variants []string,
+	threshold float64,
+	limit int,
+) ([]domain.CatalogMatch, error) {
+	const query = `with scores as (
+		select s.service_id, max(similarity(lower(n), v)) as score
+		from services s
+		cross join lateral unnest(array_append(s.aliases, s.name)) n
+		cross join unnest($1::text[]) v
+		group by s.service_id
+	)
+	select s.service_id, s.name, s.aliases, s.category, s.default_price, s.website, scores.score
+	from scores join services s using (service_id)
+	where scores.score >= $2
+	order by scores.score desc, s.name
+	limit $3`
+	var matches []domain.CatalogMatch
+	if err := connection.SelectContext(ctx, &matches, query, variants, threshold, limit); err != nil {
+		return matches, errors.Join(ErrSearchCatalog, err)
+	}
+	return matches, nil
+}
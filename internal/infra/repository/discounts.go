@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ef_project/internal/domain"
+)
+
+var (
+	ErrCreateDiscount   = errors.Join(errSubscription, errors.New("create discount failed"))
+	ErrReadDiscounts    = errors.Join(errSubscription, errors.New("read discounts failed"))
+	ErrDeleteDiscount   = errors.Join(errSubscription, errors.New("delete discount failed"))
+	errDiscountNotFound = errors.New("no discount found")
+)
+
+const discountColumns = `discount_id, subscription_id, kind, value, start_date, end_date`
+
+func (s *Subscription) CreateDiscount(
+	ctx context.Context,
+	connection domain.Connection,
+	discount domain.Discount,
+) error {
+	const query = `insert into subscription_discounts
+	(discount_id, subscription_id, kind, value, start_date, end_date)
+	values
+	($1, $2, $3, $4, $5, $6)`
+
+	if _, err := connection.ExecContext(ctx, query, discount.ID, discount.SubscriptionID, discount.Kind, discount.Value, discount.StartDate, discount.EndDate); err != nil {
+		return errors.Join(ErrCreateDiscount, err)
+	}
+
+	return nil
+}
+
+func (s *Subscription) ReadDiscounts(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionIDs []domain.SubscriptionID,
+) ([]domain.Discount, error) {
+	const query = `select ` + discountColumns + ` from subscription_discounts
+	where subscription_id = any($1) order by subscription_id, start_date`
+	var discounts []domain.Discount
+	if err := connection.SelectContext(ctx, &discounts, query, subscriptionIDs); err != nil {
+		return discounts, errors.Join(ErrReadDiscounts, err)
+	}
+	return discounts, nil
+}
+
+func (s *Subscription) DeleteDiscount(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionID domain.SubscriptionID,
+	discountID domain.DiscountID,
+) error {
+	const query = `delete from subscription_discounts where subscription_id = $1 and discount_id = $2`
+	rowsAffected, err := connection.ExecContext(ctx, query, subscriptionID, discountID)
+	if err != nil {
+		return errors.Join(ErrDeleteDiscount, err)
+	}
+	if rowsAffected == 0 {
+		return errors.Join(ErrDeleteDiscount, errDiscountNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"ef_project/internal/domain"
+)
+
+var (
+	ErrReadVersions = errors.Join(errSubscription, errors.New("read versions failed"))
+	ErrGetVersion   = errors.Join(errSubscription, errors.New("get version failed"))
+)
+
+// ReadVersions returns every recorded version of a subscription, oldest
+// first. Versions are written by a trigger, so they include changes made
+// outside the application.
+func (s *Subscription) ReadVersions(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionID domain.SubscriptionID,
+) ([]domain.SubscriptionVersion, error) {
+	const query = `select ` + subscriptionColumns + `, valid_from, valid_to from subscriptions_history
+	where subscription_id = $1 order by valid_from, valid_to nulls last`
+	var versions []domain.SubscriptionVersion
+	if err := connection.SelectContext(ctx, &versions, query, subscriptionID); err != nil {
+		return versions, errors.Join(ErrReadVersions, err)
+	}
+	return versions, nil
+}
+
+// GetVersionAt returns the version of a subscription current at the moment.
+// Before the first version it fails with domain.ErrNotFound.
+func (s *Subscription) GetVersionAt(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionID domain.SubscriptionID,
+	at time.Time,
+) (domain.SubscriptionVersion, error) {
+	const query = `select ` + subscriptionColumns + `, valid_from, valid_to from subscriptions_history
+	where subscription_id = $1 and valid_from <= $2 and (valid_to is null or valid_to > $2)
+	order by valid_from desc limit 1`
+	var version domain.SubscriptionVersion
+	if err := connection.GetContext(ctx, &version, query, subscriptionID, at); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return version, errors.Join(ErrGetVersion, domain.ErrNotFound, err)
+		}
+		return version, errors.Join(ErrGetVersion, err)
+	}
+	return version, nil
+}
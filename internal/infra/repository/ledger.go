@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ef_project/internal/domain"
+)
+
+var _ domain.LedgerRepository = (*Ledger)(nil)
+
+var (
+	errLedger              = errors.New("ledger repository error")
+	ErrDeleteLedgerCharges = errors.Join(errLedger, errors.New("delete failed"))
+	ErrInsertLedgerCharges = errors.Join(errLedger, errors.New("insert failed"))
+	ErrReadLedgerCharges   = errors.Join(errLedger, errors.New("read failed"))
+	ErrReadLedgerPayers    = errors.Join(errLedger, errors.New("read payers failed"))
+)
+
+type Ledger struct{}
+
+func NewLedger() *Ledger {
+	return &Ledger{}
+}
+
+func (s *Ledger) DeleteBySubscriptionID(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionID domain.SubscriptionID,
+) error {
+	const query = `delete from charges where subscription_id = $1`
+
+	if _, err := connection.ExecContext(ctx, query, subscriptionID); err != nil {
+		return errors.Join(ErrDeleteLedgerCharges, err)
+	}
+
+	return nil
+}
+
+// DeleteInPeriod removes the rows of [start, end] of the subscriptions paid
+// by the user, or of all subscriptions for nil.
+func (s *Ledger) DeleteInPeriod(
+	ctx context.Context,
+	connection domain.Connection,
+	payerID *domain.UserID,
+	start time.Time,
+	end time.Time,
+) error {
+	const query = `delete from charges
+	where ($1::uuid is null or payer_id = $1) and month between $2 and $3`
+
+	if _, err := connection.ExecContext(ctx, query, payerID, start, end); err != nil {
+		return errors.Join(ErrDeleteLedgerCharges, err)
+	}
+
+	return nil
+}
+
+// Insert writes the charges in one statement, passing every column as an
+// array.
+func (s *Ledger) Insert(
+	ctx context.Context,
+	connection domain.Connection,
+	charges []domain.Charge,
+) error {
+	const query = `insert into charges
+	(subscription_id, user_id, payer_id, month, kind, discount_id, actual, amount, currency)
+	select subscription_id, user_id, payer_id, month, kind, discount_id, actual, amount, $9
+	from unnest($1::uuid[], $2::uuid[], $3::uuid[], $4::date[], $5::text[], $6::uuid[], $7::boolean[], $8::integer[])
+	as c(subscription_id, user_id, payer_id, month, kind, discount_id, actual, amount)`
+
+	if len(charges) == 0 {
+		return nil
+	}
+
+	var (
+		subscriptionIDs = make([]domain.SubscriptionID, 0, len(charges))
+		userIDs         = make([]domain.UserID, 0, len(charges))
+		payerIDs        = make([]domain.UserID, 0, len(charges))
+		months          = make([]time.Time, 0, len(charges))
+		kinds           = make([]string, 0, len(charges))
+		discountIDs     = make([]*domain.DiscountID, 0, len(charges))
+		actuals         = make([]bool, 0, len(charges))
+		amounts         = make([]int, 0, len(charges))
+	)
+	for _, charge := range charges {
+		subscriptionIDs = append(subscriptionIDs, charge.SubscriptionID)
+		userIDs = append(userIDs, charge.UserID)
+		payerIDs = append(payerIDs, charge.PayerID)
+		months = append(months, charge.Month)
+		kinds = append(kinds, string(charge.Kind))
+		discountIDs = append(discountIDs, charge.DiscountID)
+		actuals = append(actuals, charge.Actual)
+		amounts = append(amounts, charge.Amount)
+	}
+
+	if _, err := connection.ExecContext(
+		ctx,
+		query,
+		subscriptionIDs,
+		userIDs,
+		payerIDs,
+		months,
+		kinds,
+		discountIDs,
+		actuals,
+		amounts,
+		domain.BillingCurrency,
+	); err != nil {
+		return errors.Join(ErrInsertLedgerCharges, err)
+	}
+
+	return nil
+}
+
+// Payers returns the users paying a subscription billed in [start, end] or
+// having ledger rows in it.
+func (s *Ledger) Payers(
+	ctx context.Context,
+	connection domain.Connection,
+	start time.Time,
+	end time.Time,
+) ([]domain.UserID, error) {
+	const query = `select user_id from subscriptions
+	where deleted_at is null and subs_start_date <= $2 and (subs_end_date is null or subs_end_date >= $1)
+	union
+	select payer_id from charges where month between $1 and $2`
+
+	var payers []domain.UserID
+	if err := connection.SelectContext(ctx, &payers, query, start, end); err != nil {
+		return nil, errors.Join(ErrReadLedgerPayers, err)
+	}
+
+	return payers, nil
+}
+
+// Read returns the charges of the query together with the name, category and
+// tags of their subscriptions, ordered by month.
+func (s *Ledger) Read(
+	ctx context.Context,
+	connection domain.Connection,
+	query domain.ChargeQuery,
+) ([]domain.Charge, error) {
+	const statement = `select c.subscription_id, c.user_id, c.payer_id, c.month, c.kind, c.discount_id, c.actual, c.amount,
+	s.kind = 'one_time' as one_time, s.service_name,
+	coalesce(s.category, (select e.category from services e where e.service_id = s.service_id)) as category, s.tags
+	from charges c join subscriptions s on s.subscription_id = c.subscription_id
+	where ($1::uuid is null or c.user_id = $1) and ($2::uuid is null or c.payer_id = $2)
+	and ($3::text is null or s.service_name = $3) and c.month between $4 and $5
+	order by c.month, s.service_name`
+
+	var charges []domain.Charge
+	if err := connection.SelectContext(
+		ctx,
+		&charges,
+		statement,
+		query.UserID,
+		query.PayerID,
+		query.Name,
+		query.Start,
+		query.End,
+	); err != nil {
+		return nil, errors.Join(ErrReadLedgerCharges, err)
+	}
+
+	return charges, nil
+}
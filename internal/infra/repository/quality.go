@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ef_project/internal/domain"
+)
+
+var _ domain.QualityRepository = (*Quality)(nil)
+
+var (
+	errQuality       = errors.New("quality repository error")
+	ErrFindAnomalies = errors.Join(errQuality, errors.New("find anomalies failed"))
+	ErrFixAnomalies  = errors.Join(errQuality, errors.New("fix anomalies failed"))
+)
+
+// sameRecord matches a record o repeating the service, period and cost of s.
+const sameRecord = `o.user_id = s.user_id and o.service_name = s.service_name and o.kind = s.kind
+	and o.subs_start_date = s.subs_start_date and o.subs_end_date is not distinct from s.subs_end_date
+	and o.month_cost = s.month_cost`
+
+// earlierRecord orders a record o before s: by start date, then by creation
+// time and, for records created in the same statement, by id.
+const earlierRecord = `(o.subs_start_date, o.created_at, o.subscription_id) < (s.subs_start_date, s.created_at, s.subscription_id)`
+
+// laterOverlap matches a recurring record o of the same user and service
+// starting while s still runs. Like CountOverlapping, a subscription ending in
+// the month the next one starts does not overlap it.
+const laterOverlap = `o.user_id = s.user_id and o.service_name = s.service_name
+	and o.kind = 'recurring' and o.deleted_at is null and o.subscription_id <> s.subscription_id
+	and o.subs_start_date > s.subs_start_date and o.subs_start_date < coalesce(s.subs_end_date, 'infinity'::date)`
+
+var qualityFixQueries = map[domain.AnomalyType]string{
+	domain.AnomalyDuplicate: `update subscriptions s set deleted_at = now()
+	where s.deleted_at is null and exists (select 1 from subscriptions o
+		where o.deleted_at is null and ` + earlierRecord + ` and ` + sameRecord + `)
+	returning s.subscription_id`,
+	domain.AnomalyEndBeforeStart: `update subscriptions set subs_start_date = subs_end_date, subs_end_date = subs_start_date
+	where deleted_at is null and subs_end_date < subs_start_date
+	returning subscription_id`,
+	domain.AnomalySentinelEndDate: `update subscriptions set subs_end_date = null
+	where deleted_at is null and extract(year from subs_end_date) >= 9999
+	returning subscription_id`,
+	domain.AnomalyOverlap: `update subscriptions t
+	set subs_end_date = greatest(t.subs_start_date, (date_trunc('month', n.next_start) - interval '1 month')::date)
+	from (select s.subscription_id, min(o.subs_start_date) as next_start
+		from subscriptions s join subscriptions o on ` + laterOverlap + `
+		where s.deleted_at is null and s.kind = 'recurring'
+		group by s.subscription_id) n
+	where t.subscription_id = n.subscription_id
+	returning t.subscription_id`,
+	domain.AnomalyNonPositiveCost: `update subscriptions s set month_cost = c.default_price
+	from services c
+	where c.service_id = s.service_id and c.default_price > 0 and s.month_cost <= 0 and s.deleted_at is null
+	returning s.subscription_id`,
+}
+
+type Quality struct{}
+
+func NewQuality() *Quality {
+	return &Quality{}
+}
+
+// FindAnomalies lists every anomaly among the subscriptions not in the
+// trash, one row per anomaly and record.
+func (s *Quality) FindAnomalies(
+	ctx context.Context,
+	connection domain.Connection,
+) ([]domain.Anomaly, error) {
+	const query = `with live as (select ` + subscriptionColumns + `, created_at from subscriptions where deleted_at is null)
+	select anomaly, ` + subscriptionColumns + `, conflict_id from (
+	select 'duplicate' as anomaly, s.*, d.subscription_id as conflict_id
+	from live s join lateral (select o.subscription_id from live o
+		where ` + earlierRecord + ` and ` + sameRecord + `
+		order by o.subs_start_date, o.created_at, o.subscription_id limit 1) d on true
+	union all
+	select 'overlap', s.*, o.subscription_id
+	from live s join live o on ` + laterOverlap + `
+	where s.kind = 'recurring'
+	union all
+	select 'end_before_start', s.*, null from live s where s.subs_end_date < s.subs_start_date
+	union all
+	select 'non_positive_cost', s.*, null from live s where s.month_cost <= 0
+	union all
+	select 'sentinel_end_date', s.*, null from live s where extract(year from s.subs_end_date) >= 9999) a
+	order by anomaly, user_id, service_name, subs_start_date`
+
+	var anomalies []domain.Anomaly
+	if err := connection.SelectContext(ctx, &anomalies, query); err != nil {
+		return anomalies, errors.Join(ErrFindAnomalies, err)
+	}
+	return anomalies, nil
+}
+
+// Fix applies the fix of one anomaly type and returns the records it changed.
+func (s *Quality) Fix(
+	ctx context.Context,
+	connection domain.Connection,
+	anomaly domain.AnomalyType,
+) ([]domain.SubscriptionID, error) {
+	query, ok := qualityFixQueries[anomaly]
+	if !ok {
+		return nil, errors.Join(ErrFixAnomalies, fmt.Errorf("no fix for %s", anomaly))
+	}
+
+	var fixed []domain.SubscriptionID
+	if err := connection.SelectContext(ctx, &fixed, query); err != nil {
+		return fixed, errors.Join(ErrFixAnomalies, err)
+	}
+	return fixed, nil
+}
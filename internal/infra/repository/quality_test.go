@@ -0,0 +1,65 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/generated/mocks"
+	"ef_project/internal/infra/repository"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQualityUnit(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check func(*testing.T, *repository.Quality, *mocks.MockConnection)
+	}{
+		{
+			name: "Find Anomalies Error",
+			check: func(t *testing.T, repo *repository.Quality, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.FindAnomalies(ctx, connection)
+
+				require.ErrorIs(t, err, repository.ErrFindAnomalies)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Fix Error",
+			check: func(t *testing.T, repo *repository.Quality, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.Fix(ctx, connection, domain.AnomalyOverlap)
+
+				require.ErrorIs(t, err, repository.ErrFixAnomalies)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Fix Unknown Anomaly",
+			check: func(t *testing.T, repo *repository.Quality, connection *mocks.MockConnection) {
+				_, err := repo.Fix(ctx, connection, domain.AnomalyType("unknown"))
+
+				require.ErrorIs(t, err, repository.ErrFixAnomalies)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, repository.NewQuality(), mocks.NewMockConnection(t))
+		})
+	}
+}
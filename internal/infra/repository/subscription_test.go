@@ -13,6 +13,7 @@ import (
 	"ef_project/internal/generated/mocks"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -50,6 +51,18 @@ func TestSubscriptionIntegration(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, subscriptionsFromDBUser1, 1)
 
+		trashUser1, err := repoSubscription.ReadDeletedByUserID(ctx, connection, userID1)
+		require.NoError(t, err)
+		require.Len(t, trashUser1, 1)
+		require.Equal(t, subscription1User1.ID, trashUser1[0].ID)
+
+		err = repoSubscription.Restore(ctx, connection, subscription1User1.ID)
+		require.NoError(t, err)
+
+		subscriptionsFromDBUser1, err = repoSubscription.ReadAllByUserID(ctx, connection, userID1)
+		require.NoError(t, err)
+		require.Len(t, subscriptionsFromDBUser1, 2)
+
 		now := time.Now()
 		newEndDate := now.AddDate(0, 1, 0).UTC().Truncate(24 * time.Hour)
 		subscription1User2.EndDate = pointer.Ref(newEndDate)
@@ -65,16 +78,16 @@ func TestSubscriptionIntegration(t *testing.T) {
 		require.Equal(t, pointer.Ref(newEndDate), subscriptionsFromDBUser2[0].EndDate)
 
 		subscription2User2 := fixtureCreateSubscription(t, connection, userID2, serviseName2)
-		subscriptionsCosts, err := repoSubscription.AllMatchingSubscriptionsForPeriod(
+		activeSubscriptions, err := repoSubscription.ReadActiveInPeriod(
 			ctx,
 			connection,
-			userID2,
-			serviseName2,
+			pointer.Ref(userID2),
+			pointer.Ref(serviseName2),
 			now,
-			pointer.Ref(newEndDate),
+			newEndDate,
 		)
 		require.NoError(t, err)
-		require.Equal(t, subscription2User2.Cost, subscriptionsCosts[0])
+		require.Equal(t, subscription2User2.Cost, activeSubscriptions[0].Cost)
 	})
 }
 
@@ -125,6 +138,42 @@ func TestListsUnit(t *testing.T) {
 				require.ErrorContains(t, err, "some error")
 			},
 		},
+		{
+			name: "Cancel Subscription Error",
+			check: func(t *testing.T, repo *repository.Subscription, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(0, errors.New("some error")).
+					Once()
+
+				err := repo.Cancel(
+					ctx,
+					connection,
+					validSubscription.UserID,
+					validSubscription.Name,
+					validSubscription.StartDate,
+					domain.CancellationOther,
+					"reason",
+				)
+
+				require.ErrorIs(t, err, repository.ErrCancelSubscription)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Restore Subscription Error",
+			check: func(t *testing.T, repo *repository.Subscription, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, mock.Anything).
+					Return(0, nil).
+					Once()
+
+				err := repo.Restore(ctx, connection, validSubscription.ID)
+
+				require.ErrorIs(t, err, repository.ErrRestoreSubscription)
+				require.ErrorContains(t, err, "no deleted subscription found")
+			},
+		},
 		{
 			name: "Update Subscription Error",
 			check: func(t *testing.T, repo *repository.Subscription, connection *mocks.MockConnection) {
@@ -139,6 +188,20 @@ func TestListsUnit(t *testing.T) {
 				require.ErrorContains(t, err, "some error")
 			},
 		},
+		{
+			name: "Update Subscription Not Found",
+			check: func(t *testing.T, repo *repository.Subscription, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(mock.Anything, mock.Anything, mock.Anything).
+					Return(0, nil).
+					Once()
+
+				err := repo.Update(ctx, connection, validSubscription)
+
+				require.ErrorIs(t, err, repository.ErrUpdateSubscription)
+				require.ErrorContains(t, err, "no subscription found to update")
+			},
+		},
 		{
 			name: "Read All Subscriptions Error",
 			check: func(t *testing.T, repo *repository.Subscription, connection *mocks.MockConnection) {
@@ -153,6 +216,99 @@ func TestListsUnit(t *testing.T) {
 				require.ErrorContains(t, err, "some error")
 			},
 		},
+		{
+			name: "Revert Subscription Not Found",
+			check: func(t *testing.T, repo *repository.Subscription, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					ExecContext(
+						mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+						mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+						mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+						mock.Anything,
+					).
+					Return(0, nil).
+					Once()
+
+				err := repo.Revert(ctx, connection, validSubscription)
+
+				require.ErrorIs(t, err, repository.ErrRevertSubscription)
+				require.ErrorContains(t, err, "no subscription found to revert")
+			},
+		},
+		{
+			name: "Read Versions Error",
+			check: func(t *testing.T, repo *repository.Subscription, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.ReadVersions(ctx, connection, validSubscription.ID)
+
+				require.ErrorIs(t, err, repository.ErrReadVersions)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Get Version Error",
+			check: func(t *testing.T, repo *repository.Subscription, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.GetVersionAt(ctx, connection, validSubscription.ID, time.Now())
+
+				require.ErrorIs(t, err, repository.ErrGetVersion)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name: "Get Latest By Name Not Found",
+			check: func(t *testing.T, repo *repository.Subscription, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(pgx.ErrNoRows).
+					Once()
+
+				_, err := repo.GetLatestByName(ctx, connection, uuid.New(), "Netflix")
+
+				require.ErrorIs(t, err, repository.ErrGetLatestSubscription)
+				require.ErrorIs(t, err, domain.ErrNotFound)
+			},
+		},
+		{
+			name: "Get Version Before First Version",
+			check: func(t *testing.T, repo *repository.Subscription, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(pgx.ErrNoRows).
+					Once()
+
+				_, err := repo.GetVersionAt(ctx, connection, validSubscription.ID, time.Now())
+
+				require.ErrorIs(t, err, repository.ErrGetVersion)
+				require.ErrorIs(t, err, domain.ErrNotFound)
+			},
+		},
+		{
+			name: "Transfer Members Error",
+			check: func(t *testing.T, repo *repository.Subscription, connection *mocks.MockConnection) {
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).
+					Once()
+				connection.EXPECT().
+					SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+
+				_, err := repo.TransferMembers(ctx, connection, uuid.New(), uuid.New())
+
+				require.ErrorIs(t, err, repository.ErrTransferMembers)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -168,6 +324,8 @@ func fixtureCreateSubscription(
 	name domain.ServiceName,
 ) domain.Subscription {
 	subscription := domain.Subscription{
+		ID:        uuid.New(),
+		Kind:      domain.SubscriptionRecurring,
 		UserID:    userID,
 		Cost:      1,
 		Name:      name,
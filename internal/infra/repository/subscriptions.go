@@ -17,11 +17,26 @@ var (
 	ErrReadAllSubscriptions      = errors.Join(errSubscription, errors.New("read failed"))
 	ErrUpdateSubscription        = errors.Join(errSubscription, errors.New("update failed"))
 	ErrDeleteSubscription        = errors.Join(errSubscription, errors.New("delete failed"))
+	ErrCancelSubscription        = errors.Join(errSubscription, errors.New("cancel failed"))
 	ErrTotalCostSubscription     = errors.Join(errSubscription, errors.New("total cost failed"))
 	ErrGetLatestSubscription     = errors.Join(errSubscription, errors.New("get latest subscription failed"))
 	ErrGetLatestDateSubscription = errors.Join(errSubscription, errors.New("get latest date failed"))
+	ErrGetSubscription           = errors.Join(errSubscription, errors.New("get subscription failed"))
+	ErrRestoreSubscription       = errors.Join(errSubscription, errors.New("restore failed"))
+	ErrPurgeSubscriptions        = errors.Join(errSubscription, errors.New("purge failed"))
+	ErrOverlapSubscriptions      = errors.Join(errSubscription, errors.New("overlap check failed"))
+	ErrRenewSubscriptions        = errors.Join(errSubscription, errors.New("renew failed"))
+	ErrExpireSubscriptions       = errors.Join(errSubscription, errors.New("expire failed"))
+	ErrServiceConflicts          = errors.Join(errSubscription, errors.New("service conflicts check failed"))
+	ErrRenameService             = errors.Join(errSubscription, errors.New("rename service failed"))
+	ErrReadMembers               = errors.Join(errSubscription, errors.New("read members failed"))
+	ErrReplaceMembers            = errors.Join(errSubscription, errors.New("replace members failed"))
+	ErrRevertSubscription        = errors.Join(errSubscription, errors.New("revert failed"))
 )
 
+const subscriptionColumns = `subscription_id, kind, service_name, month_cost, user_id, subs_start_date, subs_end_date,
+	service_id, category, tags, split_rule, auto_renew, term_months, expired_at, cancellation_code, cancellation_reason, deleted_at`
+
 type Subscription struct{}
 
 func NewSubscription() *Subscription {
@@ -34,11 +49,11 @@ func (s *Subscription) Create(
 	subscription domain.Subscription,
 ) error {
 	const query = `insert into subscriptions
-	(service_name, month_cost, user_id, subs_start_date, subs_end_date)
+	(subscription_id, service_name, month_cost, user_id, subs_start_date, subs_end_date, auto_renew, term_months, service_id, category, tags, split_rule, kind)
 	values
-	($1, $2, $3, $4, $5)`
+	($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, coalesce($11::text[], '{}'), $12, $13)`
 
-	if _, err := connection.ExecContext(ctx, query, subscription.Name, subscription.Cost, subscription.UserID, subscription.StartDate, subscription.EndDate); err != nil {
+	if _, err := connection.ExecContext(ctx, query, subscription.ID, subscription.Name, subscription.Cost, subscription.UserID, subscription.StartDate, subscription.EndDate, subscription.AutoRenew, subscription.TermMonths, subscription.ServiceID, subscription.Category, subscription.Tags, subscription.SplitRule, subscription.Kind); err != nil {
 		return errors.Join(ErrCreateSubscription, err)
 	}
 
@@ -50,7 +65,7 @@ func (s *Subscription) ReadAllByUserID(
 	connection domain.Connection,
 	userID domain.UserID,
 ) ([]domain.Subscription, error) {
-	const query = `select service_name, month_cost, user_id, subs_start_date, subs_end_date from subscriptions where user_id=$1`
+	const query = `select ` + subscriptionColumns + ` from subscriptions where user_id=$1 and deleted_at is null`
 	var allUserSubscriptions []domain.Subscription
 	if err := connection.SelectContext(ctx, &allUserSubscriptions, query, userID); err != nil {
 		return allUserSubscriptions, errors.Join(ErrReadAllSubscriptions, err)
@@ -63,14 +78,18 @@ func (s *Subscription) Update(
 	connection domain.Connection,
 	subscription domain.Subscription,
 ) error {
-	const query = `update subscriptions set month_cost = $3, subs_end_date=$4  
-	where service_name = $1 and user_id = $2 
-	and subs_start_date = (select subs_start_date from subscriptions
-	where user_id = $2 and service_name = $1 order by subs_start_date desc limit 1) `
+	const query = `update subscriptions set month_cost = $3, subs_end_date=$4, auto_renew = $5, term_months = $6,
+	category = $7, tags = coalesce($8::text[], '{}'), split_rule = $9
+	where subscription_id = (select subscription_id from subscriptions
+	where user_id = $2 and service_name = $1 and deleted_at is null order by subs_start_date desc limit 1)`
 
-	if _, err := connection.ExecContext(ctx, query, subscription.Name, subscription.UserID, subscription.Cost, subscription.EndDate); err != nil {
+	rowsAffected, err := connection.ExecContext(ctx, query, subscription.Name, subscription.UserID, subscription.Cost, subscription.EndDate, subscription.AutoRenew, subscription.TermMonths, subscription.Category, subscription.Tags, subscription.SplitRule)
+	if err != nil {
 		return errors.Join(ErrUpdateSubscription, err)
 	}
+	if rowsAffected == 0 {
+		return errors.Join(ErrUpdateSubscription, errors.New("no subscription found to update"))
+	}
 
 	return nil
 }
@@ -81,8 +100,9 @@ func (s *Subscription) Delete(
 	subscriptionUserID domain.UserID,
 	subscriptionName domain.ServiceName,
 ) error {
-	const query = `delete from subscriptions where user_id = $1 and service_name = $2 
-	and subs_start_date = (select subs_start_date from subscriptions where user_id = $1 and service_name = $2 order by subs_start_date desc limit 1)`
+	const query = `update subscriptions set deleted_at = now()
+	where subscription_id = (select subscription_id from subscriptions
+	where user_id = $1 and service_name = $2 and deleted_at is null order by subs_start_date desc limit 1)`
 	rowsAffected, err := connection.ExecContext(ctx, query, subscriptionUserID, subscriptionName)
 	if err != nil {
 		return errors.Join(ErrDeleteSubscription, err)
@@ -93,14 +113,44 @@ func (s *Subscription) Delete(
 	return nil
 }
 
+func (s *Subscription) Cancel(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionUserID domain.UserID,
+	subscriptionName domain.ServiceName,
+	endDate time.Time,
+	code domain.CancellationCode,
+	reason string,
+) error {
+	const query = `update subscriptions set subs_end_date = $3, cancellation_code = $4, cancellation_reason = $5, auto_renew = false
+	where subscription_id = (select subscription_id from subscriptions
+	where user_id = $1 and service_name = $2 and deleted_at is null order by subs_start_date desc limit 1)`
+	rowsAffected, err := connection.ExecContext(
+		ctx,
+		query,
+		subscriptionUserID,
+		subscriptionName,
+		endDate,
+		code,
+		reason,
+	)
+	if err != nil {
+		return errors.Join(ErrCancelSubscription, err)
+	}
+	if rowsAffected == 0 {
+		return errors.Join(ErrCancelSubscription, errors.New("no subscription found to cancel"))
+	}
+	return nil
+}
+
 func (s *Subscription) GetLatest(
 	ctx context.Context,
 	connection domain.Connection,
 	userID domain.UserID,
 ) (domain.Subscription, error) {
 	var latestSubs domain.Subscription
-	const query = `select service_name, month_cost, user_id, subs_start_date, subs_end_date from subscriptions
-	where user_id = $1 order by subs_start_date desc limit 1`
+	const query = `select ` + subscriptionColumns + ` from subscriptions
+	where user_id = $1 and deleted_at is null order by subs_start_date desc limit 1`
 
 	if err := connection.GetContext(ctx, &latestSubs, query, userID); err != nil {
 		return latestSubs, errors.Join(ErrGetLatestSubscription, err)
@@ -108,20 +158,49 @@ func (s *Subscription) GetLatest(
 	return latestSubs, nil
 }
 
-func (s *Subscription) AllMatchingSubscriptionsForPeriod(
+func (s *Subscription) GetLatestByName(
 	ctx context.Context,
 	connection domain.Connection,
-	subscriptionUserID domain.UserID,
-	subscriptionName domain.ServiceName,
+	userID domain.UserID,
+	serviceName domain.ServiceName,
+) (domain.Subscription, error) {
+	var latestSubs domain.Subscription
+	const query = `select ` + subscriptionColumns + ` from subscriptions
+	where user_id = $1 and service_name = $2 and deleted_at is null order by subs_start_date desc limit 1`
+
+	if err := connection.GetContext(ctx, &latestSubs, query, userID, serviceName); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return latestSubs, errors.Join(ErrGetLatestSubscription, domain.ErrNotFound, err)
+		}
+		return latestSubs, errors.Join(ErrGetLatestSubscription, err)
+	}
+	return latestSubs, nil
+}
+
+// ReadActiveInPeriod returns the subscriptions billed in at least one month of
+// [start, end], optionally narrowed to a user paying or sharing them and to a
+// service name. A subscription without its own category takes the one of its
+// catalog service.
+func (s *Subscription) ReadActiveInPeriod(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionUserID *domain.UserID,
+	subscriptionName *domain.ServiceName,
 	start time.Time,
-	end *time.Time,
-) ([]int, error) {
-	const query = `select month_cost from subscriptions where user_id = $1 and service_name = $2 and subs_start_date < $3 and (subs_end_date is null or subs_end_date >= $4)`
-	var matchesSubscriptions []int
-	if err := connection.SelectContext(ctx, &matchesSubscriptions, query, subscriptionUserID, subscriptionName, end, start); err != nil {
-		return matchesSubscriptions, errors.Join(ErrTotalCostSubscription, err)
+	end time.Time,
+) ([]domain.Subscription, error) {
+	const query = `select subscription_id, kind, service_name, month_cost, user_id, subs_start_date, subs_end_date,
+	service_id, coalesce(category, (select c.category from services c where c.service_id = subscriptions.service_id)) as category,
+	tags, split_rule, auto_renew, term_months, expired_at, cancellation_code, cancellation_reason, deleted_at
+	from subscriptions
+	where ($1::uuid is null or user_id = $1 or exists (select 1 from subscription_members m
+		where m.subscription_id = subscriptions.subscription_id and m.user_id = $1)) and ($2::text is null or service_name = $2)
+	and subs_start_date <= $4 and (subs_end_date is null or subs_end_date >= $3) and deleted_at is null`
+	var subscriptions []domain.Subscription
+	if err := connection.SelectContext(ctx, &subscriptions, query, subscriptionUserID, subscriptionName, start, end); err != nil {
+		return subscriptions, errors.Join(ErrTotalCostSubscription, err)
 	}
-	return matchesSubscriptions, nil
+	return subscriptions, nil
 }
 
 func (s *Subscription) GetLatestSubscriptionDate(
@@ -130,8 +209,10 @@ func (s *Subscription) GetLatestSubscriptionDate(
 	userID domain.UserID,
 	serviceName domain.ServiceName,
 ) (*time.Time, error) {
-	const query = `select (subs_end_date) from subscriptions
-	where user_id = $1 and service_name = $2 order by subs_start_date desc limit 1`
+	// An open-ended subscription never ends, so it is reported as ending at
+	// the end of time rather than as missing.
+	const query = `select coalesce(subs_end_date, '9999-12-31'::date) from subscriptions
+	where user_id = $1 and service_name = $2 and kind = 'recurring' and deleted_at is null order by subs_start_date desc limit 1`
 	var latestDate *time.Time
 	if err := connection.GetContext(ctx, &latestDate, query, userID, serviceName); err != nil &&
 		!errors.Is(err, sql.ErrNoRows) {
@@ -139,3 +220,248 @@ func (s *Subscription) GetLatestSubscriptionDate(
 	}
 	return latestDate, nil
 }
+
+func (s *Subscription) GetByID(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionID domain.SubscriptionID,
+) (domain.Subscription, error) {
+	var subscription domain.Subscription
+	const query = `select ` + subscriptionColumns + ` from subscriptions where subscription_id = $1`
+
+	if err := connection.GetContext(ctx, &subscription, query, subscriptionID); err != nil {
+		return subscription, errors.Join(ErrGetSubscription, err)
+	}
+	return subscription, nil
+}
+
+func (s *Subscription) ReadDeletedByUserID(
+	ctx context.Context,
+	connection domain.Connection,
+	userID domain.UserID,
+) ([]domain.Subscription, error) {
+	const query = `select ` + subscriptionColumns + ` from subscriptions
+	where user_id = $1 and deleted_at is not null order by deleted_at desc`
+	var deletedSubscriptions []domain.Subscription
+	if err := connection.SelectContext(ctx, &deletedSubscriptions, query, userID); err != nil {
+		return deletedSubscriptions, errors.Join(ErrReadAllSubscriptions, err)
+	}
+	return deletedSubscriptions, nil
+}
+
+func (s *Subscription) Restore(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionID domain.SubscriptionID,
+) error {
+	const query = `update subscriptions set deleted_at = null
+	where subscription_id = $1 and deleted_at is not null`
+	rowsAffected, err := connection.ExecContext(ctx, query, subscriptionID)
+	if err != nil {
+		return errors.Join(ErrRestoreSubscription, err)
+	}
+	if rowsAffected == 0 {
+		return errors.Join(ErrRestoreSubscription, errors.New("no deleted subscription found to restore"))
+	}
+	return nil
+}
+
+// Revert writes every column of a snapshot back to its subscription.
+func (s *Subscription) Revert(
+	ctx context.Context,
+	connection domain.Connection,
+	subscription domain.Subscription,
+) error {
+	const query = `update subscriptions set kind = $2, service_name = $3, month_cost = $4, user_id = $5,
+	subs_start_date = $6, subs_end_date = $7, service_id = $8, category = $9, tags = coalesce($10::text[], '{}'),
+	split_rule = $11, auto_renew = $12, term_months = $13, expired_at = $14, cancellation_code = $15,
+	cancellation_reason = $16, deleted_at = $17
+	where subscription_id = $1`
+	rowsAffected, err := connection.ExecContext(
+		ctx,
+		query,
+		subscription.ID,
+		subscription.Kind,
+		subscription.Name,
+		subscription.Cost,
+		subscription.UserID,
+		subscription.StartDate,
+		subscription.EndDate,
+		subscription.ServiceID,
+		subscription.Category,
+		subscription.Tags,
+		subscription.SplitRule,
+		subscription.AutoRenew,
+		subscription.TermMonths,
+		subscription.ExpiredAt,
+		subscription.CancellationCode,
+		subscription.CancellationReason,
+		subscription.DeletedAt,
+	)
+	if err != nil {
+		return errors.Join(ErrRevertSubscription, err)
+	}
+	if rowsAffected == 0 {
+		return errors.Join(ErrRevertSubscription, errors.New("no subscription found to revert"))
+	}
+	return nil
+}
+
+func (s *Subscription) PurgeDeleted(
+	ctx context.Context,
+	connection domain.Connection,
+	deletedBefore time.Time,
+) (int64, error) {
+	const query = `delete from subscriptions where deleted_at < $1`
+	rowsAffected, err := connection.ExecContext(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, errors.Join(ErrPurgeSubscriptions, err)
+	}
+	return rowsAffected, nil
+}
+
+func (s *Subscription) CountOverlapping(
+	ctx context.Context,
+	connection domain.Connection,
+	subscription domain.Subscription,
+) (int, error) {
+	const query = `select count(*) from subscriptions
+	where user_id = $1 and service_name = $2 and subscription_id <> $3 and deleted_at is null
+	and subs_start_date < coalesce($5, 'infinity'::date)
+	and $4 < coalesce(subs_end_date, 'infinity'::date)`
+	var overlapping int
+	if err := connection.GetContext(
+		ctx,
+		&overlapping,
+		query,
+		subscription.UserID,
+		subscription.Name,
+		subscription.ID,
+		subscription.StartDate,
+		subscription.EndDate,
+	); err != nil {
+		return 0, errors.Join(ErrOverlapSubscriptions, err)
+	}
+	return overlapping, nil
+}
+
+// RenewDue extends every lapsed auto-renewing term subscription by as many
+// whole terms as needed to cover the given billing period and returns the
+// extended ones. Only the latest record of a user's service is extended, so
+// renewals never create overlaps.
+func (s *Subscription) RenewDue(
+	ctx context.Context,
+	connection domain.Connection,
+	period time.Time,
+) ([]domain.SubscriptionID, error) {
+	const query = `update subscriptions s
+	set subs_end_date = (s.subs_end_date + make_interval(months => s.term_months * (
+		(((extract(year from $1::date) - extract(year from s.subs_end_date)) * 12
+		+ extract(month from $1::date) - extract(month from s.subs_end_date))::int + s.term_months - 1)
+		/ s.term_months)))::date
+	where s.auto_renew and s.term_months is not null
+	and s.deleted_at is null and s.expired_at is null
+	and s.subs_end_date < $1::date
+	and not exists (select 1 from subscriptions n
+		where n.user_id = s.user_id and n.service_name = s.service_name
+		and n.deleted_at is null and n.subs_start_date > s.subs_start_date)
+	returning s.subscription_id`
+	var renewed []domain.SubscriptionID
+	if err := connection.SelectContext(ctx, &renewed, query, period); err != nil {
+		return nil, errors.Join(ErrRenewSubscriptions, err)
+	}
+	return renewed, nil
+}
+
+func (s *Subscription) ExpireDue(
+	ctx context.Context,
+	connection domain.Connection,
+	period time.Time,
+	expiredAt time.Time,
+) (int64, error) {
+	const query = `update subscriptions set expired_at = $2
+	where not auto_renew and term_months is not null
+	and deleted_at is null and expired_at is null
+	and subs_end_date < $1`
+	rowsAffected, err := connection.ExecContext(ctx, query, period, expiredAt)
+	if err != nil {
+		return 0, errors.Join(ErrExpireSubscriptions, err)
+	}
+	return rowsAffected, nil
+}
+
+// FindServiceConflicts lists pairs of active recurring subscriptions of the
+// same user under the two names whose periods overlap, i.e. the records that
+// would break the one-period-at-a-time rule if the names were unified.
+// One-time purchases may repeat, so they never conflict.
+func (s *Subscription) FindServiceConflicts(
+	ctx context.Context,
+	connection domain.Connection,
+	from domain.ServiceName,
+	to domain.ServiceName,
+) ([]domain.ServiceConflict, error) {
+	const query = `select a.user_id, a.subscription_id as source_id, b.subscription_id as target_id
+	from subscriptions a
+	join subscriptions b on b.user_id = a.user_id
+	where a.service_name = $1 and b.service_name = $2
+	and a.kind = 'recurring' and b.kind = 'recurring'
+	and a.deleted_at is null and b.deleted_at is null
+	and a.subs_start_date < coalesce(b.subs_end_date, 'infinity'::date)
+	and b.subs_start_date < coalesce(a.subs_end_date, 'infinity'::date)
+	order by a.user_id, a.subs_start_date`
+	var conflicts []domain.ServiceConflict
+	if err := connection.SelectContext(ctx, &conflicts, query, from, to); err != nil {
+		return conflicts, errors.Join(ErrServiceConflicts, err)
+	}
+	return conflicts, nil
+}
+
+func (s *Subscription) RenameService(
+	ctx context.Context,
+	connection domain.Connection,
+	from domain.ServiceName,
+	to domain.ServiceName,
+	serviceID *domain.CatalogEntryID,
+) (int64, error) {
+	const query = `update subscriptions set service_name = $2, service_id = coalesce($3, service_id)
+	where service_name = $1`
+	rowsAffected, err := connection.ExecContext(ctx, query, from, to, serviceID)
+	if err != nil {
+		return 0, errors.Join(ErrRenameService, err)
+	}
+	return rowsAffected, nil
+}
+
+func (s *Subscription) ReadMembers(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionIDs []domain.SubscriptionID,
+) ([]domain.SubscriptionMember, error) {
+	const query = `select subscription_id, user_id, share from subscription_members
+	where subscription_id = any($1) order by subscription_id, user_id`
+	var members []domain.SubscriptionMember
+	if err := connection.SelectContext(ctx, &members, query, subscriptionIDs); err != nil {
+		return members, errors.Join(ErrReadMembers, err)
+	}
+	return members, nil
+}
+
+func (s *Subscription) ReplaceMembers(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionID domain.SubscriptionID,
+	members []domain.SubscriptionMember,
+) error {
+	const deleteQuery = `delete from subscription_members where subscription_id = $1`
+	const insertQuery = `insert into subscription_members (subscription_id, user_id, share) values ($1, $2, $3)`
+
+	if _, err := connection.ExecContext(ctx, deleteQuery, subscriptionID); err != nil {
+		return errors.Join(ErrReplaceMembers, err)
+	}
+	for _, member := range members {
+		if _, err := connection.ExecContext(ctx, insertQuery, subscriptionID, member.UserID, member.Share); err != nil {
+			return errors.Join(ErrReplaceMembers, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ef_project/internal/domain"
+)
+
+var (
+	ErrUserConflicts     = errors.Join(errSubscription, errors.New("user conflicts check failed"))
+	ErrTrashSubscription = errors.Join(errSubscription, errors.New("trash failed"))
+	ErrTransferOwner     = errors.Join(errSubscription, errors.New("transfer owner failed"))
+	ErrTransferMembers   = errors.Join(errSubscription, errors.New("transfer members failed"))
+	ErrReadByUsers       = errors.Join(errSubscription, errors.New("read by users failed"))
+)
+
+// FindUserConflicts pairs the recurring subscriptions of the source user with
+// the ones of the target user to the same service whose periods overlap.
+func (s *Subscription) FindUserConflicts(
+	ctx context.Context,
+	connection domain.Connection,
+	source domain.UserID,
+	target domain.UserID,
+) ([]domain.ServiceConflict, error) {
+	const query = `select b.user_id, a.subscription_id as source_id, b.subscription_id as target_id
+	from subscriptions a
+	join subscriptions b on b.service_name = a.service_name
+	where a.user_id = $1 and b.user_id = $2
+	and a.kind = 'recurring' and b.kind = 'recurring'
+	and a.deleted_at is null and b.deleted_at is null
+	and a.subs_start_date < coalesce(b.subs_end_date, 'infinity'::date)
+	and b.subs_start_date < coalesce(a.subs_end_date, 'infinity'::date)
+	order by a.service_name, a.subs_start_date`
+	var conflicts []domain.ServiceConflict
+	if err := connection.SelectContext(ctx, &conflicts, query, source, target); err != nil {
+		return conflicts, errors.Join(ErrUserConflicts, err)
+	}
+	return conflicts, nil
+}
+
+// ReadByUsers returns the subscriptions owned or shared by any of the users,
+// the trashed ones included.
+func (s *Subscription) ReadByUsers(
+	ctx context.Context,
+	connection domain.Connection,
+	userIDs []domain.UserID,
+) ([]domain.Subscription, error) {
+	const query = `select ` + subscriptionColumns + ` from subscriptions
+	where user_id = any($1) or exists (select 1 from subscription_members m
+		where m.subscription_id = subscriptions.subscription_id and m.user_id = any($1))`
+	var subscriptions []domain.Subscription
+	if err := connection.SelectContext(ctx, &subscriptions, query, userIDs); err != nil {
+		return subscriptions, errors.Join(ErrReadByUsers, err)
+	}
+	return subscriptions, nil
+}
+
+// Trash moves the subscriptions to the trash.
+func (s *Subscription) Trash(
+	ctx context.Context,
+	connection domain.Connection,
+	subscriptionIDs []domain.SubscriptionID,
+) error {
+	const query = `update subscriptions set deleted_at = now()
+	where subscription_id = any($1) and deleted_at is null`
+	if _, err := connection.ExecContext(ctx, query, subscriptionIDs); err != nil {
+		return errors.Join(ErrTrashSubscription, err)
+	}
+	return nil
+}
+
+// TransferOwner gives every subscription of the source user, the trashed
+// ones included, to the target user.
+func (s *Subscription) TransferOwner(
+	ctx context.Context,
+	connection domain.Connection,
+	source domain.UserID,
+	target domain.UserID,
+) ([]domain.SubscriptionID, error) {
+	const query = `update subscriptions set user_id = $2 where user_id = $1
+	returning subscription_id`
+	var moved []domain.SubscriptionID
+	if err := connection.SelectContext(ctx, &moved, query, source, target); err != nil {
+		return moved, errors.Join(ErrTransferOwner, err)
+	}
+	return moved, nil
+}
+
+// TransferMembers moves the memberships of the source user in family
+// subscriptions to the target user. A membership is dropped instead when the
+// target user already shares or owns the subscription, as the owner pays the
+// rest and is never a member. It returns the subscriptions it changed.
+func (s *Subscription) TransferMembers(
+	ctx context.Context,
+	connection domain.Connection,
+	source domain.UserID,
+	target domain.UserID,
+) ([]domain.SubscriptionID, error) {
+	const dropQuery = `delete from subscription_members m
+	where (m.user_id = $1 and exists (select 1 from subscription_members t
+		where t.subscription_id = m.subscription_id and t.user_id = $2))
+	or (m.user_id in ($1, $2) and exists (select 1 from subscriptions s
+		where s.subscription_id = m.subscription_id and s.user_id = $2))
+	returning m.subscription_id`
+	const moveQuery = `update subscription_members set user_id = $2 where user_id = $1
+	returning subscription_id`
+
+	var dropped, moved []domain.SubscriptionID
+	if err := connection.SelectContext(ctx, &dropped, dropQuery, source, target); err != nil {
+		return nil, errors.Join(ErrTransferMembers, err)
+	}
+	if err := connection.SelectContext(ctx, &moved, moveQuery, source, target); err != nil {
+		return nil, errors.Join(ErrTransferMembers, err)
+	}
+	return append(dropped, moved...), nil
+}
@@ -0,0 +1,78 @@
+package translit
+
+import "strings"
+
+type latinSequence struct {
+	latin    string
+	cyrillic string
+}
+
+// latinSequences lists multi-letter Latin sequences first so that ToCyrillic
+// prefers "shch" over "sh" + "ch".
+func latinSequences() []latinSequence {
+	return []latinSequence{
+		{"shch", "щ"}, {"sch", "щ"}, {"zh", "ж"}, {"kh", "х"}, {"ts", "ц"},
+		{"ch", "ч"}, {"sh", "ш"}, {"yu", "ю"}, {"ya", "я"}, {"yo", "ё"},
+		{"ye", "е"}, {"a", "а"}, {"b", "б"}, {"c", "к"}, {"d", "д"},
+		{"e", "е"}, {"f", "ф"}, {"g", "г"}, {"h", "х"}, {"i", "и"},
+		{"j", "дж"}, {"k", "к"}, {"l", "л"}, {"m", "м"}, {"n", "н"},
+		{"o", "о"}, {"p", "п"}, {"q", "к"}, {"r", "р"}, {"s", "с"},
+		{"t", "т"}, {"u", "у"}, {"v", "в"}, {"w", "в"}, {"x", "кс"},
+		{"y", "й"}, {"z", "з"},
+	}
+}
+
+func cyrillicLetters() map[rune]string {
+	return map[rune]string{
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+		'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+		'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+		'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+		'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	}
+}
+
+// ToLatin transliterates Cyrillic letters of s into Latin ones. The result is
+// lower-cased; characters without a mapping are kept as is.
+func ToLatin(s string) string {
+	letters := cyrillicLetters()
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if latin, ok := letters[r]; ok {
+			b.WriteString(latin)
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// ToCyrillic transliterates Latin letters of s into Cyrillic ones. The result
+// is lower-cased; characters without a mapping are kept as is.
+func ToCyrillic(s string) string {
+	s = strings.ToLower(s)
+	sequences := latinSequences()
+
+	var b strings.Builder
+	for s != "" {
+		matched := false
+		for _, pair := range sequences {
+			if strings.HasPrefix(s, pair.latin) {
+				b.WriteString(pair.cyrillic)
+				s = s[len(pair.latin):]
+				matched = true
+
+				break
+			}
+		}
+		if !matched {
+			r := []rune(s)[0]
+			b.WriteRune(r)
+			s = s[len(string(r)):]
+		}
+	}
+
+	return b.String()
+}
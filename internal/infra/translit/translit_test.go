@@ -0,0 +1,23 @@
+package translit_test
+
+import (
+	"testing"
+
+	"ef_project/internal/infra/translit"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToLatin(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "yandeks plyus", translit.ToLatin("Яндекс Плюс"))
+	require.Equal(t, "kinopoisk hd", translit.ToLatin("Кинопоиск HD"))
+}
+
+func TestToCyrillic(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "яндекс плус", translit.ToCyrillic("Yandex Plus"))
+	require.Equal(t, "щука 42", translit.ToCyrillic("Shchuka 42"))
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"ef_project/internal/domain"
 
 	"ef_project/internal/infra/database"
+	"ef_project/internal/infra/events"
 	"ef_project/internal/infra/repository"
 
 	httpapi "ef_project/internal/adapters/http"
@@ -33,11 +35,17 @@ const (
 	exitOK = iota
 	exitDotEnvFailed
 	exitServersFailed
+	exitQualityFailed
 )
 
 const (
 	readimeout        = 100 * time.Millisecond
 	readHeaderTimeout = 100 * time.Millisecond
+
+	defaultTrashRetention     = 30 * 24 * time.Hour
+	defaultTrashPurgeInterval = time.Hour
+	defaultRenewalInterval    = time.Hour
+	defaultLedgerInterval     = 24 * time.Hour
 )
 
 func main() {
@@ -72,17 +80,51 @@ func Run(ctx context.Context) int {
 	)
 	defer provider.Close()
 
+	subscriptionRepo := repository.NewSubscription()
+	catalogRepo := repository.NewCatalog()
+	auditRepo := repository.NewAudit()
+
+	ledgerService := domain.NewLedgerService(provider, repository.NewLedger(), subscriptionRepo)
+	qualityService := domain.NewQualityService(provider, repository.NewQuality(), auditRepo, ledgerService)
+
+	// `quality` runs the data quality check once instead of serving.
+	if len(os.Args) > 1 && os.Args[1] == "quality" {
+		return runQuality(ctx, qualityService, os.Args[2:])
+	}
+
 	router := gin.Default()
 
+	budgetService := domain.NewBudgetService(
+		provider,
+		repository.NewBudget(),
+		subscriptionRepo,
+		ledgerService,
+		events.NewLogPublisher(),
+	)
 	subscriptionsService := domain.NewSubscriptionService(
 		provider,
-		repository.NewSubscription(),
+		subscriptionRepo,
+		catalogRepo,
+		domain.WithAutoMapThreshold(floatFromEnv(ctx, "CATALOG_AUTOMAP_THRESHOLD")),
+		domain.WithBudgetEvaluator(budgetService),
+		domain.WithChargeLedger(ledgerService),
+		domain.WithAuditLog(auditRepo),
+		domain.WithUndoWindow(durationFromEnv(ctx, "UNDO_WINDOW", 0)),
+	)
+	catalogService := domain.NewCatalogService(provider, catalogRepo)
+	adminService := domain.NewAdminService(
+		provider,
+		subscriptionRepo,
+		catalogRepo,
+		auditRepo,
+		ledgerService,
 	)
 
 	middlewares := []oapi.StrictMiddlewareFunc{
 		func(f strictgin.StrictGinHandlerFunc, _ string) strictgin.StrictGinHandlerFunc {
 			return func(ctx *gin.Context, request any) (any, error) {
 				log.SetRequestID(ctx, uuid.NewString())
+				log.SetActor(ctx, ctx.GetHeader("X-Actor"))
 
 				return f(ctx, request)
 			}
@@ -92,7 +134,16 @@ func Run(ctx context.Context) int {
 	oapi.RegisterHandlers(
 		router,
 		oapi.NewStrictHandler(
-			httpapi.NewServer(subscriptionsService),
+			httpapi.NewServer(
+				subscriptionsService,
+				catalogService,
+				adminService,
+				budgetService,
+				ledgerService,
+				domain.NewAnalyticsService(provider, repository.NewAnalytics()),
+				domain.NewAuditService(provider, auditRepo),
+				qualityService,
+			),
 			middlewares,
 		),
 	)
@@ -100,6 +151,62 @@ func Run(ctx context.Context) int {
 	var eg errgroup.Group
 	startHTTPServer(ctx, &eg, router)
 
+	trashRetention := durationFromEnv(ctx, "TRASH_RETENTION", defaultTrashRetention)
+	startPeriodicJob(
+		ctx,
+		&eg,
+		"trash purge",
+		durationFromEnv(ctx, "TRASH_PURGE_INTERVAL", defaultTrashPurgeInterval),
+		func(ctx context.Context) error {
+			purged, err := subscriptionsService.PurgeTrash(ctx, time.Now().Add(-trashRetention))
+			if err == nil {
+				slog.InfoContext(ctx, "Trash purged.", slog.Int64("purged", purged))
+			}
+
+			return err
+		},
+	)
+	startPeriodicJob(
+		ctx,
+		&eg,
+		"renewals",
+		durationFromEnv(ctx, "RENEWAL_INTERVAL", defaultRenewalInterval),
+		func(ctx context.Context) error {
+			result, err := subscriptionsService.ProcessRenewals(ctx, time.Now())
+			if err == nil {
+				slog.InfoContext(
+					ctx,
+					"Renewals processed.",
+					slog.Int64("renewed", result.Renewed),
+					slog.Int64("expired", result.Expired),
+				)
+			}
+
+			return err
+		},
+	)
+
+	// Writes keep the ledger in sync, the job moves its horizon forward and
+	// picks up renewals.
+	startPeriodicJob(
+		ctx,
+		&eg,
+		"ledger",
+		durationFromEnv(ctx, "LEDGER_INTERVAL", defaultLedgerInterval),
+		func(ctx context.Context) error {
+			now := time.Now()
+			written, err := ledgerService.Rebuild(ctx, domain.LedgerRebuild{
+				Start: now,
+				End:   domain.LedgerHorizon(now),
+			})
+			if err == nil {
+				slog.InfoContext(ctx, "Ledger rebuilt.", slog.Int("written", written))
+			}
+
+			return err
+		},
+	)
+
 	if err := eg.Wait(); err != nil {
 		slog.ErrorContext(ctx, "Runing servers failed.", log.ErrorAttr(err))
 
@@ -131,3 +238,61 @@ func startHTTPServer(ctx context.Context, eg *errgroup.Group, router *gin.Engine
 		return httpSrv.Shutdown(ctx)
 	})
 }
+
+func startPeriodicJob(
+	ctx context.Context,
+	eg *errgroup.Group,
+	name string,
+	interval time.Duration,
+	job func(context.Context) error,
+) {
+	eg.Go(func() error {
+		slog.InfoContext(ctx, "Starting periodic job", slog.String("job", name), slog.Duration("interval", interval))
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := job(ctx); err != nil {
+				slog.ErrorContext(ctx, "Periodic job failed.", slog.String("job", name), log.ErrorAttr(err))
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+func durationFromEnv(ctx context.Context, key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		slog.WarnContext(ctx, "Invalid duration, using default.", slog.String("key", key), slog.String("value", value))
+
+		return fallback
+	}
+
+	return duration
+}
+
+func floatFromEnv(ctx context.Context, key string) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return 0
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		slog.WarnContext(ctx, "Invalid number, ignoring.", slog.String("key", key), slog.String("value", value))
+
+		return 0
+	}
+
+	return number
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+
+	"ef_project/internal/domain"
+	"ef_project/internal/infra/log"
+)
+
+// runQuality prints the anomalies found among the subscriptions. With -fix it
+// fixes what it can first, and with -dry-run as well it only shows what the
+// fix would leave.
+func runQuality(ctx context.Context, quality domain.QualityInterface, args []string) int {
+	flags := flag.NewFlagSet("quality", flag.ContinueOnError)
+	fix := flags.Bool("fix", false, "fix the anomalies that have an automatic fix")
+	dryRun := flags.Bool("dry-run", false, "with -fix, roll the fixes back after reporting them")
+	if err := flags.Parse(args); err != nil {
+		return exitQualityFailed
+	}
+
+	out := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer out.Flush()
+
+	var anomalies []domain.Anomaly
+	if *fix {
+		result, err := quality.Fix(ctx, *dryRun)
+		if err != nil {
+			slog.ErrorContext(ctx, "Fixing subscription anomalies failed.", log.ErrorAttr(err))
+
+			return exitQualityFailed
+		}
+		fmt.Fprintln(out, "FIXED\tCOUNT")
+		for _, anomaly := range domain.AnomalyFixOrder() {
+			fmt.Fprintf(out, "%s\t%d\n", anomaly, result.Fixed[anomaly])
+		}
+		if result.DryRun {
+			fmt.Fprintln(out, "dry run, nothing was saved")
+		}
+		fmt.Fprintln(out)
+		anomalies = result.Remaining
+	} else {
+		var err error
+		if anomalies, err = quality.Scan(ctx); err != nil {
+			slog.ErrorContext(ctx, "Scanning subscriptions for anomalies failed.", log.ErrorAttr(err))
+
+			return exitQualityFailed
+		}
+	}
+
+	fmt.Fprintln(out, "ANOMALY\tSUBSCRIPTION\tUSER\tSERVICE\tSTART\tEND\tCOST\tCONFLICT")
+	for _, anomaly := range anomalies {
+		end, conflict := "-", "-"
+		if anomaly.EndDate != nil {
+			end = anomaly.EndDate.Format("01-2006")
+		}
+		if anomaly.ConflictID != nil {
+			conflict = anomaly.ConflictID.String()
+		}
+		fmt.Fprintf(
+			out,
+			"%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\n",
+			anomaly.Type,
+			anomaly.ID,
+			anomaly.UserID,
+			anomaly.Name,
+			anomaly.StartDate.Format("01-2006"),
+			end,
+			anomaly.Cost,
+			conflict,
+		)
+	}
+
+	return exitOK
+}